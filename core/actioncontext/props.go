@@ -126,11 +126,43 @@ var (
 		TimeoutKeywords: []string{"stop_timeout", "timeout"},
 		PG:              true,
 	}
+	ImagePull = Properties{
+		Name: "image_pull",
+		PG:   true,
+	}
+	Move = Properties{
+		Name:            "move",
+		Target:          "moved",
+		Progress:        "moving",
+		Failure:         "move failed",
+		MustLock:        true,
+		Order:           ordering.Desc,
+		Rollback:        true,
+		TimeoutKeywords: []string{"stop_timeout", "timeout"},
+		PG:              true,
+	}
+	MoveCheck = Properties{
+		Name:     "move_check",
+		Progress: "move checking",
+		Failure:  "move check failed",
+	}
 	PGUpdate = Properties{
 		Name:     "pg_update",
 		MustLock: true,
 		PG:       true,
 	}
+	SplitBrainResolve = Properties{
+		Name:     "drbd_resolve",
+		Progress: "resolving",
+		Failure:  "split-brain resolve failed",
+		MustLock: true,
+	}
+	SnapshotRestore = Properties{
+		Name:     "snapshot_restore",
+		Progress: "restoring",
+		Failure:  "snapshot restore failed",
+		MustLock: true,
+	}
 	Start = Properties{
 		Name:            "start",
 		Target:          "started",
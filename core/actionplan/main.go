@@ -0,0 +1,103 @@
+// Package actionplan describes the resource operations an instance action
+// would execute, in order, without executing them.
+package actionplan
+
+import (
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/status"
+)
+
+type (
+	// T is the planned operation graph of an instance action.
+	//
+	// The steps are executed serially, in order. The resources of a step
+	// are executed serially, or concurrently if the step is parallel.
+	T struct {
+		Path   naming.Path `json:"path"`
+		Node   string      `json:"node"`
+		Action string      `json:"action"`
+
+		// Desc is true if the action walks the resources in descending
+		// order, like the stop action.
+		Desc  bool   `json:"desc"`
+		Steps []Step `json:"steps"`
+	}
+
+	// Step is the planned execution of a resourceset.
+	Step struct {
+		Subset    string     `json:"subset"`
+		Parallel  bool       `json:"parallel"`
+		Resources []Resource `json:"resources"`
+	}
+
+	// Resource is a planned resource operation.
+	Resource struct {
+		RID      string   `json:"rid"`
+		Label    string   `json:"label"`
+		Status   status.T `json:"status"`
+		Skip     bool     `json:"skip"`
+		Reason   string   `json:"reason,omitempty"`
+		Optional bool     `json:"optional,omitempty"`
+		Barrier  bool     `json:"barrier,omitempty"`
+
+		// Requires is the list of the resource action requirements,
+		// formatted like <rid>(<state>[,<state>]).
+		Requires []string `json:"requires,omitempty"`
+
+		// DependsOn is the list of resources added to the action selection
+		// to satisfy the resource action dependencies.
+		DependsOn []string `json:"depends_on,omitempty"`
+
+		// Linked is the list of operations on the resources linked to this
+		// resource. They are executed after the resource operation on
+		// ascending actions, and before on descending actions.
+		Linked []Resource `json:"linked,omitempty"`
+	}
+)
+
+// The reasons to skip a resource operation.
+const (
+	ReasonActionNotSupported   = "action not supported by the driver"
+	ReasonAlreadyDown          = "already down"
+	ReasonAlreadyProvisioned   = "already provisioned"
+	ReasonAlreadyUnprovisioned = "already unprovisioned"
+	ReasonAlreadyUp            = "already up"
+	ReasonConfigurationError   = "configuration error"
+	ReasonDisabled             = "disabled"
+	ReasonEncap                = "encap resource, handled by the encap agent"
+	ReasonNotProvisioned       = "not provisioned"
+	ReasonProvisionDisabled    = "provision disabled"
+	ReasonStandby              = "standby resource, use --force to override"
+	ReasonUnprovisionDisabled  = "unprovision disabled"
+)
+
+// New allocates and returns a new action plan.
+func New(path naming.Path, node, action string, desc bool) *T {
+	return &T{
+		Path:   path,
+		Node:   node,
+		Action: action,
+		Desc:   desc,
+		Steps:  make([]Step, 0),
+	}
+}
+
+// Ops returns the number of resource operations that are not skipped.
+func (t T) Ops() int {
+	var count func(l []Resource) int
+	count = func(l []Resource) int {
+		n := 0
+		for _, r := range l {
+			if !r.Skip {
+				n++
+			}
+			n += count(r.Linked)
+		}
+		return n
+	}
+	n := 0
+	for _, step := range t.Steps {
+		n += count(step.Resources)
+	}
+	return n
+}
@@ -0,0 +1,52 @@
+package actionplan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/status"
+)
+
+func newTestPlan(desc bool) *T {
+	p := New(naming.Path{Namespace: "root", Kind: naming.KindSvc, Name: "svc1"}, "node1", "start", desc)
+	p.Steps = []Step{
+		{
+			Subset: "subset#disk",
+			Resources: []Resource{
+				{RID: "disk#1", Status: status.Up, Skip: true, Reason: ReasonAlreadyUp},
+				{RID: "disk#2", Status: status.Down},
+			},
+		},
+		{
+			Subset:   "subset#container",
+			Parallel: true,
+			Resources: []Resource{
+				{RID: "container#1", Status: status.Down, Linked: []Resource{
+					{RID: "ip#1", Status: status.Down},
+				}},
+				{RID: "container#2", Status: status.Down},
+			},
+		},
+	}
+	return p
+}
+
+func TestOps(t *testing.T) {
+	require.Equal(t, 4, newTestPlan(false).Ops())
+}
+
+func TestDOT(t *testing.T) {
+	s := newTestPlan(false).DOT()
+	require.Contains(t, s, `digraph "svc1 start" {`)
+	require.Contains(t, s, `"disk#1" [label="disk#1\nskip: already up", style=dashed, fontcolor=gray];`)
+	require.Contains(t, s, `"disk#1" -> "disk#2";`)
+	require.Contains(t, s, `"disk#2" -> "container#1";`)
+	require.Contains(t, s, `"disk#2" -> "container#2";`)
+	require.Contains(t, s, `"container#1" -> "ip#1";`)
+
+	s = newTestPlan(true).DOT()
+	require.Contains(t, s, `"disk#2" -> "ip#1";`)
+	require.Contains(t, s, `"ip#1" -> "container#1";`)
+}
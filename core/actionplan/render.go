@@ -0,0 +1,154 @@
+package actionplan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/colorstatus"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/util/render/tree"
+)
+
+// Render returns a human friendly tree representation of the plan.
+func (t T) Render() string {
+	tree := tree.New()
+	head := tree.Head()
+	head.AddColumn().AddText(fmt.Sprintf("%s %s plan", t.Path, t.Action)).SetColor(rawconfig.Color.Bold)
+	head.AddColumn().AddText(t.Node).SetColor(rawconfig.Color.Secondary)
+	head.AddColumn()
+	head.AddColumn().AddText(fmt.Sprintf("%d operations", t.Ops())).SetColor(rawconfig.Color.Secondary)
+	for i, step := range t.Steps {
+		n := head.AddNode()
+		n.AddColumn().AddText(step.title(i)).SetColor(rawconfig.Color.Bold)
+		for _, r := range step.Resources {
+			r.loadTreeNode(n.AddNode(), t.Action)
+		}
+	}
+	return tree.Render()
+}
+
+func (t Resource) loadTreeNode(n *tree.Node, action string) {
+	n.AddColumn().AddText(t.RID).SetColor(rawconfig.Color.Primary)
+	if t.Skip {
+		n.AddColumn().AddText("skip").SetColor(rawconfig.Color.Secondary)
+	} else {
+		n.AddColumn().AddText(action)
+	}
+	n.AddColumn().AddText(colorstatus.Sprint(t.Status, rawconfig.Colorize))
+	n.AddColumn().AddText(strings.Join(t.details(), ", ")).SetColor(rawconfig.Color.Secondary)
+	for _, linked := range t.Linked {
+		linked.loadTreeNode(n.AddNode(), action)
+	}
+}
+
+// details returns the list of notable information about the planned
+// resource operation.
+func (t Resource) details() []string {
+	l := make([]string, 0)
+	if t.Reason != "" {
+		l = append(l, t.Reason)
+	}
+	if t.Optional {
+		l = append(l, "optional")
+	}
+	if t.Barrier {
+		l = append(l, "barrier")
+	}
+	if len(t.Requires) > 0 {
+		l = append(l, "requires "+strings.Join(t.Requires, " "))
+	}
+	if len(t.DependsOn) > 0 {
+		l = append(l, "depends on "+strings.Join(t.DependsOn, " "))
+	}
+	return l
+}
+
+func (t Step) title(i int) string {
+	mode := "serial"
+	if t.Parallel {
+		mode = "parallel"
+	}
+	return fmt.Sprintf("%d. %s (%s)", i+1, t.Subset, mode)
+}
+
+// DOT returns the Graphviz DOT representation of the plan.
+//
+// The resources of a serial step are chained, the resources of a parallel
+// step all depend on the exits of the previous step. Skipped operations are
+// drawn dashed.
+func (t T) DOT() string {
+	var (
+		sb   strings.Builder
+		prev []string
+	)
+	fmt.Fprintf(&sb, "digraph %q {\n", fmt.Sprintf("%s %s", t.Path, t.Action))
+	sb.WriteString("\trankdir=LR;\n")
+	sb.WriteString("\tnode [shape=box];\n")
+	edges := make([]string, 0)
+	addEdges := func(from []string, to string) {
+		for _, s := range from {
+			edges = append(edges, fmt.Sprintf("\t%q -> %q;\n", s, to))
+		}
+	}
+	for i, step := range t.Steps {
+		fmt.Fprintf(&sb, "\tsubgraph \"cluster_%d\" {\n", i)
+		fmt.Fprintf(&sb, "\t\tlabel=%q;\n", step.title(i))
+		exits := make([]string, 0)
+		for _, r := range step.Resources {
+			entry, exit := r.dot(&sb, t.Action, t.Desc, addEdges)
+			if step.Parallel {
+				addEdges(prev, entry)
+				exits = append(exits, exit)
+			} else {
+				addEdges(prev, entry)
+				prev = []string{exit}
+			}
+		}
+		sb.WriteString("\t}\n")
+		if step.Parallel && len(exits) > 0 {
+			prev = exits
+		}
+	}
+	for _, s := range edges {
+		sb.WriteString(s)
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// dot writes the DOT nodes of the resource operation and its linked
+// operations, and returns the entry and exit node identifiers of the chain.
+func (t Resource) dot(sb *strings.Builder, action string, desc bool, addEdges func([]string, string)) (string, string) {
+	label := t.RID + "\\n" + action
+	attrs := ""
+	if t.Skip {
+		label = t.RID + "\\nskip: " + t.Reason
+		attrs = ", style=dashed, fontcolor=gray"
+	}
+	if t.Barrier {
+		attrs += ", peripheries=2"
+	}
+	fmt.Fprintf(sb, "\t\t%q [label=\"%s\"%s];\n", t.RID, label, attrs)
+	var chainEntry, chainExit string
+	for _, linked := range t.Linked {
+		linkedEntry, linkedExit := linked.dot(sb, action, desc, addEdges)
+		if chainExit == "" {
+			chainEntry = linkedEntry
+		} else {
+			addEdges([]string{chainExit}, linkedEntry)
+		}
+		chainExit = linkedExit
+	}
+	switch {
+	case chainEntry == "":
+		return t.RID, t.RID
+	case desc:
+		// linked operations are done before the resource operation
+		addEdges([]string{chainExit}, t.RID)
+		return chainEntry, t.RID
+	default:
+		// linked operations are done after the resource operation
+		addEdges([]string{t.RID}, chainEntry)
+		return t.RID, chainExit
+	}
+}
@@ -27,6 +27,9 @@ type GetEvents struct {
 	Replay    bool
 	Duration  *time.Duration
 	ServedBy  string
+
+	// Since is the id of the last event received, set to resume a stream.
+	Since *uint64
 }
 
 func (t *GetEvents) SetDuration(duration time.Duration) *GetEvents {
@@ -59,6 +62,13 @@ func (t *GetEvents) WithReplay() *GetEvents {
 	return t
 }
 
+// SetSince requests the events following the event id, to resume a
+// stream without missing the events published during a disconnection.
+func (t *GetEvents) SetSince(id uint64) *GetEvents {
+	t.Since = &id
+	return t
+}
+
 func (t *GetEvents) SetNamespace(s string) *GetEvents {
 	t.namespace = &s
 	return t
@@ -211,6 +221,7 @@ func (t *GetEvents) eventsBase() (*http.Response, error) {
 		Filter:   &t.Filters,
 		Selector: t.selector,
 		Replay:   &t.Replay,
+		Since:    t.Since,
 	}
 	if t.Limit != nil {
 		i := int64(*t.Limit)
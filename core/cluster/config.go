@@ -5,6 +5,7 @@ import (
 
 	"golang.org/x/time/rate"
 
+	"github.com/opensvc/om3/v3/daemon/rbac"
 	"github.com/opensvc/om3/v3/util/file"
 )
 
@@ -22,8 +23,17 @@ type (
 		DNS        []string       `json:"dns"`
 		CASecPaths []string       `json:"ca_sec_paths"`
 		Listener   ConfigListener `json:"listener"`
+		DNSServer  ConfigDNS      `json:"dns_server"`
+		Audit      ConfigAudit    `json:"audit"`
 		Quorum     bool           `json:"quorum"`
 
+		// Roles are the custom roles defined in the role#<name> sections.
+		Roles rbac.CustomRoles `json:"roles"`
+
+		// Remotes are the remote clusters defined in the remote#<name>
+		// sections, referenced by the path@cluster:<name> relations.
+		Remotes ConfigRemotes `json:"remotes"`
+
 		// fields private, no exposed in daemon data
 		// json nor events
 		secret string
@@ -31,6 +41,19 @@ type (
 		sshKeyFile string
 	}
 
+	// ConfigRemote describes how to watch the objects of a remote cluster.
+	// Credentials is the path of the local sec or usr object storing the
+	// credentials of the remote cluster api.
+	ConfigRemote struct {
+		Name        string   `json:"name"`
+		URLs        []string `json:"urls"`
+		Credentials string   `json:"credentials"`
+		Username    string   `json:"username"`
+		Insecure    bool     `json:"insecure"`
+	}
+
+	ConfigRemotes []ConfigRemote
+
 	RateLimiterConfig struct {
 		Rate    rate.Limit    `json:"rate"`
 		Burst   int           `json:"burst"`
@@ -47,6 +70,37 @@ type (
 		DNSSockUID     string            `json:"dns_sock_uid"`
 		RateLimiter    RateLimiterConfig `json:"rate_limiter"`
 	}
+
+	// ConfigAudit describes the daemon api audit journal rotation and
+	// forwarding. The forwarding is disabled when ForwardURL is empty.
+	ConfigAudit struct {
+		MaxSize        int64         `json:"max_size"`
+		MaxBackups     int           `json:"max_backups"`
+		ForwardURL     string        `json:"forward_url"`
+		ForwardTimeout time.Duration `json:"forward_timeout"`
+	}
+
+	// ConfigDNS describes the embedded authoritative dns server serving
+	// the cluster zone, and the RFC2136 dynamic updates client pushing the
+	// zone records to an external dns server.
+	// The server is disabled when Addr is empty.
+	// The updates client is disabled when UpdateServer is empty.
+	ConfigDNS struct {
+		Addr        string   `json:"addr"`
+		Port        int      `json:"port"`
+		AXFRAllow   []string `json:"axfr_allow"`
+		HealthAware bool     `json:"health_aware"`
+
+		UpdateServer            string        `json:"update_server"`
+		UpdateZone              string        `json:"update_zone"`
+		UpdateTSIGKeyName       string        `json:"update_tsig_key_name"`
+		UpdateTSIGAlgorithm     string        `json:"update_tsig_algorithm"`
+		UpdateReconcileInterval time.Duration `json:"update_reconcile_interval"`
+
+		// fields private, no exposed in daemon data
+		// json nor events
+		updateTSIGSecret string
+	}
 )
 
 func (t *Config) Secret() string {
@@ -78,12 +132,64 @@ func (t *Config) DeepCopy() *Config {
 		DNS:        append([]string{}, t.DNS...),
 		CASecPaths: append([]string{}, t.CASecPaths...),
 		Listener:   t.Listener,
+		DNSServer:  *t.DNSServer.DeepCopy(),
+		Audit:      t.Audit,
 		Quorum:     t.Quorum,
+		Roles:      t.Roles.DeepCopy(),
+		Remotes:    t.Remotes.DeepCopy(),
 		secret:     t.secret,
 		sshKeyFile: t.sshKeyFile,
 	}
 }
 
+func (t ConfigRemotes) DeepCopy() ConfigRemotes {
+	if t == nil {
+		return nil
+	}
+	l := make(ConfigRemotes, len(t))
+	for i, remote := range t {
+		l[i] = remote
+		l[i].URLs = append([]string{}, remote.URLs...)
+	}
+	return l
+}
+
+// Lookup returns the remote cluster named name.
+func (t ConfigRemotes) Lookup(name string) (ConfigRemote, bool) {
+	for _, remote := range t {
+		if remote.Name == name {
+			return remote, true
+		}
+	}
+	return ConfigRemote{}, false
+}
+
+func (t *ConfigDNS) DeepCopy() *ConfigDNS {
+	return &ConfigDNS{
+		Addr:        t.Addr,
+		Port:        t.Port,
+		AXFRAllow:   append([]string{}, t.AXFRAllow...),
+		HealthAware: t.HealthAware,
+
+		UpdateServer:            t.UpdateServer,
+		UpdateZone:              t.UpdateZone,
+		UpdateTSIGKeyName:       t.UpdateTSIGKeyName,
+		UpdateTSIGAlgorithm:     t.UpdateTSIGAlgorithm,
+		UpdateReconcileInterval: t.UpdateReconcileInterval,
+		updateTSIGSecret:        t.updateTSIGSecret,
+	}
+}
+
+// UpdateTSIGSecret returns the base64 encoded TSIG secret used to sign the
+// dynamic update requests.
+func (t *ConfigDNS) UpdateTSIGSecret() string {
+	return t.updateTSIGSecret
+}
+
+func (t *ConfigDNS) SetUpdateTSIGSecret(s string) {
+	t.updateTSIGSecret = s
+}
+
 // SSHKeyFile returns the configured SSH key file path and a boolean indicating
 // if the file exists and is regular.
 func (t *Config) SSHKeyFile() (string, bool) {
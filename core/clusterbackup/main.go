@@ -0,0 +1,358 @@
+// Package clusterbackup implements the encrypted archive format used by
+// the 'om cluster backup' and 'om cluster restore' commands.
+//
+// An archive is a header followed by a AES-256-GCM encrypted gzipped tar
+// stream. The encryption key is derived from a passphrase, so an archive
+// can be restored on a cluster with a different secret. The tar stream
+// starts with a manifest.json member listing the archived files and their
+// sha256 checksums.
+package clusterbackup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/naming"
+)
+
+type (
+	// Kind is the type of an archived file.
+	Kind string
+
+	// Entry describes an archived file.
+	Entry struct {
+		// Kind is the type of the archived file.
+		Kind Kind `json:"kind"`
+
+		// File is the name of the tar member holding the file content.
+		File string `json:"file"`
+
+		// Node is the node name of a node config entry.
+		Node string `json:"node,omitempty"`
+
+		// Path is the object path of an object config or key entry.
+		Path string `json:"path,omitempty"`
+
+		// Key is the key name of a key entry.
+		Key string `json:"key,omitempty"`
+
+		// Size is the file content length.
+		Size int `json:"size"`
+
+		// Checksum is the hex encoded sha256 sum of the file content.
+		Checksum string `json:"checksum"`
+	}
+
+	// Manifest describes the archive content.
+	Manifest struct {
+		// Version is the archive format version.
+		Version int `json:"version"`
+
+		// CreatedAt is the archive creation time.
+		CreatedAt time.Time `json:"created_at"`
+
+		// Node is the name of the node the archive was created from.
+		Node string `json:"node"`
+
+		// Entries lists the archived files.
+		Entries []Entry `json:"entries"`
+	}
+
+	// Archive is the decrypted content of a backup.
+	Archive struct {
+		Manifest Manifest
+		files    map[string][]byte
+	}
+)
+
+const (
+	// KindCluster is the cluster.conf entry kind.
+	KindCluster Kind = "cluster"
+
+	// KindNode is a node config entry kind.
+	KindNode Kind = "node"
+
+	// KindObject is an object config entry kind.
+	KindObject Kind = "object"
+
+	// KindKey is a cfg, sec or usr object decoded key entry kind.
+	KindKey Kind = "key"
+
+	// Version is the archive format version written by this package.
+	Version = 1
+
+	manifestFile = "manifest.json"
+	magic        = "OSVCBKP\x00"
+	saltSize     = 16
+	keySize      = 32
+)
+
+var (
+	// Iterations is the number of pbkdf2 iterations used to derive the
+	// encryption key. Archives with a different number of iterations are
+	// rejected, so a corrupted header can not weaken the key or stall the
+	// key derivation.
+	Iterations = 600000
+
+	// ErrFormat is returned when the input is not a backup archive, or has
+	// an unsupported version or number of pbkdf2 iterations.
+	ErrFormat = errors.New("invalid backup archive format")
+
+	// ErrDecrypt is returned when the archive can not be decrypted, either
+	// because the passphrase is wrong or because the archive is corrupted.
+	ErrDecrypt = errors.New("backup archive decrypt failed: wrong passphrase or corrupted archive")
+
+	// ErrChecksum is returned when a archived file content does not match
+	// its manifest checksum.
+	ErrChecksum = errors.New("backup archive checksum mismatch")
+)
+
+// New returns an empty archive.
+func New(nodename string) *Archive {
+	return &Archive{
+		Manifest: Manifest{
+			Version:   Version,
+			CreatedAt: time.Now(),
+			Node:      nodename,
+			Entries:   make([]Entry, 0),
+		},
+		files: make(map[string][]byte),
+	}
+}
+
+// AddCluster adds the cluster.conf content to the archive.
+func (t *Archive) AddCluster(b []byte) {
+	t.add(Entry{Kind: KindCluster, File: "cluster.conf"}, b)
+}
+
+// AddNode adds the config content of the node nodename to the archive.
+func (t *Archive) AddNode(nodename string, b []byte) {
+	t.add(Entry{Kind: KindNode, File: path.Join("nodes", nodename+".conf"), Node: nodename}, b)
+}
+
+// AddObject adds the config content of the object p to the archive.
+func (t *Archive) AddObject(p naming.Path, b []byte) {
+	t.add(Entry{Kind: KindObject, File: path.Join("objects", p.String()+".conf"), Path: p.String()}, b)
+}
+
+// AddKey adds the decoded value of the key name of the datastore object p
+// to the archive.
+func (t *Archive) AddKey(p naming.Path, name string, b []byte) {
+	file := path.Join("keys", p.String(), fmt.Sprint(len(t.Manifest.Entries)))
+	t.add(Entry{Kind: KindKey, File: file, Path: p.String(), Key: name}, b)
+}
+
+// Data returns the content of the archived file described by e.
+func (t *Archive) Data(e Entry) []byte {
+	return t.files[e.File]
+}
+
+// Paths returns the paths of the archived object configs.
+func (t *Archive) Paths() naming.Paths {
+	l := make(naming.Paths, 0)
+	for _, e := range t.Manifest.Entries {
+		if e.Kind != KindObject {
+			continue
+		}
+		if p, err := naming.ParsePath(e.Path); err == nil {
+			l = append(l, p)
+		}
+	}
+	return l
+}
+
+func (t *Archive) add(e Entry, b []byte) {
+	e.Size = len(b)
+	e.Checksum = checksum(b)
+	t.Manifest.Entries = append(t.Manifest.Entries, e)
+	t.files[e.File] = b
+}
+
+// Write writes the archive encrypted with a key derived from passphrase.
+func (t *Archive) Write(w io.Writer, passphrase []byte) error {
+	var buff bytes.Buffer
+	if err := t.writeTar(&buff); err != nil {
+		return err
+	}
+	header := make([]byte, 0, len(magic)+6+saltSize)
+	header = append(header, magic...)
+	header = binary.BigEndian.AppendUint16(header, Version)
+	header = binary.BigEndian.AppendUint32(header, uint32(Iterations))
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	header = append(header, salt...)
+	aead, err := newAEAD(passphrase, salt, Iterations)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	header = append(header, nonce...)
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err = w.Write(aead.Seal(nil, nonce, buff.Bytes(), header))
+	return err
+}
+
+// Read decrypts an archive with a key derived from passphrase, and verifies
+// the archived files against the manifest checksums.
+func Read(r io.Reader, passphrase []byte) (*Archive, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	headerSize := len(magic) + 6 + saltSize
+	if len(b) < headerSize || string(b[:len(magic)]) != magic {
+		return nil, ErrFormat
+	}
+	version := binary.BigEndian.Uint16(b[len(magic):])
+	if version != Version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrFormat, version)
+	}
+	iterations := binary.BigEndian.Uint32(b[len(magic)+2:])
+	if int64(iterations) != int64(Iterations) {
+		return nil, fmt.Errorf("%w: unsupported pbkdf2 iterations %d", ErrFormat, iterations)
+	}
+	salt := b[len(magic)+6 : headerSize]
+	aead, err := newAEAD(passphrase, salt, int(iterations))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < headerSize+aead.NonceSize() {
+		return nil, ErrFormat
+	}
+	header := b[:headerSize+aead.NonceSize()]
+	nonce := header[headerSize:]
+	plain, err := aead.Open(nil, nonce, b[len(header):], header)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	t := &Archive{files: make(map[string][]byte)}
+	if err := t.readTar(bytes.NewReader(plain)); err != nil {
+		return nil, err
+	}
+	if err := t.verify(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *Archive) writeTar(w io.Writer) error {
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	manifest, err := json.MarshalIndent(t.Manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	writeFile := func(name string, b []byte) error {
+		hdr := &tar.Header{
+			Name:    name,
+			Mode:    0600,
+			Size:    int64(len(b)),
+			ModTime: t.Manifest.CreatedAt,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		_, err := tw.Write(b)
+		return err
+	}
+	if err := writeFile(manifestFile, manifest); err != nil {
+		return err
+	}
+	for _, e := range t.Manifest.Entries {
+		if err := writeFile(e.File, t.files[e.File]); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
+
+func (t *Archive) readTar(r io.Reader) error {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFormat, err)
+	}
+	defer gzr.Close()
+	tr := tar.NewReader(gzr)
+	hasManifest := false
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("%w: %w", ErrFormat, err)
+		}
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrFormat, hdr.Name, err)
+		}
+		if hdr.Name == manifestFile {
+			if err := json.Unmarshal(b, &t.Manifest); err != nil {
+				return fmt.Errorf("%w: %s: %w", ErrFormat, manifestFile, err)
+			}
+			hasManifest = true
+			continue
+		}
+		t.files[hdr.Name] = b
+	}
+	if !hasManifest {
+		return fmt.Errorf("%w: missing %s", ErrFormat, manifestFile)
+	}
+	return nil
+}
+
+func (t *Archive) verify() error {
+	for _, e := range t.Manifest.Entries {
+		b, ok := t.files[e.File]
+		if !ok {
+			return fmt.Errorf("%w: %s: missing file", ErrChecksum, e.File)
+		}
+		if len(b) != e.Size || checksum(b) != e.Checksum {
+			return fmt.Errorf("%w: %s", ErrChecksum, e.File)
+		}
+	}
+	return nil
+}
+
+func newAEAD(passphrase, salt []byte, iterations int) (cipher.AEAD, error) {
+	if len(passphrase) == 0 {
+		return nil, fmt.Errorf("empty backup archive passphrase")
+	}
+	key, err := pbkdf2.Key(sha256.New, string(passphrase), salt, iterations, keySize)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func checksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
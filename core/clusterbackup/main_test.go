@@ -0,0 +1,65 @@
+package clusterbackup
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/naming"
+)
+
+func testArchive(t *testing.T) *Archive {
+	t.Helper()
+	Iterations = 1000
+	p, err := naming.ParsePath("ns1/sec/s1")
+	require.NoError(t, err)
+	a := New("node1")
+	a.AddCluster([]byte("[cluster]\nname = c1\n"))
+	a.AddNode("node1", []byte("[node]\n"))
+	a.AddObject(p, []byte("[DEFAULT]\n"))
+	a.AddKey(p, "a/b", []byte{0, 1, 2})
+	return a
+}
+
+func TestReadWrite(t *testing.T) {
+	a := testArchive(t)
+	var buff bytes.Buffer
+	require.NoError(t, a.Write(&buff, []byte("secret")))
+
+	b, err := Read(bytes.NewReader(buff.Bytes()), []byte("secret"))
+	require.NoError(t, err)
+	require.Equal(t, a.Manifest.Entries, b.Manifest.Entries)
+	require.Equal(t, []string{"ns1/sec/s1"}, b.Paths().StrSlice())
+	for _, e := range a.Manifest.Entries {
+		require.Equal(t, a.Data(e), b.Data(e), e.File)
+	}
+
+	_, err = Read(bytes.NewReader(buff.Bytes()), []byte("wrong"))
+	require.ErrorIs(t, err, ErrDecrypt)
+
+	_, err = Read(bytes.NewReader([]byte("not an archive")), []byte("secret"))
+	require.ErrorIs(t, err, ErrFormat)
+}
+
+func TestReadChecksumMismatch(t *testing.T) {
+	a := testArchive(t)
+	a.files["cluster.conf"] = []byte("[cluster]\nname = c2\n")
+	var buff bytes.Buffer
+	require.NoError(t, a.Write(&buff, []byte("secret")))
+	_, err := Read(&buff, []byte("secret"))
+	require.ErrorIs(t, err, ErrChecksum)
+}
+
+func TestReadIterations(t *testing.T) {
+	a := testArchive(t)
+	var buff bytes.Buffer
+	require.NoError(t, a.Write(&buff, []byte("secret")))
+	for _, iterations := range []uint32{0, 1<<32 - 1} {
+		b := bytes.Clone(buff.Bytes())
+		binary.BigEndian.PutUint32(b[len(magic)+2:], iterations)
+		_, err := Read(bytes.NewReader(b), []byte("secret"))
+		require.ErrorIs(t, err, ErrFormat)
+	}
+}
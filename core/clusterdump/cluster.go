@@ -89,6 +89,27 @@ func (s *Data) ObjectPaths() naming.Paths {
 	return allPaths
 }
 
+// ObjectStatus returns the aggregated status of the object p, or nil if
+// the object is not in the dataset.
+func (s *Data) ObjectStatus(p naming.Path) *object.Status {
+	if v, ok := s.Cluster.Object[p.String()]; ok {
+		return &v
+	}
+	return nil
+}
+
+// InstanceStatus returns the status of the p instances, indexed by node
+// name.
+func (s *Data) InstanceStatus(p naming.Path) map[string]*instance.Status {
+	m := make(map[string]*instance.Status)
+	for nodename, nodeData := range s.Cluster.Node {
+		if inst, ok := nodeData.Instance[p.String()]; ok && inst.Status != nil {
+			m[nodename] = inst.Status
+		}
+	}
+	return m
+}
+
 // WithSelector purges the dataset from objects not matching the selector expression
 func (s *Data) WithSelector(selector string) *Data {
 	if selector == "" {
@@ -97,6 +118,7 @@ func (s *Data) WithSelector(selector string) *Data {
 	paths, err := objectselector.New(
 		selector,
 		objectselector.WithPaths(s.ObjectPaths()),
+		objectselector.WithStatus(s),
 	).ExpandRelaxed()
 	if err != nil {
 		return s
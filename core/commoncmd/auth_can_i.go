@@ -0,0 +1,85 @@
+package commoncmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+type (
+	CmdAuthCanI struct {
+		Color  string
+		Output string
+		Verb   string
+		Path   string
+	}
+)
+
+func NewCmdAuthCanI() *cobra.Command {
+	var options CmdAuthCanI
+	cmd := &cobra.Command{
+		Use:   "can-i <verb> <path>",
+		Short: "explain if the current user can apply a verb to an object",
+		Long: "Explain if the current user grants allow a verb on an object, and which grant allows or denies it.\n\n" +
+			"The builtin roles and the custom roles defined in the cluster config role#<name> sections are evaluated.\n\n" +
+			"The verbs are get, decode, set, set_key, the object or instance action names like start, stop or\n" +
+			"sync_ingest, and console, clear, enable, disable.",
+		Example: "  om auth can-i restart ns1/svc/web\n" +
+			"  om auth can-i decode ns1/sec/tls",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Verb = args[0]
+			options.Path = args[1]
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	FlagColor(flags, &options.Color)
+	FlagOutput(flags, &options.Output)
+	return cmd
+}
+
+func (t *CmdAuthCanI) Run() error {
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	params := api.GetAuthCanIParams{
+		Verb: t.Verb,
+		Path: t.Path,
+	}
+	resp, err := c.GetAuthCanIWithResponse(context.Background(), &params)
+	if err != nil {
+		return err
+	}
+	switch {
+	case resp.JSON200 != nil:
+	case resp.JSON400 != nil:
+		return fmt.Errorf("%s", *resp.JSON400)
+	case resp.JSON401 != nil:
+		return fmt.Errorf("%s", *resp.JSON401)
+	default:
+		return fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+	data := *resp.JSON200
+	output.Renderer{
+		HumanRenderer: func() string {
+			answer := "no"
+			if data.Allowed {
+				answer = "yes"
+			}
+			return fmt.Sprintf("%s: %s\n", answer, data.Reason)
+		},
+		Output:   t.Output,
+		Color:    t.Color,
+		Data:     data,
+		Colorize: rawconfig.Colorize,
+	}.Print()
+	return nil
+}
@@ -0,0 +1,140 @@
+package commoncmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/nodeselector"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+type (
+	CmdDaemonAuditLog struct {
+		Color        string
+		Output       string
+		NodeSelector string
+		Since        string
+		Until        string
+		User         string
+		Method       string
+		Path         string
+		Target       string
+		Route        string
+		Failed       bool
+		Limit        int
+	}
+)
+
+func NewCmdDaemonAuditLog() *cobra.Command {
+	var options CmdDaemonAuditLog
+	cmd := &cobra.Command{
+		Use:   "log",
+		Short: "read the journal of the mutating api requests",
+		Long: "Read the audit journal of the mutating api requests served by the selected node daemons.\n\n" +
+			"Each record describes the authenticated user, the authentication strategy and grants, the source\n" +
+			"address, the route, the targeted object and node, the request id, the result status and the duration.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	FlagColor(flags, &options.Color)
+	FlagOutput(flags, &options.Output)
+	FlagNodeSelector(flags, &options.NodeSelector)
+	flags.StringVar(&options.Since, "since", "", "select the records more recent than this duration ago or this RFC3339 date (ex: 24h)")
+	flags.StringVar(&options.Until, "until", "", "select the records older than this duration ago or this RFC3339 date")
+	flags.StringVar(&options.User, "user", "", "select the records of this user")
+	flags.StringVar(&options.Method, "method", "", "select the records with this http method (ex: POST)")
+	flags.StringVar(&options.Path, "path", "", "select the records targeting this object path")
+	flags.StringVar(&options.Target, "target", "", "select the records targeting this node name")
+	flags.StringVar(&options.Route, "route", "", "select the records with a route containing this string")
+	flags.BoolVar(&options.Failed, "failed", false, "select the records with a status code >= 400")
+	flags.IntVar(&options.Limit, "limit", 0, "the maximum number of records per node, the most recent being kept")
+	return cmd
+}
+
+func (t *CmdDaemonAuditLog) params() *api.GetDaemonAuditLogParams {
+	params := &api.GetDaemonAuditLogParams{}
+	setString := func(p **string, s string) {
+		if s != "" {
+			*p = &s
+		}
+	}
+	setString(&params.Since, t.Since)
+	setString(&params.Until, t.Until)
+	setString(&params.User, t.User)
+	setString(&params.Method, t.Method)
+	setString(&params.Path, t.Path)
+	setString(&params.Target, t.Target)
+	setString(&params.Route, t.Route)
+	if t.Failed {
+		params.Failed = &t.Failed
+	}
+	if t.Limit > 0 {
+		params.Limit = &t.Limit
+	}
+	return params
+}
+
+func (t *CmdDaemonAuditLog) extract(c *client.T, nodename string) (api.AuditRecordList, error) {
+	resp, err := c.GetDaemonAuditLogWithResponse(context.Background(), nodename, t.params())
+	if err != nil {
+		return api.AuditRecordList{}, err
+	}
+	switch resp.StatusCode() {
+	case 200:
+		return *resp.JSON200, nil
+	case 400:
+		return api.AuditRecordList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON400)
+	case 401:
+		return api.AuditRecordList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON401)
+	case 403:
+		return api.AuditRecordList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON403)
+	default:
+		return api.AuditRecordList{}, fmt.Errorf("%s: unexpected statuscode: %s", nodename, resp.Status())
+	}
+}
+
+func (t *CmdDaemonAuditLog) Run() error {
+	var errs error
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	nodenames := []string{"localhost"}
+	if t.NodeSelector != "" {
+		nodenames, err = nodeselector.New(t.NodeSelector, nodeselector.WithClient(c)).Expand()
+		if err != nil {
+			return err
+		}
+	}
+	data := api.AuditRecordList{
+		Kind:  "AuditRecordList",
+		Items: make(api.AuditRecordItems, 0),
+	}
+	for _, nodename := range nodenames {
+		if d, err := t.extract(c, nodename); err != nil {
+			errs = errors.Join(errs, err)
+		} else {
+			data.Items = append(data.Items, d.Items...)
+		}
+	}
+	sort.SliceStable(data.Items, func(i, j int) bool {
+		return data.Items[i].Data.At.Before(data.Items[j].Data.At)
+	})
+	output.Renderer{
+		DefaultOutput: "tab=AT:data.at,NODE:meta.node,USER:data.user,ADDRESS:data.address,METHOD:data.method,URI:data.uri,STATUS:data.status",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          data,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return errs
+}
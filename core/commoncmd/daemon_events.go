@@ -268,7 +268,11 @@ func (t *CmdDaemonEvents) nodeEventLoop(ctx context.Context, nodename string) {
 		ctx, cancel = context.WithTimeout(ctx, t.Duration)
 		defer cancel()
 	}
-	evReader, err := t.getEvReader(ctx, nodename)
+	// lastID is the id of the last event read, used to resume the
+	// stream after a reconnection
+	var lastID *uint64
+
+	evReader, err := t.getEvReader(ctx, nodename, lastID)
 	if err != nil {
 		t.errC <- fmt.Errorf("getEvReader %s: %w", nodename, err)
 		return
@@ -298,6 +302,7 @@ func (t *CmdDaemonEvents) nodeEventLoop(ctx context.Context, nodename string) {
 			if err != nil {
 				break
 			}
+			lastID = &ev.ID
 			t.evC <- ev
 		}
 		for { // get reader retry loop
@@ -324,7 +329,7 @@ func (t *CmdDaemonEvents) nodeEventLoop(ctx context.Context, nodename string) {
 				_, _ = fmt.Fprintf(os.Stderr, "event read failed for node %s: '%s'\n", nodename, err)
 				_, _ = fmt.Fprintln(os.Stderr, "press ctrl+c to interrupt retries")
 			}
-			evReader, err = t.getEvReader(ctx, nodename)
+			evReader, err = t.getEvReader(ctx, nodename, lastID)
 			if err == nil {
 				_, _ = fmt.Fprintf(os.Stderr, "retry %d of %d ok for %s\n", retries, maxRetries, nodename)
 				retries = 0
@@ -335,8 +340,12 @@ func (t *CmdDaemonEvents) nodeEventLoop(ctx context.Context, nodename string) {
 	}
 }
 
-func (t *CmdDaemonEvents) getEvReader(ctx context.Context, nodename string) (event.ReadCloser, error) {
-	return t.cli.NewGetEvents().
+func (t *CmdDaemonEvents) getEvReader(ctx context.Context, nodename string, since *uint64) (event.ReadCloser, error) {
+	getEvents := t.cli.NewGetEvents()
+	if since != nil {
+		getEvents = getEvents.SetSince(*since)
+	}
+	return getEvents.
 		SetRelatives(false).
 		SetLimit(t.Limit).
 		SetReplay(t.Wait || t.Replay).
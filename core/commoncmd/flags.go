@@ -89,6 +89,10 @@ func FlagsTo(flags *pflag.FlagSet, p *OptTo) {
 	DeprecatedFlagDownTo(flags, &p.DownTo)
 }
 
+func FlagBlueGreen(flags *pflag.FlagSet, p *bool) {
+	flags.BoolVar(p, "blue-green", false, "prewarm and verify the destination instance before stopping the source instance")
+}
+
 func FlagComplianceAttach(flags *pflag.FlagSet, p *bool) {
 	flags.BoolVar(p, "attach", false, "attach the modulesets selected for the compliance run")
 }
@@ -165,6 +169,10 @@ func FlagDryRun(flags *pflag.FlagSet, p *bool) {
 	flags.BoolVar(p, "dry-run", false, "show the action execution plan")
 }
 
+func FlagPlan(flags *pflag.FlagSet, p *bool) {
+	flags.BoolVar(p, "plan", false, "show the resource operations graph the action would execute, without executing it")
+}
+
 func FlagDuration(flags *pflag.FlagSet, p *time.Duration) {
 	flags.DurationVar(p, "duration", 0*time.Second, "duration")
 }
@@ -547,6 +555,10 @@ func FlagSections(flags *pflag.FlagSet, p *[]string) {
 	flags.StringSliceVar(p, "section", []string{}, "a configuration section")
 }
 
+func FlagMigrateTo(flags *pflag.FlagSet, p *string) {
+	flags.StringVar(p, "to", "", "the node to live migrate the instance to")
+}
+
 func FlagMoveTo(flags *pflag.FlagSet, p *string) {
 	flags.StringVar(p, "move-to", "", "live-migrate capable resources destination")
 }
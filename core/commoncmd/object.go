@@ -72,6 +72,15 @@ func NewCmdObjectKey(kind string) *cobra.Command {
 	}
 }
 
+func NewCmdObjectSnapshot(kind string) *cobra.Command {
+	return &cobra.Command{
+		GroupID: GroupIDSubsystems,
+		Use:     "snapshot",
+		Short:   "list, restore data snapshots",
+		Aliases: []string{"snap"},
+	}
+}
+
 func NewCmdObjectSSH(kind string) *cobra.Command {
 	return &cobra.Command{
 		GroupID: GroupIDSubsystems,
@@ -89,6 +98,22 @@ func NewCmdObjectSync(kind string) *cobra.Command {
 	}
 }
 
+func NewCmdObjectInstanceDRBD(kind string) *cobra.Command {
+	return &cobra.Command{
+		GroupID: GroupIDSubsystems,
+		Use:     "drbd",
+		Short:   "manage object instance drbd replicated disks",
+	}
+}
+
+func NewCmdObjectInstanceImage(kind string) *cobra.Command {
+	return &cobra.Command{
+		GroupID: GroupIDSubsystems,
+		Use:     "image",
+		Short:   "manage object instance container images",
+	}
+}
+
 func NewCmdObjectInstanceSync(kind string) *cobra.Command {
 	return &cobra.Command{
 		GroupID: GroupIDSubsystems,
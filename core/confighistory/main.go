@@ -0,0 +1,324 @@
+// Package confighistory keeps the revisions of an object configuration
+// file.
+//
+// The daemon records a new revision each time it detects a change of the
+// configuration file content. The revisions are stored in the object var
+// directory, with a json metadata file describing who changed the
+// configuration, when, and with which keyword operations.
+//
+// The code committing a configuration change can Annotate the change with
+// the author and the operations. The annotation is attached to the revision
+// with the same content checksum, whatever the order the annotation and the
+// revision are recorded in.
+package confighistory
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/keyop"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/util/file"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/key"
+	"github.com/opensvc/om3/v3/util/lock"
+)
+
+type (
+	// Revision describes a recorded configuration file content.
+	Revision struct {
+		ID        int       `json:"id"`
+		CreatedAt time.Time `json:"created_at"`
+		Node      string    `json:"node"`
+		Author    string    `json:"author"`
+		Ops       []string  `json:"ops"`
+		Checksum  string    `json:"checksum"`
+		Size      int       `json:"size"`
+	}
+
+	// Annotation describes who changed a configuration and how.
+	Annotation struct {
+		Author    string    `json:"author"`
+		Ops       []string  `json:"ops"`
+		Checksum  string    `json:"checksum"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	Revisions []Revision
+)
+
+const (
+	// DefaultMax is the default number of revisions kept per object
+	DefaultMax = 10
+
+	// annotationTTL is the maximum age of a pending annotation. Older
+	// annotations are ignored, as the change they describe was never
+	// detected.
+	annotationTTL = time.Minute
+
+	lockTimeout = 5 * time.Second
+
+	pendingFile = "pending.json"
+	lockFile    = "lock"
+)
+
+var (
+	ErrNotFound = errors.New("revision not found")
+)
+
+// Dir returns the directory hosting the revisions of the object p.
+func Dir(p naming.Path) string {
+	return filepath.Join(p.VarDir(), "config_history")
+}
+
+// Checksum returns the checksum of a configuration content, as stored in
+// Revision.Checksum.
+func Checksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// LocalUser returns the name of the user running the process, used as the
+// author of changes committed without the daemon api.
+func LocalUser() string {
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return strconv.Itoa(os.Getuid())
+}
+
+// Record records b as a new revision of the object p configuration, unless
+// it has the same content as the latest revision. The revisions beyond max
+// are pruned. A pending annotation with the same content checksum is
+// consumed to set the revision author and operations.
+func Record(p naming.Path, b []byte, max int) (Revision, bool, error) {
+	var (
+		rev     Revision
+		created bool
+	)
+	if max <= 0 {
+		max = DefaultMax
+	}
+	err := withLock(p, "config history record", func() error {
+		revs, err := List(p)
+		if err != nil {
+			return err
+		}
+		checksum := Checksum(b)
+		if n := len(revs); n > 0 && revs[n-1].Checksum == checksum {
+			rev = revs[n-1]
+			return nil
+		}
+		rev = Revision{
+			ID:        1,
+			CreatedAt: time.Now(),
+			Node:      hostname.Hostname(),
+			Checksum:  checksum,
+			Size:      len(b),
+			Ops:       []string{},
+		}
+		if n := len(revs); n > 0 {
+			rev.ID = revs[n-1].ID + 1
+		}
+		if a, ok := loadPending(p); ok && a.Checksum == checksum {
+			rev.Author = a.Author
+			rev.Ops = a.Ops
+			_ = os.Remove(filepath.Join(Dir(p), pendingFile))
+		}
+		if err := writeFile(contentFile(p, rev.ID), b); err != nil {
+			return err
+		}
+		if err := writeRevision(p, rev); err != nil {
+			return err
+		}
+		created = true
+		revs = append(revs, rev)
+		for len(revs) > max {
+			_ = os.Remove(contentFile(p, revs[0].ID))
+			_ = os.Remove(metaFile(p, revs[0].ID))
+			revs = revs[1:]
+		}
+		return nil
+	})
+	return rev, created, err
+}
+
+// Annotate attaches the author and operations to the revision of the
+// current object p configuration content. If the daemon has not recorded
+// this revision yet, the annotation is stored as pending, to be consumed
+// by Record.
+func Annotate(p naming.Path, author string, ops []string) error {
+	b, err := os.ReadFile(p.ConfigFile())
+	if err != nil {
+		return err
+	}
+	a := Annotation{
+		Author:    author,
+		Ops:       ops,
+		Checksum:  Checksum(b),
+		CreatedAt: time.Now(),
+	}
+	if a.Ops == nil {
+		a.Ops = []string{}
+	}
+	if err := os.MkdirAll(Dir(p), 0700); err != nil {
+		return err
+	}
+	return withLock(p, "config history annotate", func() error {
+		revs, err := List(p)
+		if err != nil {
+			return err
+		}
+		if n := len(revs); n > 0 && revs[n-1].Checksum == a.Checksum && revs[n-1].Author == "" {
+			rev := revs[n-1]
+			rev.Author = a.Author
+			rev.Ops = a.Ops
+			return writeRevision(p, rev)
+		}
+		b, err := json.Marshal(a)
+		if err != nil {
+			return err
+		}
+		return writeFile(filepath.Join(Dir(p), pendingFile), b)
+	})
+}
+
+// List returns the recorded revisions of the object p, sorted by id.
+func List(p naming.Path) (Revisions, error) {
+	revs := make(Revisions, 0)
+	entries, err := os.ReadDir(Dir(p))
+	if errors.Is(err, os.ErrNotExist) {
+		return revs, nil
+	} else if err != nil {
+		return revs, err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, ".json") || name == pendingFile {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(Dir(p), name))
+		if err != nil {
+			return revs, err
+		}
+		var rev Revision
+		if err := json.Unmarshal(b, &rev); err != nil {
+			return revs, fmt.Errorf("%s: %w", name, err)
+		}
+		revs = append(revs, rev)
+	}
+	sort.Slice(revs, func(i, j int) bool { return revs[i].ID < revs[j].ID })
+	return revs, nil
+}
+
+// Get returns the revision id of the object p, and its configuration
+// content.
+func Get(p naming.Path, id int) (Revision, []byte, error) {
+	var rev Revision
+	b, err := os.ReadFile(metaFile(p, id))
+	if errors.Is(err, os.ErrNotExist) {
+		return rev, nil, fmt.Errorf("%w: %d", ErrNotFound, id)
+	} else if err != nil {
+		return rev, nil, err
+	}
+	if err := json.Unmarshal(b, &rev); err != nil {
+		return rev, nil, err
+	}
+	content, err := os.ReadFile(contentFile(p, id))
+	if errors.Is(err, os.ErrNotExist) {
+		return rev, nil, fmt.Errorf("%w: %d", ErrNotFound, id)
+	}
+	return rev, content, err
+}
+
+// Delete removes all the recorded revisions of the object p.
+func Delete(p naming.Path) error {
+	return os.RemoveAll(Dir(p))
+}
+
+func loadPending(p naming.Path) (Annotation, bool) {
+	var a Annotation
+	b, err := os.ReadFile(filepath.Join(Dir(p), pendingFile))
+	if err != nil {
+		return a, false
+	}
+	if err := json.Unmarshal(b, &a); err != nil {
+		return a, false
+	}
+	if time.Since(a.CreatedAt) > annotationTTL {
+		return a, false
+	}
+	return a, true
+}
+
+func writeRevision(p naming.Path, rev Revision) error {
+	b, err := json.Marshal(rev)
+	if err != nil {
+		return err
+	}
+	return writeFile(metaFile(p, rev.ID), b)
+}
+
+func withLock(p naming.Path, intent string, f func() error) error {
+	if err := os.MkdirAll(Dir(p), 0700); err != nil {
+		return err
+	}
+	return lock.Func(filepath.Join(Dir(p), lockFile), lockTimeout, intent, f)
+}
+
+// writeFile atomically writes b to filename
+func writeFile(filename string, b []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".*")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	defer func() {
+		_ = os.Remove(tmpName)
+	}()
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpName, filename); err != nil {
+		return err
+	}
+	return file.Sync(filename)
+}
+
+func contentFile(p naming.Path, id int) string {
+	return filepath.Join(Dir(p), fmt.Sprintf("%d.conf", id))
+}
+
+func metaFile(p naming.Path, id int) string {
+	return filepath.Join(Dir(p), fmt.Sprintf("%d.json", id))
+}
+
+// Ops returns the description of configuration update operations, as
+// stored in Revision.Ops.
+func Ops(deletes []string, unsets []key.T, sets []keyop.T) []string {
+	l := make([]string, 0, len(deletes)+len(unsets)+len(sets))
+	for _, section := range deletes {
+		l = append(l, "delete "+section)
+	}
+	for _, k := range unsets {
+		l = append(l, "unset "+k.String())
+	}
+	for _, op := range sets {
+		l = append(l, "set "+op.String())
+	}
+	return l
+}
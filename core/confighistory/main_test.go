@@ -0,0 +1,69 @@
+package confighistory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/testhelper"
+)
+
+func TestRecordAndAnnotate(t *testing.T) {
+	testhelper.Setup(t)
+	p := naming.Path{Namespace: "root", Kind: naming.KindSvc, Name: "svc1"}
+	require.NoError(t, os.MkdirAll(filepath.Dir(p.ConfigFile()), 0755))
+
+	write := func(s string) []byte {
+		b := []byte(s)
+		require.NoError(t, os.WriteFile(p.ConfigFile(), b, 0644))
+		return b
+	}
+
+	t.Run("record deduplicates same content", func(t *testing.T) {
+		b := write("[DEFAULT]\nnodes = *\n")
+		rev, created, err := Record(p, b, 3)
+		require.NoError(t, err)
+		require.True(t, created)
+		require.Equal(t, 1, rev.ID)
+
+		_, created, err = Record(p, b, 3)
+		require.NoError(t, err)
+		require.False(t, created)
+	})
+
+	t.Run("annotation before record is consumed", func(t *testing.T) {
+		b := write("[DEFAULT]\nnodes = n1\n")
+		require.NoError(t, Annotate(p, "alice", []string{"set nodes=n1"}))
+		rev, created, err := Record(p, b, 3)
+		require.NoError(t, err)
+		require.True(t, created)
+		require.Equal(t, "alice", rev.Author)
+		require.Equal(t, []string{"set nodes=n1"}, rev.Ops)
+	})
+
+	t.Run("annotation after record updates the revision", func(t *testing.T) {
+		b := write("[DEFAULT]\nnodes = n2\n")
+		rev, _, err := Record(p, b, 3)
+		require.NoError(t, err)
+		require.Equal(t, "", rev.Author)
+		require.NoError(t, Annotate(p, "bob", []string{"set nodes=n2"}))
+		rev, content, err := Get(p, rev.ID)
+		require.NoError(t, err)
+		require.Equal(t, "bob", rev.Author)
+		require.Equal(t, b, content)
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		_, _, err := Record(p, write("[DEFAULT]\nnodes = n3\n"), 3)
+		require.NoError(t, err)
+		revs, err := List(p)
+		require.NoError(t, err)
+		require.Len(t, revs, 3)
+		require.Equal(t, 2, revs[0].ID)
+		_, _, err = Get(p, 1)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
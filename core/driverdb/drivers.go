@@ -11,11 +11,13 @@ import (
 	"github.com/opensvc/om3/v3/core/rawconfig"
 	_ "github.com/opensvc/om3/v3/drivers/arrayfreenas"
 	_ "github.com/opensvc/om3/v3/drivers/arrayhoc"
+	_ "github.com/opensvc/om3/v3/drivers/arraylio"
 	_ "github.com/opensvc/om3/v3/drivers/arraypure"
 	_ "github.com/opensvc/om3/v3/drivers/arraysymmetrix"
 	_ "github.com/opensvc/om3/v3/drivers/pooldirectory"
 	_ "github.com/opensvc/om3/v3/drivers/poolfreenas"
 	_ "github.com/opensvc/om3/v3/drivers/poolhoc"
+	_ "github.com/opensvc/om3/v3/drivers/poollio"
 	_ "github.com/opensvc/om3/v3/drivers/poolpure"
 	_ "github.com/opensvc/om3/v3/drivers/poolshm"
 
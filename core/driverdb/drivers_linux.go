@@ -7,6 +7,7 @@ import (
 	_ "github.com/opensvc/om3/v3/drivers/networkbridge"
 	_ "github.com/opensvc/om3/v3/drivers/networklo"
 	_ "github.com/opensvc/om3/v3/drivers/networkroutedbridge"
+	_ "github.com/opensvc/om3/v3/drivers/poolbtrfs"
 	_ "github.com/opensvc/om3/v3/drivers/pooldrbd"
 	_ "github.com/opensvc/om3/v3/drivers/poolloop"
 	_ "github.com/opensvc/om3/v3/drivers/poolrados"
@@ -14,6 +15,7 @@ import (
 	_ "github.com/opensvc/om3/v3/drivers/rescontainerdocker"
 	_ "github.com/opensvc/om3/v3/drivers/rescontainerkvm"
 	_ "github.com/opensvc/om3/v3/drivers/rescontainerlxc"
+	_ "github.com/opensvc/om3/v3/drivers/rescontainernspawn"
 	_ "github.com/opensvc/om3/v3/drivers/rescontaineroci"
 	_ "github.com/opensvc/om3/v3/drivers/rescontainerpodman"
 	_ "github.com/opensvc/om3/v3/drivers/rescontainervbox"
@@ -22,8 +24,11 @@ import (
 	_ "github.com/opensvc/om3/v3/drivers/resdiskrados"
 	_ "github.com/opensvc/om3/v3/drivers/resdiskzpool"
 	_ "github.com/opensvc/om3/v3/drivers/resdiskzvol"
+	_ "github.com/opensvc/om3/v3/drivers/resfsbtrfs"
 	_ "github.com/opensvc/om3/v3/drivers/resipcni"
 	_ "github.com/opensvc/om3/v3/drivers/resipnetns"
+	_ "github.com/opensvc/om3/v3/drivers/ressyncbtrfs"
+	_ "github.com/opensvc/om3/v3/drivers/ressynclvmsnap"
 	_ "github.com/opensvc/om3/v3/drivers/restaskdocker"
 	_ "github.com/opensvc/om3/v3/drivers/restaskoci"
 	_ "github.com/opensvc/om3/v3/drivers/restaskpodman"
@@ -3,6 +3,7 @@ package instance
 import (
 	"time"
 
+	"github.com/opensvc/om3/v3/core/maintenance"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/placement"
 	"github.com/opensvc/om3/v3/core/priority"
@@ -45,6 +46,34 @@ type (
 
 		// IsDisabled is true when DEFAULT.disable is true
 		IsDisabled bool `json:"is_disabled"`
+
+		// Images is the map of container image references, indexed by the
+		// id of the container and task resources using them.
+		Images map[string]string `json:"images,omitempty"`
+
+		// ImagePrepull is true when DEFAULT.image_prepull is true
+		ImagePrepull bool `json:"image_prepull,omitempty"`
+
+		// ImageDigestCheck is true when DEFAULT.image_digest_check is true
+		ImageDigestCheck bool `json:"image_digest_check,omitempty"`
+
+		// MaintenanceWindow is the DEFAULT.maintenance_window schedule expression
+		MaintenanceWindow string `json:"maintenance_window,omitempty"`
+
+		// MaintenanceMode is the DEFAULT.maintenance_mode value
+		MaintenanceMode maintenance.Mode `json:"maintenance_mode,omitempty"`
+
+		// SwitchPrewarm is the DEFAULT.switch_prewarm list of resource ids
+		// and driver groups started on a blue/green switch destination
+		// before the source instance stops.
+		SwitchPrewarm []string `json:"switch_prewarm,omitempty"`
+
+		// SwitchVerify is the DEFAULT.switch_verify command verifying a
+		// prewarmed blue/green switch destination.
+		SwitchVerify string `json:"switch_verify,omitempty"`
+
+		// SwitchVerifyTimeout is the DEFAULT.switch_verify_timeout value
+		SwitchVerifyTimeout time.Duration `json:"switch_verify_timeout,omitempty"`
 	}
 
 	FlexConfig struct {
@@ -115,6 +144,10 @@ func (cfg *ActorConfig) DeepCopy() *ActorConfig {
 	newCfg.Subsets = cfg.Subsets.DeepCopy()
 	newCfg.Resources = cfg.Resources.DeepCopy()
 	newCfg.Schedules = append([]schedule.Config{}, cfg.Schedules...)
+	newCfg.SwitchPrewarm = append([]string{}, cfg.SwitchPrewarm...)
+	if cfg.Images != nil {
+		newCfg.Images = xmap.Copy(cfg.Images)
+	}
 	return &newCfg
 }
 
@@ -165,6 +198,22 @@ func (t Config) Unstructured() map[string]any {
 		m["subsets"] = t.Subsets.Unstructured()
 		m["topology"] = t.Topology
 		m["stonith"] = t.Stonith
+		if len(t.Images) > 0 {
+			m["images"] = t.Images
+			m["image_prepull"] = t.ImagePrepull
+			m["image_digest_check"] = t.ImageDigestCheck
+		}
+		if t.MaintenanceWindow != "" {
+			m["maintenance_window"] = t.MaintenanceWindow
+			m["maintenance_mode"] = t.MaintenanceMode
+		}
+		if len(t.SwitchPrewarm) > 0 {
+			m["switch_prewarm"] = t.SwitchPrewarm
+		}
+		if t.SwitchVerify != "" {
+			m["switch_verify"] = t.SwitchVerify
+			m["switch_verify_timeout"] = t.SwitchVerifyTimeout
+		}
 		if t.ActorConfig.Flex != nil {
 			m["max"] = t.ActorConfig.Flex.Max
 			m["min"] = t.ActorConfig.Flex.Min
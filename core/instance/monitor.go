@@ -9,6 +9,8 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/opensvc/om3/v3/core/maintenance"
+	"github.com/opensvc/om3/v3/core/resource"
 	"github.com/opensvc/om3/v3/core/status"
 	"github.com/opensvc/om3/v3/util/xsession"
 )
@@ -50,6 +52,9 @@ type (
 		Resources               ResourceMonitors `json:"resources,omitempty"`
 		UpdatedAt               time.Time        `json:"updated_at"`
 
+		// MaintenanceWindow is the open object maintenance window, if any.
+		MaintenanceWindow *maintenance.Window `json:"maintenance_window,omitempty"`
+
 		Parents  map[string]status.T `json:"parents,omitempty"`
 		Children map[string]status.T `json:"children,omitempty"`
 	}
@@ -73,6 +78,10 @@ type (
 	// for an object instance.
 	ResourceMonitor struct {
 		Restart *ResourceMonitorRestart `json:"restart,omitempty"`
+
+		// ActionTimeout is the last action timeout of the resource, with
+		// the diagnostics of the terminated processes.
+		ActionTimeout *resource.ActionTimeout `json:"action_timeout,omitempty"`
 	}
 	ResourceMonitorRestart struct {
 		Remaining int       `json:"remaining,omitempty"`
@@ -90,6 +99,16 @@ type (
 	MonitorGlobalExpectOptionsPlacedAt struct {
 		Destination []string `json:"destination"`
 		Live        bool     `json:"live"`
+
+		// BlueGreen is true when the destination instance must prewarm
+		// and verify before the source instance stops.
+		BlueGreen bool `json:"blue_green"`
+	}
+
+	// MonitorGlobalExpectOptionsMoved is the options of the moved global
+	// expect, the live migration of a failover instance.
+	MonitorGlobalExpectOptionsMoved struct {
+		Destination string `json:"destination"`
 	}
 )
 
@@ -141,6 +160,15 @@ func (t *Monitor) UnmarshalJSON(b []byte) error {
 		} else {
 			mon.GlobalExpectOptions = options
 		}
+	case MonitorGlobalExpectMoved:
+		var options MonitorGlobalExpectOptionsMoved
+		if b, err := json.Marshal(mon.GlobalExpectOptions); err != nil {
+			return err
+		} else if err := json.Unmarshal(b, &options); err != nil {
+			return err
+		} else {
+			mon.GlobalExpectOptions = options
+		}
 	}
 	*t = Monitor(mon)
 	return nil
@@ -229,9 +257,14 @@ func (m ResourceMonitors) Get(rid string) *ResourceMonitor {
 func (m ResourceMonitors) DeepCopy() ResourceMonitors {
 	c := make(ResourceMonitors)
 	for k, v := range m {
-		c[k] = ResourceMonitor{
+		rmon := ResourceMonitor{
 			Restart: v.Restart.DeepCopy(),
 		}
+		if v.ActionTimeout != nil {
+			actionTimeout := *v.ActionTimeout
+			rmon.ActionTimeout = &actionTimeout
+		}
+		c[k] = rmon
 	}
 	return c
 }
@@ -247,6 +280,7 @@ func (t ResourceMonitors) Unstructured() map[string]map[string]any {
 func (mon Monitor) DeepCopy() *Monitor {
 	v := mon
 	v.Resources = v.Resources.DeepCopy()
+	v.MaintenanceWindow = mon.MaintenanceWindow.DeepCopy()
 	if mon.Parents != nil {
 		v.Parents = make(map[string]status.T, len(mon.Parents))
 		for k, s := range mon.Parents {
@@ -271,6 +305,12 @@ func (mon Monitor) DeepCopy() *Monitor {
 			// TODO Don't ignore following error
 			_ = json.Unmarshal(b, &placedAt)
 			v.GlobalExpectOptions = placedAt
+		case MonitorGlobalExpectMoved:
+			b, _ := json.Marshal(mon.GlobalExpectOptions)
+			var moved MonitorGlobalExpectOptionsMoved
+			// TODO Don't ignore following error
+			_ = json.Unmarshal(b, &moved)
+			v.GlobalExpectOptions = moved
 		// TODO add other cases for globalExpect values that requires GlobalExpectOptions
 		default:
 			b, _ := json.Marshal(mon.GlobalExpectOptions)
@@ -5,6 +5,7 @@ const (
 	MonitorGlobalExpectAborted
 	MonitorGlobalExpectDeleted
 	MonitorGlobalExpectFrozen
+	MonitorGlobalExpectMoved
 	MonitorGlobalExpectNone
 	MonitorGlobalExpectPlaced
 	MonitorGlobalExpectPlacedAt
@@ -34,6 +35,7 @@ func init() {
 		{MonitorGlobalExpectDeleted, "deleted"},
 		{MonitorGlobalExpectInit, "init"},
 		{MonitorGlobalExpectFrozen, "frozen"},
+		{MonitorGlobalExpectMoved, "moved"},
 		{MonitorGlobalExpectNone, "none"},
 		{MonitorGlobalExpectPlaced, "placed"},
 		{MonitorGlobalExpectPlacedAt, "placed@"},
@@ -54,6 +54,29 @@ const (
 	MonitorStatePurgeFailed
 	MonitorStateReady
 	MonitorStateRestarted
+
+	// blue/green switch states
+	MonitorStatePrewarmProgress
+	MonitorStatePrewarmFailure
+	MonitorStatePrewarmSuccess
+
+	MonitorStateRevertProgress
+	MonitorStateRevertFailure
+	MonitorStateRevertSuccess
+
+	// live migration states
+	MonitorStateMoveCheckProgress
+	MonitorStateMoveCheckFailure
+	MonitorStateMoveCheckSuccess
+
+	MonitorStateMoveProgress
+	MonitorStateMoveFailure
+	MonitorStateMoveSuccess
+
+	// MonitorStateMovePartialFailure is the state of a source instance
+	// whose move failed after a movable resource was migrated to the
+	// destination node.
+	MonitorStateMovePartialFailure
 )
 
 var (
@@ -63,7 +86,12 @@ var (
 	MonitorStatesFailure = []MonitorState{
 		MonitorStateDeleteFailure,
 		MonitorStateFreezeFailure,
+		MonitorStateMoveCheckFailure,
+		MonitorStateMoveFailure,
+		MonitorStateMovePartialFailure,
+		MonitorStatePrewarmFailure,
 		MonitorStateProvisionFailure,
+		MonitorStateRevertFailure,
 		MonitorStateShutdownFailure,
 		MonitorStateStartFailure,
 		MonitorStateStopFailure,
@@ -133,6 +161,25 @@ func init() {
 		{MonitorStatePurgeFailed, "purge failed"},
 		{MonitorStateReady, "ready"},
 		{MonitorStateRestarted, "restarted"},
+
+		// blue/green switch states
+		{MonitorStatePrewarmProgress, "prewarming"},
+		{MonitorStatePrewarmFailure, "prewarm failed"},
+		{MonitorStatePrewarmSuccess, "prewarmed"},
+
+		{MonitorStateRevertProgress, "reverting"},
+		{MonitorStateRevertFailure, "revert failed"},
+		{MonitorStateRevertSuccess, "reverted"},
+
+		// live migration states
+		{MonitorStateMoveCheckProgress, "move checking"},
+		{MonitorStateMoveCheckFailure, "move check failed"},
+		{MonitorStateMoveCheckSuccess, "move checked"},
+
+		{MonitorStateMoveProgress, "moving"},
+		{MonitorStateMoveFailure, "move failed"},
+		{MonitorStateMoveSuccess, "moved"},
+		{MonitorStateMovePartialFailure, "move partially failed"},
 	}
 
 	// Populate the maps
@@ -46,6 +46,16 @@ func Test_Monitor_Unmarshal(t *testing.T) {
 		require.Equalf(t, expected, monitor, "got %+v\nexpected %+v", monitor, expected)
 	})
 
+	t.Run("with moved global expect options", func(t *testing.T) {
+		b := []byte(`{"global_expect": "moved", "global_expect_options": {"destination": "node2"}, "state": "moving"}`)
+		var monitor Monitor
+		require.Nil(t, json.Unmarshal(b, &monitor))
+		require.Equal(t, MonitorGlobalExpectMoved, monitor.GlobalExpect)
+		require.Equal(t, MonitorGlobalExpectOptionsMoved{Destination: "node2"}, monitor.GlobalExpectOptions)
+		require.Equal(t, MonitorStateMoveProgress, monitor.State)
+		require.Equal(t, monitor.GlobalExpectOptions, monitor.DeepCopy().GlobalExpectOptions)
+	})
+
 	t.Run("DeprecatedRestart", func(t *testing.T) {
 		var monitor Monitor
 		path := filepath.Join("testdata", "monitor_deprecated_restart.json")
@@ -155,6 +155,11 @@ func (t States) descString() string {
 		case provisioned.Mixed:
 			l = append(l, rawconfig.Colorize.Error("mix-provisioned"))
 		}
+
+		// Drift
+		if t.Status.Drift {
+			l = append(l, rawconfig.Colorize.Warning("drift"))
+		}
 	}
 
 	if !t.Config.UpdatedAt.IsZero() {
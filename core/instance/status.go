@@ -9,6 +9,7 @@ import (
 	"github.com/opensvc/om3/v3/core/resource"
 	"github.com/opensvc/om3/v3/core/resourceid"
 	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/util/pg"
 )
 
 type (
@@ -17,11 +18,13 @@ type (
 	// Status describes the instance status.
 	Status struct {
 		Avail         status.T                 `json:"avail"`
+		Drift         bool                     `json:"drift,omitempty"`
 		Encap         EncapMap                 `json:"encap,omitempty"`
 		FrozenAt      time.Time                `json:"frozen_at,omitempty"`
 		LastStartedAt time.Time                `json:"last_started_at"`
 		Optional      status.T                 `json:"optional,omitempty"`
 		Overall       status.T                 `json:"overall"`
+		Pressure      *pg.Pressure             `json:"pressure,omitempty"`
 		Provisioned   provisioned.T            `json:"provisioned"`
 		Resources     ResourceStatuses         `json:"resources,omitempty"`
 		Running       resource.RunningInfoList `json:"running,omitempty"`
@@ -95,6 +98,7 @@ func (t Status) DeepCopy() *Status {
 	n.Running = append(resource.RunningInfoList{}, t.Running...)
 	n.Resources = t.Resources.DeepCopy()
 	n.Encap = t.Encap.DeepCopy()
+	n.Pressure = t.Pressure.DeepCopy()
 	return &n
 }
 
@@ -213,5 +217,8 @@ func (t Status) Unstructured() map[string]any {
 	if !t.FrozenAt.IsZero() {
 		m["frozen_at"] = t.FrozenAt
 	}
+	if t.Drift {
+		m["drift"] = t.Drift
+	}
 	return m
 }
@@ -0,0 +1,110 @@
+// Package maintenance defines the maintenance windows the daemon evaluates
+// to freeze a node or its objects, or to block their failover, during
+// planned operations.
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/opensvc/om3/v3/util/schedule"
+)
+
+type (
+	// Mode defines what the daemon does while a maintenance window is open.
+	Mode string
+
+	// Window describes an open maintenance window.
+	Window struct {
+		// Schedule is the schedule expression defining the window.
+		Schedule string `json:"schedule"`
+
+		// Mode is the daemon behaviour during the window.
+		Mode Mode `json:"mode"`
+
+		// OpenedAt is the time the daemon detected the window opening.
+		OpenedAt time.Time `json:"opened_at"`
+	}
+)
+
+const (
+	// ModeFreeze freezes the scoped node or object instances when the
+	// window opens, and unfreezes them when it closes.
+	ModeFreeze Mode = "freeze"
+
+	// ModeBlock blocks the automatic orchestrations of the scoped objects
+	// while the window is open. Operator actions are still allowed.
+	ModeBlock Mode = "block"
+)
+
+var (
+	// CheckInterval is the period of the maintenance windows evaluation.
+	CheckInterval = 30 * time.Second
+)
+
+// ParseMode returns the Mode for s. An empty s is the freeze mode.
+func ParseMode(s string) (Mode, error) {
+	switch Mode(s) {
+	case "", ModeFreeze:
+		return ModeFreeze, nil
+	case ModeBlock:
+		return ModeBlock, nil
+	default:
+		return "", fmt.Errorf("invalid maintenance mode: %s", s)
+	}
+}
+
+// IsOpen returns true if tm is in the window defined by the schedule
+// expression s. An empty s defines no window.
+func IsOpen(s string, tm time.Time) (bool, error) {
+	if s == "" {
+		return false, nil
+	}
+	return schedule.New(s).Includes(tm)
+}
+
+// frozenMarker returns the path of the file marking the frozen flag file p
+// as created by a maintenance window opening.
+func frozenMarker(p string) string {
+	return p + ".maintenance"
+}
+
+// MarkFrozen records the frozen flag file p was created by a maintenance
+// window opening, so the window closing removes it, even after a daemon
+// restart.
+func MarkFrozen(p string) error {
+	f, err := os.Create(frozenMarker(p))
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// IsMarkedFrozen returns true if the frozen flag file p was created by a
+// maintenance window opening.
+func IsMarkedFrozen(p string) bool {
+	_, err := os.Stat(frozenMarker(p))
+	return err == nil
+}
+
+// UnmarkFrozen removes the maintenance marker of the frozen flag file p.
+func UnmarkFrozen(p string) error {
+	if err := os.Remove(frozenMarker(p)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (t Mode) String() string {
+	return string(t)
+}
+
+func (t *Window) DeepCopy() *Window {
+	if t == nil {
+		return nil
+	}
+	v := *t
+	return &v
+}
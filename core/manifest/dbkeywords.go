@@ -10,6 +10,24 @@ import (
 var fs embed.FS
 
 var (
+	KWActionTimeout = keywords.Keyword{
+		Attr:      "ActionTimeout",
+		Converter: "duration",
+		Example:   "5m",
+		Option:    "action_timeout",
+		Scopable:  true,
+		Text:      keywords.NewText(fs, "text/kw/action_timeout"),
+	}
+
+	KWActionTimeoutGrace = keywords.Keyword{
+		Attr:      "ActionTimeoutGrace",
+		Converter: "duration",
+		Default:   "10s",
+		Option:    "action_timeout_grace",
+		Scopable:  true,
+		Text:      keywords.NewText(fs, "text/kw/action_timeout_grace"),
+	}
+
 	KWBlockingPostProvision = keywords.Keyword{
 		Attr:     "BlockingPostProvision",
 		Option:   "blocking_post_provision",
@@ -119,6 +137,14 @@ var (
 		Text:      keywords.NewText(fs, "text/kw/monitor"),
 	}
 
+	KWOnError = keywords.Keyword{
+		Attr:     "OnErrorCmd",
+		Example:  "/srv/{name}/data/scripts/on_error.sh",
+		Option:   "on_error",
+		Scopable: true,
+		Text:     keywords.NewText(fs, "text/kw/on_error"),
+	}
+
 	KWOptional = keywords.Keyword{
 		Attr:      "Optional",
 		Converter: "bool",
@@ -319,8 +345,11 @@ var (
 	}
 
 	starterKeywords = []*keywords.Keyword{
+		&KWActionTimeout,
+		&KWActionTimeoutGrace,
 		&KWBlockingPostStart,
 		&KWBlockingPreStart,
+		&KWOnError,
 		&KWPostStart,
 		&KWPreStart,
 		&KWRestart,
@@ -329,26 +358,35 @@ var (
 	}
 
 	stopperKeywords = []*keywords.Keyword{
+		&KWActionTimeout,
+		&KWActionTimeoutGrace,
 		&KWBlockingPostStop,
 		&KWBlockingPreStop,
+		&KWOnError,
 		&KWPostStop,
 		&KWPreStop,
 		&KWStopRequires,
 	}
 
 	provisionerKeywords = []*keywords.Keyword{
+		&KWActionTimeout,
+		&KWActionTimeoutGrace,
 		&KWBlockingPostProvision,
 		&KWBlockingPreProvision,
 		&KWEnableProvision,
+		&KWOnError,
 		&KWPostProvision,
 		&KWPreProvision,
 		&KWProvisionRequires,
 	}
 
 	unprovisionerKeywords = []*keywords.Keyword{
+		&KWActionTimeout,
+		&KWActionTimeoutGrace,
 		&KWBlockingPostUnprovision,
 		&KWBlockingPreUnprovision,
 		&KWEnableUnprovision,
+		&KWOnError,
 		&KWPostUnprovision,
 		&KWPreUnprovision,
 		&KWUnprovisionRequires,
@@ -39,6 +39,7 @@ func (f Frame) StrObjectInstance(path string, node string, scope []string) strin
 		s += sObjectInstanceFrozen(instanceStatus)
 		s += sObjectInstanceUnprovisioned(instanceStatus)
 		s += sObjectInstanceMonitorState(instanceMonitor)
+		s += sObjectInstanceMaintenanceWindow(instanceMonitor)
 		s += sObjectInstanceMonitorGlobalExpect(instanceMonitor)
 	} else if inst.Config != nil || slices.Contains(scope, node) {
 		s += iconUndef
@@ -125,6 +126,13 @@ func sObjectInstanceMonitorState(instanceMonitor instance.Monitor) string {
 	}
 }
 
+func sObjectInstanceMaintenanceWindow(instanceMonitor instance.Monitor) string {
+	if w := instanceMonitor.MaintenanceWindow; w != nil {
+		return hiblue(" maintenance:" + w.Mode.String())
+	}
+	return ""
+}
+
 func sObjectInstanceMonitorGlobalExpect(instanceMonitor instance.Monitor) string {
 	switch instanceMonitor.GlobalExpect {
 	case instance.MonitorGlobalExpectInit:
@@ -73,6 +73,7 @@ func (f Frame) StrNodeStates(n string) string {
 	var sb strings.Builder
 	sb.WriteString(f.sNodeMonState(n))
 	sb.WriteString(f.sNodeFrozen(n))
+	sb.WriteString(f.sNodeMaintenanceWindow(n))
 	sb.WriteString(f.sNodeMonTarget(n))
 	return sb.String()
 }
@@ -241,6 +242,15 @@ func (f Frame) sNodeFrozen(n string) string {
 	return ""
 }
 
+func (f Frame) sNodeMaintenanceWindow(n string) string {
+	if val, ok := f.Current.Cluster.Node[n]; ok {
+		if w := val.Status.MaintenanceWindow; w != nil {
+			return hiblue(" maintenance:" + w.Mode.String())
+		}
+	}
+	return ""
+}
+
 func (f Frame) sNodeMonTarget(n string) string {
 	if val, ok := f.Current.Cluster.Node[n]; ok {
 		var sb strings.Builder
@@ -3,13 +3,18 @@ package naming
 import "strings"
 
 type (
-	// Relation is an object path or an instance path (path@node).
+	// Relation is an object path, an instance path (path@node) or a remote
+	// cluster object path (path@cluster:<context>).
 	Relation string
 
 	// Relations is a slice of Relation
 	Relations []Relation
 )
 
+// RelationClusterPrefix is the prefix of the scope of a relation to an
+// object of a remote cluster, followed by the remote cluster context name.
+const RelationClusterPrefix = "cluster:"
+
 func (t Relation) String() string {
 	return string(t)
 }
@@ -19,14 +24,30 @@ func (t Relation) Split() (Path, string, error) {
 	return p, t.Node(), err
 }
 
+// Node returns the node of a path@node relation, or an empty string for
+// an object or a remote cluster object relation.
 func (t Relation) Node() string {
 	var s string
 	if strings.Contains(string(t), "@") {
 		s = strings.SplitN(string(t), "@", 2)[1]
 	}
+	if strings.HasPrefix(s, RelationClusterPrefix) {
+		return ""
+	}
 	return s
 }
 
+// Cluster returns the remote cluster context name of a
+// path@cluster:<context> relation, or an empty string for a local
+// relation.
+func (t Relation) Cluster() string {
+	_, s, _ := strings.Cut(string(t), "@")
+	if name, ok := strings.CutPrefix(s, RelationClusterPrefix); ok {
+		return name
+	}
+	return ""
+}
+
 func (t Relation) Path() (Path, error) {
 	var s string
 	if strings.Contains(string(t), "@") {
@@ -74,6 +95,11 @@ func (relations Relations) Strings() []string {
 // Implicitely local with scope:
 //
 //	children svc4@n1         => test/svc/svc4@n1
+//
+// Remote cluster object, the namespace policy is applied in the remote
+// cluster:
+//
+//	parents = db@cluster:c1  => test/svc/db@cluster:c1
 func ParseRelations(l []string, ns string) Relations {
 	relations := make(Relations, 0)
 	for _, s := range l {
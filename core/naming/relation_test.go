@@ -32,6 +32,11 @@ func TestParseRelation(t *testing.T) {
 			node:       "n1@n1",
 			ok:         true,
 		},
+		"svc1@cluster:c1": {
+			objectPath: "svc1",
+			node:       "",
+			ok:         true,
+		},
 	}
 	for input, test := range tests {
 		t.Logf("input: '%s'", input)
@@ -48,6 +53,14 @@ func TestParseRelation(t *testing.T) {
 	}
 }
 
+func TestRelationCluster(t *testing.T) {
+	assert.Equal(t, "c1", Relation("ns1/svc/svc1@cluster:c1").Cluster())
+	assert.Equal(t, "", Relation("ns1/svc/svc1@n1").Cluster())
+	assert.Equal(t, "", Relation("ns1/svc/svc1").Cluster())
+	relations := ParseRelations([]string{"db@cluster:c1"}, "test")
+	assert.Equal(t, Relations{"test/svc/db@cluster:c1"}, relations)
+}
+
 func TestParseRelations(t *testing.T) {
 	// Test cases from the docstring examples with namespace "test"
 	// Note: The docstring shows the expected Path representation (namespace/kind/name)
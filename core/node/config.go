@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/opensvc/om3/v3/core/collector"
+	"github.com/opensvc/om3/v3/core/maintenance"
 	"github.com/opensvc/om3/v3/core/schedule"
 	"github.com/opensvc/om3/v3/util/flatten"
 	"github.com/opensvc/om3/v3/util/label"
@@ -16,10 +17,13 @@ import (
 type (
 	Config struct {
 		Collector              *collector.Config `json:"collector,omitempty"`
+		ConfigHistoryMax       int               `json:"config_history_max"`
 		Env                    string            `json:"env"`
 		Hooks                  Hooks             `json:"hooks"`
 		Labels                 label.M           `json:"labels"`
 		MaintenanceGracePeriod time.Duration     `json:"maintenance_grace_period"`
+		MaintenanceMode        maintenance.Mode  `json:"maintenance_mode"`
+		MaintenanceWindow      string            `json:"maintenance_window"`
 		MaxParallel            int               `json:"max_parallel"`
 		MaxKeySize             int64             `json:"max_key_size"`
 		MinAvailMemPct         int               `json:"min_avail_mem_pct"`
@@ -53,6 +57,7 @@ func (cfg *Config) DeepCopy() *Config {
 
 func (c Config) Equal(other Config) bool {
 	if c.Env != other.Env ||
+		c.ConfigHistoryMax != other.ConfigHistoryMax ||
 		c.MaintenanceGracePeriod != other.MaintenanceGracePeriod ||
 		c.MaxParallel != other.MaxParallel ||
 		c.MaxKeySize != other.MaxKeySize ||
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/maintenance"
 	"github.com/opensvc/om3/v3/core/status"
 )
 
@@ -19,6 +20,9 @@ type (
 		IsOverloaded bool                        `json:"is_overloaded"`
 		BootedAt     time.Time                   `json:"booted_at"`
 
+		// MaintenanceWindow is the open node maintenance window, if any.
+		MaintenanceWindow *maintenance.Window `json:"maintenance_window,omitempty"`
+
 		// LeftAt is the last time the nmon advanced the last_shutdown file,
 		// i.e. the last time it proved it was alive and rejoined.
 		LeftAt time.Time `json:"left_at"`
@@ -67,5 +71,7 @@ func (t *Status) DeepCopy() *Status {
 	}
 	result.Gen = newGen
 
+	result.MaintenanceWindow = t.MaintenanceWindow.DeepCopy()
+
 	return &result
 }
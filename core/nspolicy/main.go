@@ -0,0 +1,289 @@
+// Package nspolicy implements the namespace quotas and policies defined
+// by the nscfg objects, and enforced by the daemon api at object create,
+// config update and provision time.
+package nspolicy
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/danwakefield/fnmatch"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+type (
+	// Policy defines the quotas and the allowed drivers and nodes of a
+	// namespace. The zero value allows everything.
+	Policy struct {
+		// MaxObjects is the maximum number of objects of a kind.
+		MaxObjects map[naming.Kind]int `json:"max_objects,omitempty"`
+
+		// MaxFlexInstances is the maximum sum of the flex objects
+		// flex_target. Zero means unlimited.
+		MaxFlexInstances int `json:"max_flex_instances,omitempty"`
+
+		// MaxPoolSize is the maximum total size of the volumes allocated
+		// from a pool, indexed by pool name.
+		MaxPoolSize map[string]int64 `json:"max_pool_size,omitempty"`
+
+		// AllowedDrivers is the list of <group>.<type> driver patterns
+		// allowed. Empty means all drivers are allowed.
+		AllowedDrivers []string `json:"allowed_drivers,omitempty"`
+
+		// DeniedDrivers is the list of <group>.<type> driver patterns
+		// denied.
+		DeniedDrivers []string `json:"denied_drivers,omitempty"`
+
+		// AllowedNodes is the list of node selector expressions the object
+		// nodes must be selected by. Empty means all nodes are allowed.
+		AllowedNodes []string `json:"allowed_nodes,omitempty"`
+	}
+
+	// Usage is the namespace usage accounted by the quotas.
+	Usage struct {
+		Objects       map[naming.Kind]int `json:"objects"`
+		FlexInstances int                 `json:"flex_instances"`
+		PoolSize      map[string]int64    `json:"pool_size"`
+	}
+
+	// Object is the contribution of an object to its namespace usage, and
+	// the object properties verified by the policy.
+	Object struct {
+		Path          naming.Path
+		FlexInstances int
+		PoolSize      map[string]int64
+		Drivers       []string
+		Nodes         []string
+
+		// AutoPoolVolumes is the list of volumes to allocate from a pool
+		// selected at provision time, so not accountable in the pool
+		// usage.
+		AutoPoolVolumes []string
+	}
+
+	// Report is the usage and policy of a namespace.
+	Report struct {
+		Namespace string `json:"namespace"`
+		Policy    Policy `json:"policy"`
+		Usage     Usage  `json:"usage"`
+	}
+)
+
+var (
+	// ErrViolation is the error wrapped by the policy checks errors.
+	ErrViolation = errors.New("namespace policy violation")
+)
+
+// NewUsage returns an empty namespace usage.
+func NewUsage() Usage {
+	return Usage{
+		Objects:  make(map[naming.Kind]int),
+		PoolSize: make(map[string]int64),
+	}
+}
+
+// Add accounts the object o in the usage.
+func (t *Usage) Add(o Object) {
+	t.Objects[o.Path.Kind]++
+	t.FlexInstances += o.FlexInstances
+	for pool, size := range o.PoolSize {
+		t.PoolSize[pool] += size
+	}
+}
+
+// Del removes the object o from the usage.
+func (t *Usage) Del(o Object) {
+	t.Objects[o.Path.Kind]--
+	t.FlexInstances -= o.FlexInstances
+	for pool, size := range o.PoolSize {
+		t.PoolSize[pool] -= size
+	}
+}
+
+func (t Usage) copy() Usage {
+	c := NewUsage()
+	c.FlexInstances = t.FlexInstances
+	for k, v := range t.Objects {
+		c.Objects[k] = v
+	}
+	for k, v := range t.PoolSize {
+		c.PoolSize[k] = v
+	}
+	return c
+}
+
+// ParseMaxObjects parses a list of <kind>=<count> quotas.
+func ParseMaxObjects(l []string) (map[naming.Kind]int, error) {
+	m := make(map[naming.Kind]int)
+	for _, s := range l {
+		k, v, ok := strings.Cut(s, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid object quota '%s': expected <kind>=<count>", s)
+		}
+		kind := naming.ParseKind(k)
+		if kind == naming.KindInvalid {
+			return nil, fmt.Errorf("invalid object quota '%s': unknown kind %s", s, k)
+		}
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 0 {
+			return nil, fmt.Errorf("invalid object quota '%s': %s is not a positive integer", s, v)
+		}
+		m[kind] = i
+	}
+	return m, nil
+}
+
+// ParseMaxPoolSize parses a list of <pool>=<size> quotas.
+func ParseMaxPoolSize(l []string) (map[string]int64, error) {
+	m := make(map[string]int64)
+	for _, s := range l {
+		k, v, ok := strings.Cut(s, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid pool size quota '%s': expected <pool>=<size>", s)
+		}
+		size, err := sizeconv.FromSize(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pool size quota '%s': %w", s, err)
+		}
+		m[k] = size
+	}
+	return m, nil
+}
+
+// IsZero returns true if the policy allows everything.
+func (t Policy) IsZero() bool {
+	return len(t.MaxObjects) == 0 &&
+		t.MaxFlexInstances == 0 &&
+		len(t.MaxPoolSize) == 0 &&
+		len(t.AllowedDrivers) == 0 &&
+		len(t.DeniedDrivers) == 0 &&
+		len(t.AllowedNodes) == 0
+}
+
+// Check verifies the candidate object config is allowed by the policy.
+// The usage accounts the current object config, if any, which is
+// replaced by the candidate. Only the quotas whose usage is increased by
+// the candidate are verified, so an object can still be updated in a
+// namespace exceeding a lowered quota.
+func (t Policy) Check(usage Usage, current *Object, candidate Object) error {
+	after := usage.copy()
+	before := NewUsage()
+	if current != nil {
+		after.Del(*current)
+		before.Add(*current)
+	}
+	after.Add(candidate)
+	var errs []error
+	if current == nil {
+		errs = append(errs, t.checkObjects(after, candidate))
+	}
+	if candidate.FlexInstances > before.FlexInstances {
+		errs = append(errs, t.checkFlexInstances(after))
+	}
+	for pool, size := range candidate.PoolSize {
+		if size > before.PoolSize[pool] {
+			errs = append(errs, t.checkPoolSize(after, pool))
+		}
+	}
+	errs = append(errs, t.checkAutoPoolVolumes(candidate), t.checkDrivers(candidate), t.checkNodes(candidate))
+	return errors.Join(errs...)
+}
+
+// Verify verifies the object o, whose config is accounted in usage, is
+// allowed by the policy. It is used before provisioning an existing object.
+func (t Policy) Verify(usage Usage, o Object) error {
+	errs := []error{t.checkObjects(usage, o)}
+	if o.FlexInstances > 0 {
+		errs = append(errs, t.checkFlexInstances(usage))
+	}
+	for pool := range o.PoolSize {
+		errs = append(errs, t.checkPoolSize(usage, pool))
+	}
+	errs = append(errs, t.checkAutoPoolVolumes(o), t.checkDrivers(o), t.checkNodes(o))
+	return errors.Join(errs...)
+}
+
+func (t Policy) checkObjects(usage Usage, o Object) error {
+	maxCount, ok := t.MaxObjects[o.Path.Kind]
+	if !ok {
+		return nil
+	}
+	if n := usage.Objects[o.Path.Kind]; n > maxCount {
+		return fmt.Errorf("%w: %s: %d %s objects exceed the namespace quota of %d", ErrViolation, o.Path, n, o.Path.Kind, maxCount)
+	}
+	return nil
+}
+
+func (t Policy) checkFlexInstances(usage Usage) error {
+	if t.MaxFlexInstances == 0 {
+		return nil
+	}
+	if usage.FlexInstances > t.MaxFlexInstances {
+		return fmt.Errorf("%w: %d flex instances exceed the namespace quota of %d", ErrViolation, usage.FlexInstances, t.MaxFlexInstances)
+	}
+	return nil
+}
+
+func (t Policy) checkPoolSize(usage Usage, pool string) error {
+	maxSize, ok := t.MaxPoolSize[pool]
+	if !ok {
+		return nil
+	}
+	if size := usage.PoolSize[pool]; size > maxSize {
+		return fmt.Errorf("%w: %s of volumes in pool %s exceed the namespace quota of %s", ErrViolation,
+			sizeconv.BSizeCompact(float64(size)), pool, sizeconv.BSizeCompact(float64(maxSize)))
+	}
+	return nil
+}
+
+// checkAutoPoolVolumes denies the volumes without an explicit pool when the
+// pools usage is limited, as their pool is not known before provisioning.
+func (t Policy) checkAutoPoolVolumes(o Object) error {
+	if len(t.MaxPoolSize) == 0 || len(o.AutoPoolVolumes) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s: volumes %s need an explicit pool in a namespace with pool size quotas", ErrViolation, o.Path, strings.Join(o.AutoPoolVolumes, ","))
+}
+
+func (t Policy) checkDrivers(o Object) error {
+	var errs []error
+	for _, drv := range o.Drivers {
+		if len(t.AllowedDrivers) > 0 && !matchAny(t.AllowedDrivers, drv) {
+			errs = append(errs, fmt.Errorf("%w: %s: driver %s is not allowed in the namespace", ErrViolation, o.Path, drv))
+		} else if matchAny(t.DeniedDrivers, drv) {
+			errs = append(errs, fmt.Errorf("%w: %s: driver %s is denied in the namespace", ErrViolation, o.Path, drv))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (t Policy) checkNodes(o Object) error {
+	if len(t.AllowedNodes) == 0 {
+		return nil
+	}
+	var denied []string
+	for _, nodename := range o.Nodes {
+		if !matchAny(t.AllowedNodes, nodename) {
+			denied = append(denied, nodename)
+		}
+	}
+	if len(denied) > 0 {
+		sort.Strings(denied)
+		return fmt.Errorf("%w: %s: nodes %s are not allowed in the namespace", ErrViolation, o.Path, strings.Join(denied, ","))
+	}
+	return nil
+}
+
+func matchAny(patterns []string, s string) bool {
+	for _, pattern := range patterns {
+		if fnmatch.Match(pattern, s, 0) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package nspolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/naming"
+)
+
+func testObject(t *testing.T, s string) Object {
+	t.Helper()
+	p, err := naming.ParsePath(s)
+	require.NoError(t, err)
+	return Object{Path: p, PoolSize: make(map[string]int64)}
+}
+
+func TestParse(t *testing.T) {
+	m, err := ParseMaxObjects([]string{"svc=2", "vol=10"})
+	require.NoError(t, err)
+	require.Equal(t, map[naming.Kind]int{naming.KindSvc: 2, naming.KindVol: 10}, m)
+
+	for _, s := range []string{"svc", "foo=1", "svc=-1", "svc=a"} {
+		_, err := ParseMaxObjects([]string{s})
+		require.Error(t, err, s)
+	}
+
+	sizes, err := ParseMaxPoolSize([]string{"p1=10g"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{"p1": 10 * 1024 * 1024 * 1024}, sizes)
+
+	_, err = ParseMaxPoolSize([]string{"=1g"})
+	require.Error(t, err)
+}
+
+func TestCheck(t *testing.T) {
+	policy := Policy{
+		MaxObjects:       map[naming.Kind]int{naming.KindSvc: 1},
+		MaxFlexInstances: 2,
+		MaxPoolSize:      map[string]int64{"p1": 100},
+		DeniedDrivers:    []string{"app.forking"},
+		AllowedNodes:     []string{"n1", "n2"},
+	}
+	usage := NewUsage()
+	existing := testObject(t, "ns1/svc/s1")
+	existing.FlexInstances = 2
+	existing.PoolSize["p1"] = 100
+	usage.Add(existing)
+
+	t.Run("object quota", func(t *testing.T) {
+		err := policy.Check(usage, nil, testObject(t, "ns1/svc/s2"))
+		require.ErrorIs(t, err, ErrViolation)
+	})
+
+	t.Run("update within quotas", func(t *testing.T) {
+		candidate := existing
+		candidate.Nodes = []string{"n1"}
+		require.NoError(t, policy.Check(usage, &existing, candidate))
+	})
+
+	t.Run("flex instances quota", func(t *testing.T) {
+		candidate := testObject(t, "ns1/svc/s1")
+		candidate.FlexInstances = 3
+		require.ErrorIs(t, policy.Check(usage, &existing, candidate), ErrViolation)
+	})
+
+	t.Run("pool size quota", func(t *testing.T) {
+		candidate := testObject(t, "ns1/svc/s1")
+		candidate.PoolSize["p1"] = 101
+		require.ErrorIs(t, policy.Check(usage, &existing, candidate), ErrViolation)
+	})
+
+	t.Run("lowered quota", func(t *testing.T) {
+		lowered := policy
+		lowered.MaxPoolSize = map[string]int64{"p1": 10}
+		require.NoError(t, lowered.Check(usage, &existing, existing))
+	})
+
+	t.Run("drivers and nodes", func(t *testing.T) {
+		candidate := existing
+		candidate.Drivers = []string{"app.forking", "fs.flag"}
+		candidate.Nodes = []string{"n1", "n3"}
+		err := policy.Check(usage, &existing, candidate)
+		require.ErrorIs(t, err, ErrViolation)
+		require.ErrorContains(t, err, "driver app.forking is denied")
+		require.ErrorContains(t, err, "nodes n3 are not allowed")
+		require.NotContains(t, err.Error(), "fs.flag")
+	})
+}
+
+func TestVerify(t *testing.T) {
+	policy := Policy{
+		MaxPoolSize:    map[string]int64{"p1": 100},
+		AllowedDrivers: []string{"fs.*", "volume"},
+	}
+	o := testObject(t, "ns1/svc/s1")
+	o.PoolSize["p1"] = 200
+	o.Drivers = []string{"volume", "fs.flag"}
+	usage := NewUsage()
+	usage.Add(o)
+	require.ErrorIs(t, policy.Verify(usage, o), ErrViolation)
+
+	o.PoolSize["p1"] = 100
+	usage = NewUsage()
+	usage.Add(o)
+	require.NoError(t, policy.Verify(usage, o))
+
+	o.AutoPoolVolumes = []string{"volume#1"}
+	require.ErrorIs(t, policy.Verify(usage, o), ErrViolation)
+	require.NoError(t, Policy{AllowedDrivers: policy.AllowedDrivers}.Verify(usage, o))
+
+	require.True(t, Policy{}.IsZero())
+	require.False(t, policy.IsZero())
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/ssrathi/go-attr"
 
+	"github.com/opensvc/om3/v3/core/actionplan"
 	"github.com/opensvc/om3/v3/core/actionresdeps"
 	"github.com/opensvc/om3/v3/core/driver"
 	"github.com/opensvc/om3/v3/core/keywords"
@@ -74,6 +75,9 @@ type (
 		PRStart(context.Context) error
 		PRStop(context.Context) error
 		PGUpdate(context.Context) error
+		ImagePull(context.Context) error
+		Move(context.Context, string) error
+		MoveCheck(context.Context, string) error
 		Provision(context.Context) error
 		Unprovision(context.Context) error
 		ResourceHandlingDevice(ctx context.Context, p device.T) (resource.Driver, error)
@@ -83,8 +87,13 @@ type (
 		SyncFull(context.Context) error
 		SyncResync(context.Context) error
 		SyncSplit(context.Context) error
+		ResolveSplitBrain(context.Context, string) error
 		SyncUpdate(context.Context) error
 		SyncIngest(context.Context) error
+		SnapshotList(context.Context) (resource.Snapshots, error)
+		Drift(context.Context) (resource.Drifts, error)
+		Plan(context.Context, string) (*actionplan.T, error)
+		SnapshotRestore(context.Context, string) error
 		Enter(context.Context, string) error
 		ContainerLogs(context.Context, string, bool, int) error
 		ContainerLogsStream(context.Context, string, bool, int) (<-chan []byte, error)
@@ -0,0 +1,110 @@
+package object
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/core/status"
+)
+
+// driftCache is the drift of the up resources, as evaluated after the
+// last config change and the last instance start. The status evaluations
+// reuse it, so the drivers only compare their running state with their
+// configuration when the running state or the configuration changes.
+type driftCache struct {
+	UpdatedAt time.Time       `json:"updated_at"`
+	Resources map[string]bool `json:"resources"`
+}
+
+// Drift returns the differences between the running state of the object
+// resources and their configuration.
+func (t *actor) Drift(ctx context.Context) (resource.Drifts, error) {
+	l := make(resource.Drifts, 0)
+	for _, r := range t.Resources() {
+		if r.IsDisabled() {
+			continue
+		}
+		drifts, err := resource.GetDrift(ctx, r)
+		if err != nil {
+			return l, err
+		}
+		l = append(l, drifts...)
+	}
+	return l, nil
+}
+
+func (t *actor) driftFile() string {
+	return filepath.Join(t.varDir(), "drift.json")
+}
+
+// loadDriftCache returns the drift cache, or an empty cache if the cache
+// is older than the config file or the last instance start.
+func (t *actor) loadDriftCache(lastStartedAt time.Time) driftCache {
+	empty := driftCache{Resources: make(map[string]bool)}
+	b, err := os.ReadFile(t.driftFile())
+	if err != nil {
+		return empty
+	}
+	var cache driftCache
+	if err := json.Unmarshal(b, &cache); err != nil || cache.Resources == nil {
+		return empty
+	}
+	configFileInfo, err := os.Stat(t.ConfigFile())
+	if err != nil || !cache.UpdatedAt.After(configFileInfo.ModTime()) {
+		return empty
+	}
+	if !cache.UpdatedAt.After(lastStartedAt) {
+		return empty
+	}
+	return cache
+}
+
+// setDrift sets the drift flags of the up resources and of the instance
+// status. The resources drift is evaluated only if not found in the drift
+// cache.
+func (t *actor) setDrift(ctx context.Context, data *instance.Status) {
+	cache := t.loadDriftCache(data.LastStartedAt)
+	changed := false
+	data.Drift = false
+	for _, r := range t.Resources() {
+		rid := r.RID()
+		resourceStatus, ok := data.Resources[rid]
+		if !ok {
+			continue
+		}
+		if resourceStatus.Status != status.Up {
+			resourceStatus.HasDrift = false
+			if _, ok := cache.Resources[rid]; ok {
+				delete(cache.Resources, rid)
+				changed = true
+			}
+		} else if v, ok := cache.Resources[rid]; ok {
+			resourceStatus.HasDrift = v
+		} else if drifts, err := resource.GetDrift(ctx, r); err != nil {
+			t.log.Warnf("%s: drift: %s", rid, err)
+			resourceStatus.HasDrift = false
+		} else {
+			resourceStatus.HasDrift = len(drifts) > 0
+			cache.Resources[rid] = resourceStatus.HasDrift
+			changed = true
+		}
+		data.Resources[rid] = resourceStatus
+		if resourceStatus.HasDrift {
+			data.Drift = true
+		}
+	}
+	if !changed || t.volatile {
+		return
+	}
+	cache.UpdatedAt = time.Now()
+	if b, err := json.Marshal(cache); err != nil {
+		t.log.Warnf("drift cache: %s", err)
+	} else if err := os.WriteFile(t.driftFile(), b, 0644); err != nil {
+		t.log.Warnf("drift cache: %s", err)
+	}
+}
@@ -0,0 +1,68 @@
+package object_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/testhelper"
+)
+
+func TestStatusDriftCache(t *testing.T) {
+	env := testhelper.Setup(t)
+	flag := filepath.Join(env.Root, "flag")
+	conf := []byte(`
+[app#0]
+start = /usr/bin/touch ` + flag + `
+stop = /usr/bin/rm -f ` + flag + `
+check = /usr/bin/test -f ` + flag + `
+`)
+	p, err := naming.ParsePath("drift1")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(p.ConfigFile()), 0755))
+	require.NoError(t, os.WriteFile(p.ConfigFile(), conf, 0644))
+	s, err := object.NewSvc(p)
+	require.NoError(t, err)
+
+	ctx := actioncontext.WithRID(context.Background(), "app#0")
+	require.NoError(t, s.Start(ctx))
+	data, err := s.FreshStatus(context.Background())
+	require.NoError(t, err)
+	require.False(t, data.Drift)
+
+	cacheFile := filepath.Join(p.VarDir(), "drift.json")
+	var cache map[string]any
+	b, err := os.ReadFile(cacheFile)
+	require.NoError(t, err, "the drift of the up resources is cached")
+	require.NoError(t, json.Unmarshal(b, &cache))
+	require.Equal(t, map[string]any{"app#0": false}, cache["resources"])
+
+	t.Run("the status uses the drift cache", func(t *testing.T) {
+		b, err := json.Marshal(map[string]any{
+			"updated_at": time.Now().Add(time.Second),
+			"resources":  map[string]bool{"app#0": true},
+		})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(cacheFile, b, 0644))
+		data, err := s.FreshStatus(context.Background())
+		require.NoError(t, err)
+		require.True(t, data.Drift)
+		require.True(t, data.Resources["app#0"].HasDrift)
+	})
+
+	t.Run("a config change invalidates the drift cache", func(t *testing.T) {
+		mtime := time.Now().Add(2 * time.Second)
+		require.NoError(t, os.Chtimes(p.ConfigFile(), mtime, mtime))
+		data, err := s.FreshStatus(context.Background())
+		require.NoError(t, err)
+		require.False(t, data.Drift)
+	})
+}
@@ -0,0 +1,24 @@
+package object
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/resource"
+)
+
+// ImagePull fetches the images of the container and task resources into the
+// local image store. The daemon runs this action on every node of the object
+// when the image references change, so a failover or switch does not stall
+// on the image download.
+func (t *actor) ImagePull(ctx context.Context) error {
+	ctx = actioncontext.WithProps(ctx, actioncontext.ImagePull)
+	if err := t.validateAction(); err != nil {
+		return err
+	}
+	t.setenv("image_pull", false)
+	return t.action(ctx, func(ctx context.Context, r resource.Driver) error {
+		t.log.Attr("rid", r.RID()).Tracef("pull resource image")
+		return resource.PullImage(ctx, r)
+	})
+}
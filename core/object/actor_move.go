@@ -0,0 +1,98 @@
+package object
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/actionrollback"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/core/status"
+)
+
+// Move live migrates the movable resources of the local instance to the
+// destination node, and stops the other resources around them.
+//
+// If the move fails before a movable resource is migrated, the movable
+// resources revert their pre-move actions and the resources already
+// stopped are restarted. Once a movable resource is migrated, the
+// destination node is recorded in the moved file, so the daemon can start
+// the destination instance even if the move fails afterward.
+func (t *actor) Move(ctx context.Context, to string) error {
+	ctx = actioncontext.WithProps(ctx, actioncontext.Move)
+	ctx = actioncontext.WithMoveTo(ctx, to)
+	if err := t.validateAction(); err != nil {
+		return err
+	}
+	t.setenv("move", false)
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	movedFile := filepath.Join(t.varDir(), movedFileName)
+	if err := os.Remove(movedFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	// moved is set when a movable resource is running on the destination
+	// node. From there, restarting the stopped resources on the local node
+	// would run the instance on both nodes.
+	var moved atomic.Bool
+
+	return t.action(ctx, func(ctx context.Context, r resource.Driver) error {
+		isMovable := resource.IsMovable(r)
+		wasUp := r.Status(ctx).Is(status.Up, status.StandbyUp)
+		if err := resource.Stop(ctx, r); err != nil {
+			return err
+		}
+		if isMovable {
+			moved.Store(true)
+			if err := os.WriteFile(movedFile, []byte(to), 0644); err != nil {
+				t.log.Errorf("record the move to %s: %s", to, err)
+			}
+			return nil
+		}
+		if wasUp {
+			actionrollback.Register(ctx, func(ctx context.Context) error {
+				if moved.Load() {
+					return nil
+				}
+				return resource.Start(ctx, r)
+			})
+		}
+		return nil
+	})
+}
+
+// MoveCheck verifies the movable resources of the local instance can be
+// live migrated to the destination node.
+func (t *actor) MoveCheck(ctx context.Context, to string) error {
+	ctx = actioncontext.WithProps(ctx, actioncontext.MoveCheck)
+	if err := t.validateAction(); err != nil {
+		return err
+	}
+	t.setenv("move_check", false)
+	return t.action(ctx, func(ctx context.Context, r resource.Driver) error {
+		return resource.MoveCheck(ctx, r, to)
+	})
+}
+
+// movedFileName is the name of the file, in the instance var dir, recording
+// the destination node of the last live migration.
+const movedFileName = "moved"
+
+// IsMovedTo returns true if the last move of the local instance of the
+// object migrated a movable resource to the node.
+func IsMovedTo(p naming.Path, to string) bool {
+	b, err := os.ReadFile(filepath.Join(p.VarDir(), movedFileName))
+	if err != nil {
+		return false
+	}
+	return strings.TrimSpace(string(b)) == to
+}
@@ -0,0 +1,230 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/actionplan"
+	"github.com/opensvc/om3/v3/core/provisioned"
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/core/resourceselector"
+	"github.com/opensvc/om3/v3/core/resourceset"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/util/hostname"
+)
+
+type (
+	planStarter interface {
+		Start(context.Context) error
+	}
+	planStopper interface {
+		Stop(context.Context) error
+	}
+	planStopStandbyer interface {
+		StopStandby(context.Context) error
+	}
+
+	// planner walks the resources like the action func does, recording
+	// the operations instead of executing them.
+	planner struct {
+		actor      *actor
+		action     string
+		force      bool
+		barrier    string
+		resources  resource.Drivers
+		rsets      resourceset.L
+		isDesc     bool
+		instStatus map[string]resource.Status
+	}
+)
+
+var (
+	planActions = map[string]actioncontext.Properties{
+		"start":       actioncontext.Start,
+		"stop":        actioncontext.Stop,
+		"provision":   actioncontext.Provision,
+		"unprovision": actioncontext.Unprovision,
+	}
+)
+
+// Plan returns the resource operations the action would execute on the
+// local instance, honoring the resource selection, the barrier and the
+// force options found in the context, without executing them.
+func (t *actor) Plan(ctx context.Context, action string) (*actionplan.T, error) {
+	props, ok := planActions[action]
+	if !ok {
+		return nil, fmt.Errorf("action %s does not support planning", action)
+	}
+	if t.IsDisabled() {
+		return nil, ErrDisabled
+	}
+	instStatus, err := t.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = actioncontext.WithProps(ctx, props)
+	resourceSelector := resourceselector.FromContext(ctx, t)
+	resources := resourceSelector.Resources()
+	if len(resources) == 0 && !resourceSelector.IsZero() {
+		return nil, fmt.Errorf("resource does not exist")
+	}
+	p := planner{
+		actor:      t,
+		action:     action,
+		force:      actioncontext.IsForce(ctx),
+		barrier:    resources.Barrier(actioncontext.To(ctx)),
+		resources:  resources,
+		rsets:      t.ResourceSets(),
+		isDesc:     resourceSelector.IsDesc(),
+		instStatus: instStatus.Resources,
+	}
+	if p.isDesc {
+		p.rsets.Reverse()
+	}
+	return p.plan(ctx), nil
+}
+
+func (p *planner) plan(ctx context.Context) *actionplan.T {
+	plan := actionplan.New(p.actor.path, hostname.Hostname(), p.action, p.isDesc)
+	hasHitBarrier := false
+	for _, rset := range p.rsets {
+		if hasHitBarrier {
+			break
+		}
+		step := actionplan.Step{
+			Subset:    rset.String(),
+			Parallel:  rset.Parallel,
+			Resources: make([]actionplan.Resource, 0),
+		}
+		for _, r := range p.rsetResources(rset) {
+			if hasHitBarrier {
+				break
+			}
+			if p.isDelayed(r) {
+				// planned as a linked operation of the resource it links to
+				continue
+			}
+			if r.RID() == p.barrier {
+				hasHitBarrier = true
+			}
+			step.Resources = append(step.Resources, p.resource(ctx, r))
+		}
+		if len(step.Resources) > 0 {
+			plan.Steps = append(plan.Steps, step)
+		}
+	}
+	return plan
+}
+
+// rsetResources returns the selected resources of the resourceset, in the
+// action order.
+func (p *planner) rsetResources(rset *resourceset.T) resource.Drivers {
+	l := p.resources.Intersection(rset.Resources())
+	if p.isDesc {
+		l.Reverse()
+	}
+	return l
+}
+
+// isDelayed returns true if the resource action is executed with the
+// resource it links to, like the action func linkWrap does.
+func (p *planner) isDelayed(r resource.Driver) bool {
+	i, ok := r.(resource.LinkToer)
+	if !ok {
+		return false
+	}
+	name := i.LinkTo()
+	return name != "" && p.resources.HasRID(name)
+}
+
+// resource returns the planned operation of the resource and of the
+// resources linked to it.
+func (p *planner) resource(ctx context.Context, r resource.Driver) actionplan.Resource {
+	rid := r.RID()
+	rstat := p.instStatus[rid]
+	o := actionplan.Resource{
+		RID:      rid,
+		Label:    r.Label(ctx),
+		Status:   rstat.Status,
+		Optional: r.IsOptional(),
+		Barrier:  rid == p.barrier,
+	}
+	o.Reason = p.skipReason(r, rstat)
+	o.Skip = o.Reason != ""
+	for requiredRID, states := range r.Requires(p.action).Requirements() {
+		o.Requires = append(o.Requires, fmt.Sprintf("%s(%s)", requiredRID, states))
+	}
+	sort.Strings(o.Requires)
+	if deps := p.actor.GetActionResDeps().Dependencies(p.action, rid); len(deps) > 0 {
+		sort.Strings(deps)
+		o.DependsOn = deps
+	}
+	if i, ok := r.(resource.LinkNameser); ok {
+		linkers := p.resources.LinkersRID(i.LinkNames())
+		for _, rset := range p.rsets {
+			for _, linked := range p.rsetResources(rset) {
+				if slices.Contains(linkers, linked.RID()) {
+					o.Linked = append(o.Linked, p.resource(ctx, linked))
+				}
+			}
+		}
+	}
+	return o
+}
+
+// skipReason returns the reason why the action would not change the
+// resource state, or an empty string if it would.
+func (p *planner) skipReason(r resource.Driver, rstat resource.Status) string {
+	var i any = r
+	if err := r.GetConfigurationError(); err != nil {
+		return fmt.Sprintf("%s: %s", actionplan.ReasonConfigurationError, err)
+	}
+	if v, err := p.actor.isEncapNodeMatchingResource(r); err == nil && !v {
+		return actionplan.ReasonEncap
+	}
+	if r.IsDisabled() || r.IsActionDisabled() {
+		return actionplan.ReasonDisabled
+	}
+	state := rstat.IsProvisioned.State
+	switch p.action {
+	case "start":
+		if _, ok := i.(planStarter); !ok {
+			return actionplan.ReasonActionNotSupported
+		}
+		if state == provisioned.False {
+			return actionplan.ReasonNotProvisioned
+		}
+		if rstat.Status.Is(status.Up, status.StandbyUp) {
+			return actionplan.ReasonAlreadyUp
+		}
+	case "stop":
+		if r.IsStandby() && !p.force {
+			if _, ok := i.(planStopStandbyer); !ok {
+				return actionplan.ReasonStandby
+			}
+		} else if _, ok := i.(planStopper); !ok {
+			return actionplan.ReasonActionNotSupported
+		}
+		if rstat.Status.Is(status.Down, status.StandbyDown) {
+			return actionplan.ReasonAlreadyDown
+		}
+	case "provision":
+		if r.IsProvisionDisabled() {
+			return actionplan.ReasonProvisionDisabled
+		}
+		if state == provisioned.True {
+			return actionplan.ReasonAlreadyProvisioned
+		}
+	case "unprovision":
+		if r.IsUnprovisionDisabled() {
+			return actionplan.ReasonUnprovisionDisabled
+		}
+		if state == provisioned.False {
+			return actionplan.ReasonAlreadyUnprovisioned
+		}
+	}
+	return ""
+}
@@ -25,6 +25,11 @@ func (t *actor) Provision(ctx context.Context) error {
 		return err
 	}
 	defer unlock()
+	if actioncontext.IsForce(ctx2) {
+		if err := t.preActionSnapshot(ctx2, "provision"); err != nil {
+			return err
+		}
+	}
 	if err := t.lockedProvision(ctx2); err != nil {
 		return err
 	}
@@ -0,0 +1,27 @@
+package object
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/resource"
+)
+
+// ResolveSplitBrain reconnects the replicated disks after a split-brain,
+// keeping the data of the keep node and discarding the changes made on the
+// local node if it is not the keep node.
+func (t *actor) ResolveSplitBrain(ctx context.Context, keep string) error {
+	ctx = actioncontext.WithProps(ctx, actioncontext.SplitBrainResolve)
+	if err := t.validateAction(); err != nil {
+		return err
+	}
+	t.setenv("drbd_resolve", false)
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return t.action(ctx, func(ctx context.Context, r resource.Driver) error {
+		return resource.ResolveSplitBrain(ctx, r, keep)
+	})
+}
@@ -13,5 +13,16 @@ func (t *actor) Set(ctx context.Context, kops ...keyop.T) error {
 	ctx, stop := statusbus.WithContext(ctx, t.path)
 	defer stop()
 	defer t.postActionStatusEval(ctx)
-	return t.core.Set(ctx, kops...)
+	if !isFsConfigChange(nil, nil, kops) {
+		return t.core.Set(ctx, kops...)
+	}
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err := t.preActionSnapshot(ctx, "set"); err != nil {
+		return err
+	}
+	return t.lockedSet(kops...)
 }
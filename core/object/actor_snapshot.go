@@ -0,0 +1,112 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/keyop"
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/util/key"
+)
+
+// preActionSnapshotTag is the tag of the snapshots taken before the risky
+// actions, so their retention is independent from the sync.lvmsnap
+// resources snapshots.
+const preActionSnapshotTag = "preaction"
+
+// snapshotters returns the object resources able to snapshot their data.
+func (t *actor) snapshotters() []resource.Snapshotter {
+	l := make([]resource.Snapshotter, 0)
+	for _, r := range t.Resources() {
+		if i, ok := r.(resource.Snapshotter); ok {
+			l = append(l, i)
+		}
+	}
+	return l
+}
+
+// SnapshotList returns the snapshots of the object resources data.
+func (t *actor) SnapshotList(ctx context.Context) (resource.Snapshots, error) {
+	l := make(resource.Snapshots, 0)
+	for _, r := range t.snapshotters() {
+		snaps, err := r.Snapshots(ctx)
+		if err != nil {
+			return l, err
+		}
+		l = append(l, snaps...)
+	}
+	return l, nil
+}
+
+// SnapshotRestore restores the resource data from the snapshot identified
+// by id, as reported by SnapshotList.
+func (t *actor) SnapshotRestore(ctx context.Context, id string) error {
+	ctx = actioncontext.WithProps(ctx, actioncontext.SnapshotRestore)
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	for _, r := range t.snapshotters() {
+		snaps, err := r.Snapshots(ctx)
+		if err != nil {
+			return err
+		}
+		for _, snap := range snaps {
+			if snap.ID == id {
+				return r.RestoreSnapshot(ctx, id)
+			}
+		}
+	}
+	return fmt.Errorf("snapshot %s not found", id)
+}
+
+// preActionSnapshot snapshots the lvm-backed data before a risky action,
+// if the pre_action_snapshot keyword is set. The caller holds the action
+// lock.
+func (t *actor) preActionSnapshot(ctx context.Context, reason string) error {
+	if !t.config.GetBool(key.New("", "pre_action_snapshot")) {
+		return nil
+	}
+	options := resource.SnapshotOptions{
+		Tag:  preActionSnapshotTag,
+		Size: t.config.GetString(key.New("", "pre_action_snapshot_size")),
+		Keep: t.config.GetInt(key.New("", "pre_action_snapshot_keep")),
+	}
+	for _, r := range t.snapshotters() {
+		snaps, err := r.Snapshot(ctx, options)
+		if err != nil {
+			return fmt.Errorf("pre %s snapshot: %w", reason, err)
+		}
+		for _, snap := range snaps {
+			t.log.Infof("pre %s snapshot %s of %s", reason, snap.ID, snap.Origin)
+		}
+	}
+	return nil
+}
+
+// isFsConfigChange returns true if the configuration change touches a fs
+// resource section.
+func isFsConfigChange(deleteSections []string, unsetKeys []key.T, keyOps []keyop.T) bool {
+	isFs := func(section string) bool {
+		return strings.HasPrefix(section, "fs#")
+	}
+	for _, section := range deleteSections {
+		if isFs(section) {
+			return true
+		}
+	}
+	for _, k := range unsetKeys {
+		if isFs(k.Section) {
+			return true
+		}
+	}
+	for _, op := range keyOps {
+		if isFs(op.Key.Section) {
+			return true
+		}
+	}
+	return false
+}
@@ -110,8 +110,20 @@ func (t *actor) setLastStartedAt(data *instance.Status) error {
 	return nil
 }
 
+// setPressure sets the rounded pressure stall information of the object
+// pg in the instance status. It is left unset if the pg does not exist,
+// the host does not support PSI or the pg is not under pressure.
+func (t *actor) setPressure(data *instance.Status) {
+	p, err := t.pgConfig("").Pressure()
+	if err != nil {
+		t.log.Debugf("read pg pressure: %s", err)
+	}
+	data.Pressure = p.Rounded()
+}
+
 func (t *actor) lockedMonitorStatusEval(ctx context.Context, data instance.Status) (instance.Status, error) {
 	t.setLastStartedAt(&data)
+	t.setPressure(&data)
 	data.UpdatedAt = time.Now()
 	data.FrozenAt = t.Frozen()
 
@@ -123,6 +135,7 @@ func (t *actor) lockedMonitorStatusEval(ctx context.Context, data instance.Statu
 	if err := t.resourceStatusEval(ctx, &data, true); err != nil {
 		return data, fmt.Errorf("resource status eval: %w", err)
 	}
+	t.setDrift(ctx, &data)
 	if len(data.Resources) == 0 {
 		data.Avail = status.NotApplicable
 		data.Overall = status.NotApplicable
@@ -139,11 +152,13 @@ func (t *actor) lockedMonitorStatusEval(ctx context.Context, data instance.Statu
 func (t *actor) lockedStatusEval(ctx context.Context) (instance.Status, error) {
 	var data instance.Status
 	t.setLastStartedAt(&data)
+	t.setPressure(&data)
 	data.UpdatedAt = time.Now()
 	data.FrozenAt = t.Frozen()
 	if err := t.resourceStatusEval(ctx, &data, false); err != nil {
 		return data, fmt.Errorf("resource status eval: %w", err)
 	}
+	t.setDrift(ctx, &data)
 	if len(data.Resources) == 0 {
 		data.Avail = status.NotApplicable
 		data.Overall = status.NotApplicable
@@ -26,6 +26,9 @@ func (t *actor) Unprovision(ctx context.Context) error {
 		return err
 	}
 	defer unlock()
+	if err := t.preActionSnapshot(ctx, "unprovision"); err != nil {
+		return err
+	}
 	return t.lockedUnprovision(ctx)
 }
 
@@ -14,10 +14,16 @@ func (t *actor) Unset(ctx context.Context, kws ...key.T) error {
 	ctx, stop := statusbus.WithContext(ctx, t.path)
 	defer stop()
 	defer t.postActionStatusEval(ctx)
+	if !isFsConfigChange(nil, kws, nil) {
+		return t.core.Unset(ctx, kws...)
+	}
 	unlock, err := t.lockAction(ctx)
 	if err != nil {
 		return err
 	}
 	defer unlock()
-	return t.config.Unset(kws...)
+	if err := t.preActionSnapshot(ctx, "unset"); err != nil {
+		return err
+	}
+	return t.lockedUnset(kws...)
 }
@@ -0,0 +1,29 @@
+package object
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+
+	"github.com/opensvc/om3/v3/core/keyop"
+	"github.com/opensvc/om3/v3/util/key"
+)
+
+// Update applies configurations changes in the configuration file, after
+// a pre-action snapshot if the changes touch fs resources. The snapshot
+// and the changes are done under the action lock.
+func (t *actor) Update(ctx context.Context, deleteSections []string, unsetKeys []key.T, keyOps []keyop.T) error {
+	if !isFsConfigChange(deleteSections, unsetKeys, keyOps) {
+		return t.core.Update(ctx, deleteSections, unsetKeys, keyOps)
+	}
+	ctx = actioncontext.WithProps(ctx, actioncontext.Set)
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err := t.preActionSnapshot(ctx, "config update"); err != nil {
+		return err
+	}
+	return t.lockedUpdate(deleteSections, unsetKeys, keyOps)
+}
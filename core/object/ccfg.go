@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"golang.org/x/time/rate"
 
 	"github.com/opensvc/om3/v3/core/cluster"
 	"github.com/opensvc/om3/v3/core/keywords"
 	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/rbac"
 	"github.com/opensvc/om3/v3/util/funcopt"
 	"github.com/opensvc/om3/v3/util/key"
 )
@@ -104,6 +106,23 @@ func getClusterConfig() (*cluster.Config, error) {
 		keyListenerRateLimiterBurst   = key.New("listener", "rate_limiter_burst")
 		keyListenerRateLimiterExpires = key.New("listener", "rate_limiter_expires")
 
+		keyDNSAddr        = key.New("dns", "addr")
+		keyDNSPort        = key.New("dns", "port")
+		keyDNSAXFRAllow   = key.New("dns", "axfr_allow")
+		keyDNSHealthAware = key.New("dns", "health_aware")
+
+		keyDNSUpdateServer            = key.New("dns", "update_server")
+		keyDNSUpdateZone              = key.New("dns", "update_zone")
+		keyDNSUpdateTSIGKeyName       = key.New("dns", "update_tsig_key_name")
+		keyDNSUpdateTSIGSecret        = key.New("dns", "update_tsig_secret")
+		keyDNSUpdateTSIGAlgorithm     = key.New("dns", "update_tsig_algorithm")
+		keyDNSUpdateReconcileInterval = key.New("dns", "update_reconcile_interval")
+
+		keyAuditMaxSize        = key.New("audit", "max_size")
+		keyAuditMaxBackups     = key.New("audit", "max_backups")
+		keyAuditForwardURL     = key.New("audit", "forward_url")
+		keyAuditForwardTimeout = key.New("audit", "forward_timeout")
+
 		keyNodeSSHKey = key.New("node", "sshkey")
 	)
 
@@ -145,6 +164,62 @@ func getClusterConfig() (*cluster.Config, error) {
 		cfg.Listener.RateLimiter.Expires = *expires
 	}
 
+	cfg.DNSServer.Addr = c.GetString(keyDNSAddr)
+	cfg.DNSServer.Port = c.GetInt(keyDNSPort)
+	cfg.DNSServer.AXFRAllow = c.GetStrings(keyDNSAXFRAllow)
+	cfg.DNSServer.HealthAware = c.GetBool(keyDNSHealthAware)
+	cfg.DNSServer.UpdateServer = c.GetString(keyDNSUpdateServer)
+	cfg.DNSServer.UpdateZone = c.GetString(keyDNSUpdateZone)
+	cfg.DNSServer.UpdateTSIGKeyName = c.GetString(keyDNSUpdateTSIGKeyName)
+	cfg.DNSServer.UpdateTSIGAlgorithm = c.GetString(keyDNSUpdateTSIGAlgorithm)
+	cfg.DNSServer.SetUpdateTSIGSecret(c.GetString(keyDNSUpdateTSIGSecret))
+	if interval := c.GetDuration(keyDNSUpdateReconcileInterval); interval != nil {
+		cfg.DNSServer.UpdateReconcileInterval = *interval
+	}
+
+	if size := c.GetSize(keyAuditMaxSize); size != nil {
+		cfg.Audit.MaxSize = *size
+	}
+	cfg.Audit.MaxBackups = c.GetInt(keyAuditMaxBackups)
+	cfg.Audit.ForwardURL = c.GetString(keyAuditForwardURL)
+	if timeout := c.GetDuration(keyAuditForwardTimeout); timeout != nil {
+		cfg.Audit.ForwardTimeout = *timeout
+	}
+
+	for _, section := range c.SectionStrings() {
+		name, ok := strings.CutPrefix(section, "role#")
+		if !ok {
+			continue
+		}
+		if rbac.IsBuiltinRole(name) {
+			cfg.Issues = append(cfg.Issues, fmt.Sprintf("section %s: can't redefine the builtin role %s", section, name))
+			continue
+		}
+		cfg.Roles = append(cfg.Roles, rbac.CustomRole{
+			Name:  name,
+			Allow: c.GetStrings(key.New(section, "allow")),
+			Kinds: c.GetStrings(key.New(section, "kinds")),
+		})
+	}
+
+	for _, section := range c.SectionStrings() {
+		name, ok := strings.CutPrefix(section, "remote#")
+		if !ok {
+			continue
+		}
+		if name == cfg.Name {
+			cfg.Issues = append(cfg.Issues, fmt.Sprintf("section %s: a remote cluster can't have the local cluster name", section))
+			continue
+		}
+		cfg.Remotes = append(cfg.Remotes, cluster.ConfigRemote{
+			Name:        name,
+			URLs:        c.GetStrings(key.New(section, "url")),
+			Credentials: c.GetString(key.New(section, "credentials")),
+			Username:    c.GetString(key.New(section, "username")),
+			Insecure:    c.GetBool(key.New(section, "insecure")),
+		})
+	}
+
 	if homedir, err := os.UserHomeDir(); err != nil {
 		cfg.Issues = append(cfg.Issues, fmt.Sprintf("user home dir: %s", err))
 	} else {
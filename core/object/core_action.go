@@ -135,6 +135,8 @@ func (t *actor) withRollbackTimeout(ctx context.Context) (context.Context, func(
 		return t.withTimeoutFromKeywords(ctx, []string{"unprovision_timeout", "timeout"})
 	case "start":
 		return t.withTimeoutFromKeywords(ctx, []string{"stop_timeout", "timeout"})
+	case "move":
+		return t.withTimeoutFromKeywords(ctx, []string{"start_timeout", "timeout"})
 	default:
 		return ctx, func() {}
 	}
@@ -756,7 +758,11 @@ func (t *actor) action(ctx context.Context, fn resourceset.DoFunc) error {
 	if err := t.ResourceSets().Do(ctxWithTimeout, resourceSelector, barrier, "link-"+action.Name, progressWrap(linkWrap(encapWrap(fn)))); errors.Is(err, resource.ErrBarrier) {
 		// pass
 	} else if err != nil {
-		if t.needRollback(ctxWithTimeout) {
+		if errors.Is(err, resource.ErrActionAbandoned) {
+			// the abandoned resource action may still be running, so a
+			// rollback could act on a resource in an unknown state.
+			t.Log().Errorf("skip rollback: a resource action was abandoned while still running, the instance needs attention")
+		} else if t.needRollback(ctxWithTimeout) {
 			if rb := actionrollback.FromContext(ctxWithTimeout); rb != nil {
 				t.Log().Infof("rollback")
 				ctxWithTimeout, cancelCtxWithTimeout := t.withRollbackTimeout(ctx)
@@ -9,6 +9,7 @@ import (
 	"github.com/opensvc/om3/v3/core/keyop"
 	"github.com/opensvc/om3/v3/core/keywords"
 	"github.com/opensvc/om3/v3/core/kwoption"
+	"github.com/opensvc/om3/v3/core/maintenance"
 	"github.com/opensvc/om3/v3/core/manifest"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/placement"
@@ -173,6 +174,61 @@ var keywordStore = keywords.Store{
 		Scopable: true,
 		Text:     keywords.NewText(fs, "text/kw/core/pg_blkio_weight"),
 	},
+	{
+		Attr:     "PG.IOMax",
+		Example:  "/dev/sda rbps=10m wiops=100 8:16 wbps=5m",
+		Inherit:  keywords.InheritLeaf,
+		Kind:     naming.NewKinds(naming.KindSvc, naming.KindVol, naming.KindNscfg),
+		Option:   "pg_io_max",
+		Scopable: true,
+		Text:     keywords.NewText(fs, "text/kw/core/pg_io_max"),
+	},
+	{
+		Attr:     "PG.PidsMax",
+		Example:  "1000",
+		Inherit:  keywords.InheritLeaf,
+		Kind:     naming.NewKinds(naming.KindSvc, naming.KindVol, naming.KindNscfg),
+		Option:   "pg_pids_max",
+		Scopable: true,
+		Text:     keywords.NewText(fs, "text/kw/core/pg_pids_max"),
+	},
+	{
+		Attr:     "PG.MemHigh",
+		Example:  "400m",
+		Inherit:  keywords.InheritLeaf,
+		Kind:     naming.NewKinds(naming.KindSvc, naming.KindVol, naming.KindNscfg),
+		Option:   "pg_mem_high",
+		Scopable: true,
+		Text:     keywords.NewText(fs, "text/kw/core/pg_mem_high"),
+	},
+	{
+		Attr:     "PG.MemMin",
+		Example:  "128m",
+		Inherit:  keywords.InheritLeaf,
+		Kind:     naming.NewKinds(naming.KindSvc, naming.KindVol, naming.KindNscfg),
+		Option:   "pg_mem_min",
+		Scopable: true,
+		Text:     keywords.NewText(fs, "text/kw/core/pg_mem_min"),
+	},
+	{
+		Attr:     "PG.CPUWeight",
+		Example:  "200",
+		Inherit:  keywords.InheritLeaf,
+		Kind:     naming.NewKinds(naming.KindSvc, naming.KindVol, naming.KindNscfg),
+		Option:   "pg_cpu_weight",
+		Scopable: true,
+		Text:     keywords.NewText(fs, "text/kw/core/pg_cpu_weight"),
+	},
+	{
+		Attr:      "PG.CPUMaxBurst",
+		Converter: "duration",
+		Example:   "20ms",
+		Inherit:   keywords.InheritLeaf,
+		Kind:      naming.NewKinds(naming.KindSvc, naming.KindVol, naming.KindNscfg),
+		Option:    "pg_cpu_max_burst",
+		Scopable:  true,
+		Text:      keywords.NewText(fs, "text/kw/core/pg_cpu_max_burst"),
+	},
 	{
 		Converter: "duration",
 		Kind:      naming.NewKinds(naming.KindSvc, naming.KindVol),
@@ -271,6 +327,119 @@ var keywordStore = keywords.Store{
 		Section:   "DEFAULT",
 		Text:      keywords.NewText(fs, "text/kw/core/stonith"),
 	},
+	{
+		Converter: "bool",
+		Default:   "true",
+		Inherit:   keywords.InheritHead,
+		Kind:      naming.NewKinds(naming.KindSvc),
+		Option:    "image_prepull",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/image_prepull"),
+	},
+	{
+		Converter: "bool",
+		Default:   "false",
+		Inherit:   keywords.InheritHead,
+		Kind:      naming.NewKinds(naming.KindSvc),
+		Option:    "image_digest_check",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/image_digest_check"),
+	},
+	{
+		Example:  "02:00-06:00 sun",
+		Inherit:  keywords.InheritHead,
+		Kind:     naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:   "maintenance_window",
+		Scopable: true,
+		Section:  "DEFAULT",
+		Text:     keywords.NewText(fs, "text/kw/core/maintenance_window"),
+	},
+	{
+		Candidates: []string{string(maintenance.ModeFreeze), string(maintenance.ModeBlock)},
+		Default:    string(maintenance.ModeFreeze),
+		Inherit:    keywords.InheritHead,
+		Kind:       naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:     "maintenance_mode",
+		Scopable:   true,
+		Section:    "DEFAULT",
+		Text:       keywords.NewText(fs, "text/kw/core/maintenance_mode"),
+	},
+	{
+		Converter:   "list",
+		DefaultText: keywords.NewText(fs, "text/kw/core/switch_prewarm.default"),
+		Example:     "fs container#db",
+		Inherit:     keywords.InheritHead,
+		Kind:        naming.NewKinds(naming.KindSvc),
+		Option:      "switch_prewarm",
+		Section:     "DEFAULT",
+		Text:        keywords.NewText(fs, "text/kw/core/switch_prewarm"),
+	},
+	{
+		Example:  "/srv/app/bin/healthcheck",
+		Inherit:  keywords.InheritHead,
+		Kind:     naming.NewKinds(naming.KindSvc),
+		Option:   "switch_verify",
+		Scopable: true,
+		Section:  "DEFAULT",
+		Text:     keywords.NewText(fs, "text/kw/core/switch_verify"),
+	},
+	{
+		Converter: "duration",
+		Default:   "1m",
+		Inherit:   keywords.InheritHead,
+		Kind:      naming.NewKinds(naming.KindSvc),
+		Option:    "switch_verify_timeout",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/switch_verify_timeout"),
+	},
+	{
+		Converter: "list",
+		Example:   "svc=20 vol=20 sec=50",
+		Kind:      naming.NewKinds(naming.KindNscfg),
+		Option:    "quota_objects",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/quota_objects"),
+	},
+	{
+		Converter: "int",
+		Example:   "10",
+		Kind:      naming.NewKinds(naming.KindNscfg),
+		Option:    "quota_flex_instances",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/quota_flex_instances"),
+	},
+	{
+		Converter: "list",
+		Example:   "default=1t fast=200g",
+		Kind:      naming.NewKinds(naming.KindNscfg),
+		Option:    "quota_pool_size",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/quota_pool_size"),
+	},
+	{
+		Converter: "list",
+		Example:   "app.* fs.* ip.* volume container.podman",
+		Kind:      naming.NewKinds(naming.KindNscfg),
+		Option:    "allowed_drivers",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/allowed_drivers"),
+	},
+	{
+		Converter: "list",
+		Example:   "container.docker disk.*",
+		Kind:      naming.NewKinds(naming.KindNscfg),
+		Option:    "denied_drivers",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/denied_drivers"),
+	},
+	{
+		Converter: "list",
+		Example:   "n1 n2 web*",
+		Kind:      naming.NewKinds(naming.KindNscfg),
+		Option:    "allowed_nodes",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/allowed_nodes"),
+	},
 	{
 		Candidates: placement.PolicyNames(),
 		Default:    "nodes order",
@@ -620,6 +789,33 @@ var keywordStore = keywords.Store{
 		Scopable:  true,
 		Text:      keywords.NewText(fs, "text/kw/core/unprovision"),
 	},
+	{
+		Attr:      "PreActionSnapshot",
+		Converter: "bool",
+		Default:   "false",
+		Kind:      naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:    "pre_action_snapshot",
+		Scopable:  true,
+		Text:      keywords.NewText(fs, "text/kw/core/pre_action_snapshot"),
+	},
+	{
+		Attr:      "PreActionSnapshotKeep",
+		Converter: "int",
+		Default:   "3",
+		Kind:      naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:    "pre_action_snapshot_keep",
+		Scopable:  true,
+		Text:      keywords.NewText(fs, "text/kw/core/pre_action_snapshot_keep"),
+	},
+	{
+		Attr:     "PreActionSnapshotSize",
+		Default:  "10%ORIGIN",
+		Example:  "1g",
+		Kind:     naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:   "pre_action_snapshot_size",
+		Scopable: true,
+		Text:     keywords.NewText(fs, "text/kw/core/pre_action_snapshot_size"),
+	},
 	{
 		Attr:      "ProvisionTimeout",
 		Converter: "duration",
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/confighistory"
 	"github.com/opensvc/om3/v3/core/keyop"
 )
 
@@ -15,5 +16,13 @@ func (t *core) Set(ctx context.Context, kops ...keyop.T) error {
 		return err
 	}
 	defer unlock()
-	return t.config.Set(kops...)
+	return t.lockedSet(kops...)
+}
+
+func (t *core) lockedSet(kops ...keyop.T) error {
+	if err := t.config.Set(kops...); err != nil {
+		return err
+	}
+	t.annotateConfigHistory(confighistory.Ops(nil, nil, kops))
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/confighistory"
 	"github.com/opensvc/om3/v3/util/key"
 )
 
@@ -15,5 +16,13 @@ func (t *core) Unset(ctx context.Context, kws ...key.T) error {
 		return err
 	}
 	defer unlock()
-	return t.config.Unset(kws...)
+	return t.lockedUnset(kws...)
+}
+
+func (t *core) lockedUnset(kws ...key.T) error {
+	if err := t.config.Unset(kws...); err != nil {
+		return err
+	}
+	t.annotateConfigHistory(confighistory.Ops(nil, kws, nil))
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/confighistory"
 	"github.com/opensvc/om3/v3/core/keyop"
 	"github.com/opensvc/om3/v3/util/key"
 )
@@ -16,5 +17,22 @@ func (t *core) Update(ctx context.Context, deleteSections []string, unsetKeys []
 		return err
 	}
 	defer unlock()
-	return t.config.Update(deleteSections, unsetKeys, keyOps)
+	return t.lockedUpdate(deleteSections, unsetKeys, keyOps)
+}
+
+func (t *core) lockedUpdate(deleteSections []string, unsetKeys []key.T, keyOps []keyop.T) error {
+	if err := t.config.Update(deleteSections, unsetKeys, keyOps); err != nil {
+		return err
+	}
+	t.annotateConfigHistory(confighistory.Ops(deleteSections, unsetKeys, keyOps))
+	return nil
+}
+
+// annotateConfigHistory describes the configuration change just committed
+// in the object configuration history, so the daemon can attach the local
+// user and the operations to the revision it records.
+func (t *core) annotateConfigHistory(ops []string) {
+	if err := confighistory.Annotate(t.path, confighistory.LocalUser(), ops); err != nil {
+		t.log.Debugf("config history annotate: %s", err)
+	}
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/opensvc/om3/v3/core/keywords"
 	"github.com/opensvc/om3/v3/core/kwoption"
+	"github.com/opensvc/om3/v3/core/maintenance"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/rawconfig"
 	"github.com/opensvc/om3/v3/daemon/daemonenv"
@@ -275,6 +276,13 @@ var (
 		Section:   "node",
 		Text:      keywords.NewText(fs, "text/kw/node/node.max_key_size"),
 	}
+	kwNodeConfigHistoryMax = keywords.Keyword{
+		Converter: "int",
+		Default:   "10",
+		Option:    "config_history_max",
+		Section:   "node",
+		Text:      keywords.NewText(fs, "text/kw/node/node.config_history_max"),
+	}
 	kwNodeAllowedNetworks = keywords.Keyword{
 		Converter: "list",
 		Default:   "10.0.0.0/8 172.16.0.0/24 192.168.0.0/16",
@@ -463,6 +471,21 @@ var (
 		Section:   "node",
 		Text:      keywords.NewText(fs, "text/kw/node/node.maintenance_grace_period"),
 	}
+	kwNodeMaintenanceWindow = keywords.Keyword{
+		Example:  "02:00-06:00 sun",
+		Option:   "maintenance_window",
+		Scopable: true,
+		Section:  "node",
+		Text:     keywords.NewText(fs, "text/kw/node/node.maintenance_window"),
+	}
+	kwNodeMaintenanceMode = keywords.Keyword{
+		Candidates: []string{string(maintenance.ModeFreeze), string(maintenance.ModeBlock)},
+		Default:    string(maintenance.ModeFreeze),
+		Option:     "maintenance_mode",
+		Scopable:   true,
+		Section:    "node",
+		Text:       keywords.NewText(fs, "text/kw/node/node.maintenance_mode"),
+	}
 	kwNodeRejoinGracePeriod = keywords.Keyword{
 		Converter: "duration",
 		Default:   "90s",
@@ -477,6 +500,13 @@ var (
 		Section:   "node",
 		Text:      keywords.NewText(fs, "text/kw/node/node.ready_period"),
 	}
+	kwNodeSysreportSnapshots = keywords.Keyword{
+		Converter: "int",
+		Default:   "10",
+		Option:    "snapshots",
+		Section:   "sysreport",
+		Text:      keywords.NewText(fs, "text/kw/node/sysreport.snapshots"),
+	}
 	kwNodeSysreportSchedule = keywords.Keyword{
 		Default: "~00:00-06:00",
 		Option:  "schedule",
@@ -593,6 +623,147 @@ var (
 		Scopable:  true,
 		Text:      keywords.NewText(fs, "text/kw/node/listener.rate_limiter_expires"),
 	}
+	kwNodeDNSAddr = keywords.Keyword{
+		Example:  "0.0.0.0",
+		Option:   "addr",
+		Scopable: true,
+		Section:  "dns",
+		Text:     keywords.NewText(fs, "text/kw/node/dns.addr"),
+	}
+	kwNodeDNSPort = keywords.Keyword{
+		Converter: "int",
+		Default:   "53",
+		Option:    "port",
+		Scopable:  true,
+		Section:   "dns",
+		Text:      keywords.NewText(fs, "text/kw/node/dns.port"),
+	}
+	kwNodeDNSAXFRAllow = keywords.Keyword{
+		Converter: "list",
+		Example:   "10.0.0.0/8 192.168.1.10/32",
+		Option:    "axfr_allow",
+		Scopable:  true,
+		Section:   "dns",
+		Text:      keywords.NewText(fs, "text/kw/node/dns.axfr_allow"),
+	}
+	kwNodeDNSHealthAware = keywords.Keyword{
+		Converter: "bool",
+		Default:   "false",
+		Option:    "health_aware",
+		Scopable:  true,
+		Section:   "dns",
+		Text:      keywords.NewText(fs, "text/kw/node/dns.health_aware"),
+	}
+	kwNodeDNSUpdateServer = keywords.Keyword{
+		Example: "ns1.example.com:53",
+		Option:  "update_server",
+		Section: "dns",
+		Text:    keywords.NewText(fs, "text/kw/node/dns.update_server"),
+	}
+	kwNodeDNSUpdateZone = keywords.Keyword{
+		DefaultText: keywords.NewText(fs, "text/kw/node/dns.update_zone.default"),
+		Example:     "cluster1.example.com",
+		Option:      "update_zone",
+		Section:     "dns",
+		Text:        keywords.NewText(fs, "text/kw/node/dns.update_zone"),
+	}
+	kwNodeDNSUpdateTSIGKeyName = keywords.Keyword{
+		Example: "om3-update",
+		Option:  "update_tsig_key_name",
+		Section: "dns",
+		Text:    keywords.NewText(fs, "text/kw/node/dns.update_tsig_key_name"),
+	}
+	kwNodeDNSUpdateTSIGSecret = keywords.Keyword{
+		Example: "c2VjcmV0",
+		Option:  "update_tsig_secret",
+		Section: "dns",
+		Text:    keywords.NewText(fs, "text/kw/node/dns.update_tsig_secret"),
+	}
+	kwNodeDNSUpdateTSIGAlgorithm = keywords.Keyword{
+		Candidates: []string{"hmac-sha1", "hmac-sha256", "hmac-sha512"},
+		Default:    "hmac-sha256",
+		Option:     "update_tsig_algorithm",
+		Section:    "dns",
+		Text:       keywords.NewText(fs, "text/kw/node/dns.update_tsig_algorithm"),
+	}
+	kwNodeDNSUpdateReconcileInterval = keywords.Keyword{
+		Converter: "duration",
+		Default:   "5m",
+		Option:    "update_reconcile_interval",
+		Section:   "dns",
+		Text:      keywords.NewText(fs, "text/kw/node/dns.update_reconcile_interval"),
+	}
+	kwNodeAuditMaxSize = keywords.Keyword{
+		Converter: "size",
+		Default:   "10mb",
+		Option:    "max_size",
+		Section:   "audit",
+		Text:      keywords.NewText(fs, "text/kw/node/audit.max_size"),
+	}
+	kwNodeAuditMaxBackups = keywords.Keyword{
+		Converter: "int",
+		Default:   "10",
+		Option:    "max_backups",
+		Section:   "audit",
+		Text:      keywords.NewText(fs, "text/kw/node/audit.max_backups"),
+	}
+	kwNodeAuditForwardURL = keywords.Keyword{
+		Example: "https://siem.example.com/opensvc/audit",
+		Option:  "forward_url",
+		Section: "audit",
+		Text:    keywords.NewText(fs, "text/kw/node/audit.forward_url"),
+	}
+	kwNodeAuditForwardTimeout = keywords.Keyword{
+		Converter: "duration",
+		Default:   "5s",
+		Option:    "forward_timeout",
+		Section:   "audit",
+		Text:      keywords.NewText(fs, "text/kw/node/audit.forward_timeout"),
+	}
+	kwNodeRoleAllow = keywords.Keyword{
+		Converter: "list",
+		Example:   "get start stop restart",
+		Option:    "allow",
+		Required:  true,
+		Section:   "role",
+		Text:      keywords.NewText(fs, "text/kw/node/role.allow"),
+	}
+	kwNodeRoleKinds = keywords.Keyword{
+		Converter:  "list",
+		Candidates: []string{"svc", "vol", "cfg", "sec", "usr"},
+		Example:    "svc vol",
+		Option:     "kinds",
+		Section:    "role",
+		Text:       keywords.NewText(fs, "text/kw/node/role.kinds"),
+	}
+	kwNodeRemoteURL = keywords.Keyword{
+		Converter: "list",
+		Example:   "https://n1.c1.acme.com:1215 https://n2.c1.acme.com:1215",
+		Option:    "url",
+		Required:  true,
+		Section:   "remote",
+		Text:      keywords.NewText(fs, "text/kw/node/remote.url"),
+	}
+	kwNodeRemoteCredentials = keywords.Keyword{
+		Example:  "system/sec/c1",
+		Option:   "credentials",
+		Required: true,
+		Section:  "remote",
+		Text:     keywords.NewText(fs, "text/kw/node/remote.credentials"),
+	}
+	kwNodeRemoteUsername = keywords.Keyword{
+		Example: "c2",
+		Option:  "username",
+		Section: "remote",
+		Text:    keywords.NewText(fs, "text/kw/node/remote.username"),
+	}
+	kwNodeRemoteInsecure = keywords.Keyword{
+		Converter: "bool",
+		Default:   "false",
+		Option:    "insecure",
+		Section:   "remote",
+		Text:      keywords.NewText(fs, "text/kw/node/remote.insecure"),
+	}
 	kwNodeSyslogFacility = keywords.Keyword{
 		Default: "daemon",
 		Option:  "facility",
@@ -731,7 +902,7 @@ var (
 		Text:      keywords.NewText(fs, "text/kw/node/stonith.command"),
 	}
 	kwNodeHBType = keywords.Keyword{
-		Candidates: []string{"unicast", "multicast", "disk", "relay"},
+		Candidates: []string{"unicast", "multicast", "disk", "relay", "file"},
 		Option:     "type",
 		Required:   true,
 		Section:    "hb",
@@ -833,6 +1004,24 @@ var (
 		Text:      keywords.NewText(fs, "text/kw/node/hb.disk.max_slots"),
 		Types:     []string{"disk"},
 	}
+	kwNodeHBFilePath = keywords.Keyword{
+		Example:  "/mnt/shared/opensvc/hb",
+		Option:   "path",
+		Required: true,
+		Scopable: true,
+		Section:  "hb",
+		Text:     keywords.NewText(fs, "text/kw/node/hb.file.path"),
+		Types:    []string{"file"},
+	}
+	kwNodeHBFileMaxSkew = keywords.Keyword{
+		Converter: "duration",
+		Default:   "5s",
+		Example:   "10s",
+		Option:    "max_skew",
+		Section:   "hb",
+		Text:      keywords.NewText(fs, "text/kw/node/hb.file.max_skew"),
+		Types:     []string{"file"},
+	}
 	kwNodeHBRelayInsecure = keywords.Keyword{
 		Converter: "bool",
 		Default:   "false",
@@ -879,7 +1068,7 @@ var (
 		Text:    keywords.NewText(fs, "text/kw/node/cni.config"),
 	}
 	kwNodePoolType = keywords.Keyword{
-		Candidates: []string{"directory", "loop", "vg", "zpool", "freenas", "share", "shm", "symmetrix", "truenas", "virtual", "dorado", "hoc", "drbd", "pure", "rados"},
+		Candidates: []string{"directory", "loop", "vg", "zpool", "btrfs", "freenas", "share", "shm", "symmetrix", "truenas", "virtual", "dorado", "hoc", "drbd", "pure", "rados", "lio"},
 		Default:    "directory",
 		Option:     "type",
 		Section:    "pool",
@@ -902,7 +1091,7 @@ var (
 		Scopable: true,
 		Section:  "pool",
 		Text:     keywords.NewText(fs, "text/kw/node/pool.array"),
-		Types:    []string{"freenas", "symmetrix", "dorado", "hoc", "pure", "truenas"},
+		Types:    []string{"freenas", "symmetrix", "dorado", "hoc", "pure", "truenas", "lio"},
 	}
 	kwNodePoolRadosRBDPool = keywords.Keyword{
 		Option:   "rbd_pool",
@@ -1034,6 +1223,30 @@ var (
 		Text:      keywords.NewText(fs, "text/kw/node/pool.freenas.blocksize"),
 		Types:     []string{"freenas", "truenas"},
 	}
+	kwNodePoolLIOBackstore = keywords.Keyword{
+		Candidates: []string{"fileio", "lv"},
+		Default:    "fileio",
+		Option:     "backstore",
+		Section:    "pool",
+		Text:       keywords.NewText(fs, "text/kw/node/pool.lio.backstore"),
+		Types:      []string{"lio"},
+	}
+	kwNodePoolLIODiskGroup = keywords.Keyword{
+		Example:  "/srv/lio",
+		Option:   "diskgroup",
+		Required: true,
+		Section:  "pool",
+		Text:     keywords.NewText(fs, "text/kw/node/pool.lio.diskgroup"),
+		Types:    []string{"lio"},
+	}
+	kwNodePoolLIOSparse = keywords.Keyword{
+		Converter: "bool",
+		Default:   "true",
+		Option:    "sparse",
+		Section:   "pool",
+		Text:      keywords.NewText(fs, "text/kw/node/pool.lio.sparse"),
+		Types:     []string{"lio"},
+	}
 	kwNodePoolName = keywords.Keyword{
 		Option:   "name",
 		Required: true,
@@ -1081,6 +1294,14 @@ var (
 		Text:     keywords.NewText(fs, "text/kw/node/pool.zpool.name"),
 		Types:    []string{"zpool"},
 	}
+	kwNodePoolBtrfsDev = keywords.Keyword{
+		Example:  "LABEL=data",
+		Option:   "dev",
+		Required: true,
+		Section:  "pool",
+		Text:     keywords.NewText(fs, "text/kw/node/pool.btrfs.dev"),
+		Types:    []string{"btrfs"},
+	}
 	kwNodePoolZpoolPool = keywords.Keyword{
 		Option:  "zpool",
 		Section: "pool",
@@ -1326,7 +1547,7 @@ var (
 		Types:   []string{"brocade"},
 	}
 	kwNodeArrayType = keywords.Keyword{
-		Candidates: []string{"freenas", "hds", "eva", "nexenta", "vioserver", "centera", "symmetrix", "emcvnx", "netapp", "hp3par", "ibmds", "ibmsvc", "xtremio", "dorado", "hoc", "truenas"},
+		Candidates: []string{"freenas", "hds", "eva", "nexenta", "vioserver", "centera", "symmetrix", "emcvnx", "netapp", "hp3par", "ibmds", "ibmsvc", "xtremio", "dorado", "hoc", "truenas", "lio"},
 		Option:     "type",
 		Required:   true,
 		Section:    "array",
@@ -1423,7 +1644,7 @@ var (
 		Option:  "username",
 		Section: "array",
 		Text:    keywords.NewText(fs, "text/kw/node/array.username,optional"),
-		Types:   []string{"emcvnx", "hp3par", "symmetrix"},
+		Types:   []string{"emcvnx", "hp3par", "symmetrix", "lio"},
 	}
 	kwNodeArrayPureClientID = keywords.Keyword{
 		Example:  "bd2c75d0-f0d5-11ee-a362-8b0f2d1b83d7",
@@ -1533,7 +1754,22 @@ var (
 		Required: true,
 		Section:  "array",
 		Text:     keywords.NewText(fs, "text/kw/node/array.server"),
-		Types:    []string{"centera", "netapp"},
+		Types:    []string{"centera", "netapp", "lio"},
+	}
+	kwNodeArrayLIOKey = keywords.Keyword{
+		Example: "/root/.ssh/id_ed25519",
+		Option:  "key",
+		Section: "array",
+		Text:    keywords.NewText(fs, "text/kw/node/array.lio.key"),
+		Types:   []string{"lio"},
+	}
+	kwNodeArrayLIOTarget = keywords.Keyword{
+		Example:  "iqn.2003-01.org.linux-iscsi.lio1:sn.opensvc",
+		Option:   "target",
+		Required: true,
+		Section:  "array",
+		Text:     keywords.NewText(fs, "text/kw/node/array.lio.target"),
+		Types:    []string{"lio"},
 	}
 	kwNodeArrayCenteraJavaBin = keywords.Keyword{
 		Example:  "/opt/java/bin/java",
@@ -1712,6 +1948,7 @@ var (
 		&kwNodeConsoleServer,
 		&kwNodeMaxParallel,
 		&kwNodeMaxKeySize,
+		&kwNodeConfigHistoryMax,
 		&kwNodeAllowedNetworks,
 		&kwNodeLocCountry,
 		&kwNodeLocCity,
@@ -1741,9 +1978,12 @@ var (
 		&kwNodeRepoComp,
 		&kwNodeRUser,
 		&kwNodeMaintenanceGracePeriod,
+		&kwNodeMaintenanceWindow,
+		&kwNodeMaintenanceMode,
 		&kwNodeRejoinGracePeriod,
 		&kwNodeReadyPeriod,
 		&kwNodeSysreportSchedule,
+		&kwNodeSysreportSnapshots,
 		&kwNodeComplianceSchedule,
 		&kwNodeArraySchedule,
 		&kwNodeArrayXtremioName,
@@ -1762,6 +2002,26 @@ var (
 		&kwNodeListenerRateLimiterRate,
 		&kwNodeListenerRateLimiterBurst,
 		&kwNodeListenerRateLimiterExpires,
+		&kwNodeDNSAddr,
+		&kwNodeDNSPort,
+		&kwNodeDNSAXFRAllow,
+		&kwNodeDNSHealthAware,
+		&kwNodeDNSUpdateServer,
+		&kwNodeDNSUpdateZone,
+		&kwNodeDNSUpdateTSIGKeyName,
+		&kwNodeDNSUpdateTSIGSecret,
+		&kwNodeDNSUpdateTSIGAlgorithm,
+		&kwNodeDNSUpdateReconcileInterval,
+		&kwNodeAuditMaxSize,
+		&kwNodeAuditMaxBackups,
+		&kwNodeAuditForwardURL,
+		&kwNodeAuditForwardTimeout,
+		&kwNodeRoleAllow,
+		&kwNodeRoleKinds,
+		&kwNodeRemoteURL,
+		&kwNodeRemoteCredentials,
+		&kwNodeRemoteUsername,
+		&kwNodeRemoteInsecure,
 		&kwNodeSyslogFacility,
 		&kwNodeSyslogLevel,
 		&kwNodeSyslogHost,
@@ -1794,6 +2054,8 @@ var (
 		&kwNodeHBUnicastNodes,
 		&kwNodeHBDiskDev,
 		&kwNodeHBDiskMaxSlots,
+		&kwNodeHBFilePath,
+		&kwNodeHBFileMaxSkew,
 		&kwNodeHBRelayInsecure,
 		&kwNodeHBRelayRelay,
 		&kwNodeHBRelayUsername,
@@ -1823,12 +2085,16 @@ var (
 		&kwNodePoolTruenasCompression,
 		&kwNodePoolTruenasSparse,
 		&kwNodePoolTruenasBlockSize,
+		&kwNodePoolLIOBackstore,
+		&kwNodePoolLIODiskGroup,
+		&kwNodePoolLIOSparse,
 		&kwNodePoolName,
 		&kwNodePoolDRBDAddr,
 		&kwNodePoolDRBDMaxPeers,
 		&kwNodePoolDRBDTemplate,
 		&kwNodePoolDRBDVG,
 		&kwNodePoolZpoolName,
+		&kwNodePoolBtrfsDev,
 		&kwNodePoolZpoolPool,
 		&kwNodePoolZpoolPath,
 		&kwNodePoolSharePath,
@@ -1890,6 +2156,8 @@ var (
 		&kwNodeArraySymmetrixSymcliPath,
 		&kwNodeArraySymmetrixSymcliConnect,
 		&kwNodeArrayServer,
+		&kwNodeArrayLIOKey,
+		&kwNodeArrayLIOTarget,
 		&kwNodeArrayCenteraJavaBin,
 		&kwNodeArrayCenteraJcassDir,
 		&kwNodeArrayEMCVNXSecFile,
@@ -40,13 +40,16 @@ func (t *Node) newScheduleEntry(action, section, rid, base string) schedule.Entr
 }
 
 func (t *Node) Schedules() schedule.Table {
+	sysreportEntry := t.newScheduleEntry("sysreport", "sysreport", "", "sysreport_push")
+	// the sysreport keeps local snapshots, even without collector.
+	sysreportEntry.RequireCollector = false
 	table := schedule.NewTable(
 		t.newScheduleEntry("pushasset", "asset", "", "asset_push"),
 		t.newScheduleEntry("checks", "checks", "", "checks_push"),
 		t.newScheduleEntry("compliance_auto", "compliance", "", "comp_check"),
 		t.newScheduleEntry("pushdisks", "disks", "", "disks_push"),
 		t.newScheduleEntry("pushpkg", "packages", "", "packages_push"),
-		t.newScheduleEntry("sysreport", "sysreport", "", "sysreport_push"),
+		sysreportEntry,
 	)
 	for _, s := range t.config.SectionStrings() {
 		rid, err := resourceid.Parse(s)
@@ -1,12 +1,21 @@
 package object
 
-import "github.com/opensvc/om3/v3/util/sysreport"
+import (
+	"errors"
+
+	"github.com/opensvc/om3/v3/core/collector"
+	"github.com/opensvc/om3/v3/util/key"
+	"github.com/opensvc/om3/v3/util/sysreport"
+)
 
 // Sysreport sends an archive of modified files the agent is configured
 // to track, and the list of files deleted since the last call.
 //
 // The collector is in charge of versioning this information and of
 // reporting on changes.
+//
+// A local snapshot of the collection is kept, even if no collector is
+// configured, so the nodes can be compared without a collector.
 func (t Node) Sysreport() error {
 	sr, err := t.newSysreport()
 	if err != nil {
@@ -26,10 +35,13 @@ func (t Node) ForceSysreport() error {
 
 func (t Node) newSysreport() (*sysreport.T, error) {
 	client, err := t.CollectorFeedClient()
-	if err != nil {
+	if err != nil && !errors.Is(err, collector.ErrConfig) {
 		return nil, err
 	}
 	sr := sysreport.New()
-	sr.SetCollectorClient(client)
+	sr.SetSnapshotMax(t.mergedConfig.GetInt(key.New("sysreport", "snapshots")))
+	if client != nil {
+		sr.SetCollectorClient(client)
+	}
 	return sr, nil
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/opensvc/om3/v3/core/actioncontext"
 	"github.com/opensvc/om3/v3/core/keywords"
 	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/nspolicy"
 	"github.com/opensvc/om3/v3/util/funcopt"
 	"github.com/opensvc/om3/v3/util/key"
 	"github.com/opensvc/om3/v3/util/pg"
@@ -20,6 +21,7 @@ type (
 	Nscfg interface {
 		Core
 		PG() *pg.Config
+		Policy() (nspolicy.Policy, error)
 	}
 )
 
@@ -67,3 +69,23 @@ func (t *nscfg) lockedPGUpdate(ctx context.Context) error {
 	// For nscfg, we control the namespace cgroup, not the object cgroup
 	return t.PGConfig().Apply()
 }
+
+// Policy returns the namespace quotas and policies defined by the nscfg
+// DEFAULT section keywords.
+func (t *nscfg) Policy() (nspolicy.Policy, error) {
+	var (
+		policy nspolicy.Policy
+		err    error
+	)
+	if policy.MaxObjects, err = nspolicy.ParseMaxObjects(t.config.GetStrings(key.Parse("quota_objects"))); err != nil {
+		return policy, err
+	}
+	if policy.MaxPoolSize, err = nspolicy.ParseMaxPoolSize(t.config.GetStrings(key.Parse("quota_pool_size"))); err != nil {
+		return policy, err
+	}
+	policy.MaxFlexInstances = t.config.GetInt(key.Parse("quota_flex_instances"))
+	policy.AllowedDrivers = t.config.GetStrings(key.Parse("allowed_drivers"))
+	policy.DeniedDrivers = t.config.GetStrings(key.Parse("denied_drivers"))
+	policy.AllowedNodes = t.config.GetStrings(key.Parse("allowed_nodes"))
+	return policy, nil
+}
@@ -0,0 +1,90 @@
+package object
+
+import (
+	"fmt"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/nspolicy"
+	"github.com/opensvc/om3/v3/core/resourceid"
+	"github.com/opensvc/om3/v3/core/topology"
+	"github.com/opensvc/om3/v3/util/key"
+)
+
+// NewNamespacePolicyObject returns the contribution of the object o config
+// to its namespace usage, and the properties verified by the namespace
+// policy.
+//
+// The volume resources are accounted in the pool usage only if volExists
+// returns false for their vol object path, as the existing vol objects are
+// accounted by themselves. The volumes without an explicit pool are listed
+// in AutoPoolVolumes instead.
+func NewNamespacePolicyObject(o any, volExists func(naming.Path) bool) (nspolicy.Object, error) {
+	c, ok := o.(Core)
+	if !ok {
+		return nspolicy.Object{}, fmt.Errorf("%v is not an object", o)
+	}
+	p := c.Path()
+	cfg := c.Config()
+	nodes, err := c.Nodes()
+	if err != nil {
+		return nspolicy.Object{}, err
+	}
+	obj := nspolicy.Object{
+		Path:     p,
+		Nodes:    nodes,
+		PoolSize: make(map[string]int64),
+	}
+	switch p.Kind {
+	case naming.KindSvc, naming.KindVol:
+	default:
+		return obj, nil
+	}
+	if topology.New(cfg.GetString(key.Parse("topology"))) == topology.Flex {
+		obj.FlexInstances = cfg.GetInt(key.Parse("flex_target"))
+		if obj.FlexInstances > len(nodes) {
+			obj.FlexInstances = len(nodes)
+		}
+	}
+	if p.Kind == naming.KindVol {
+		if pool := cfg.GetString(key.Parse("pool")); pool == "" {
+			obj.AutoPoolVolumes = append(obj.AutoPoolVolumes, p.String())
+		} else if size := cfg.GetSize(key.Parse("size")); size != nil {
+			obj.PoolSize[pool] += *size
+		}
+	}
+	for _, section := range cfg.SectionStrings() {
+		rid, err := resourceid.Parse(section)
+		if err != nil {
+			continue
+		}
+		group := rid.DriverGroup()
+		switch group {
+		case driver.GroupUnknown:
+			continue
+		case driver.GroupVolume:
+			// the volume resource type keyword is the pool type, not a
+			// driver type.
+			obj.Drivers = append(obj.Drivers, group.String())
+			volPath := naming.Path{Namespace: p.Namespace, Kind: naming.KindVol, Name: cfg.GetString(key.New(section, "name"))}
+			if volExists != nil && volExists(volPath) {
+				continue
+			}
+			pool := cfg.GetString(key.New(section, "pool"))
+			if pool == "" {
+				obj.AutoPoolVolumes = append(obj.AutoPoolVolumes, section)
+				continue
+			}
+			if size := cfg.GetSize(key.New(section, "size")); size != nil {
+				obj.PoolSize[pool] += *size
+			}
+		default:
+			if typ := cfg.GetString(key.New(section, "type")); typ != "" {
+				obj.Drivers = append(obj.Drivers, group.String()+"."+typ)
+			} else {
+				obj.Drivers = append(obj.Drivers, group.String())
+			}
+		}
+	}
+	return obj, nil
+}
@@ -61,6 +61,12 @@ func (t *core) pgAnonConfig(section string) *pg.Config {
 	data.MemOOMControl, _ = t.config.EvalNoConv(key.New(section, "pg_mem_oom_control"))
 	data.MemSwappiness, _ = t.config.EvalNoConv(key.New(section, "pg_mem_swappiness"))
 	data.BlockIOWeight, _ = t.config.EvalNoConv(key.New(section, "pg_blkio_weight"))
+	data.IOMax, _ = t.config.EvalNoConv(key.New(section, "pg_io_max"))
+	data.PidsMax, _ = t.config.EvalNoConv(key.New(section, "pg_pids_max"))
+	data.MemHigh, _ = t.config.EvalNoConv(key.New(section, "pg_mem_high"))
+	data.MemMin, _ = t.config.EvalNoConv(key.New(section, "pg_mem_min"))
+	data.CPUWeight, _ = t.config.EvalNoConv(key.New(section, "pg_cpu_weight"))
+	data.CPUMaxBurst, _ = t.config.EvalNoConv(key.New(section, "pg_cpu_max_burst"))
 	return &data
 }
 
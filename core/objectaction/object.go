@@ -508,12 +508,27 @@ func doPostObjectAction(ctx context.Context, c *client.T, target instance.Monito
 		} else {
 			params.Destination = options.Destination
 			params.Live = options.Live
+			if options.BlueGreen {
+				params.BlueGreen = &options.BlueGreen
+			}
 		}
 		resp, err := c.PostObjectActionSwitchWithResponse(ctx, p.Namespace, p.Kind, p.Name, params)
 		if err != nil {
 			return nil, err
 		}
 		return handleStatusCode(resp.StatusCode(), resp.Body, resp.JSON400, resp.JSON401, resp.JSON403, resp.JSON404, resp.JSON408, resp.JSON409, resp.JSON500)
+	case instance.MonitorGlobalExpectMoved:
+		params := api.PostObjectActionMigrate{}
+		if options, ok := targetOptions.(instance.MonitorGlobalExpectOptionsMoved); !ok {
+			return nil, fmt.Errorf("unexpected orchestration options: %#v", targetOptions)
+		} else {
+			params.Destination = options.Destination
+		}
+		resp, err := c.PostObjectActionMigrateWithResponse(ctx, p.Namespace, p.Kind, p.Name, params)
+		if err != nil {
+			return nil, err
+		}
+		return handleStatusCode(resp.StatusCode(), resp.Body, resp.JSON400, resp.JSON401, resp.JSON403, resp.JSON404, resp.JSON408, resp.JSON409, resp.JSON500)
 	default:
 		return nil, fmt.Errorf("unexpected global expect: %s", target)
 	}
@@ -957,6 +972,20 @@ func (t T) waitExpectation(ctx context.Context, c *client.T, idC <-chan uuid.UUI
 				return assertPlacedAt(p, option, status.Up, status.NotApplicable)
 			}
 		}
+	case instance.MonitorGlobalExpectMoved:
+		filters = append(filters,
+			"InstanceStatusUpdated,path="+p.String(),
+			"InstanceStatusDeleted,path="+p.String(),
+		)
+
+		checkFunc = func() error {
+			if option, ok := targetOptions.(instance.MonitorGlobalExpectOptionsMoved); !ok {
+				return fmt.Errorf("unexpected orchestration options: %#v", targetOptions)
+			} else {
+				placedAt := instance.MonitorGlobalExpectOptionsPlacedAt{Destination: []string{option.Destination}}
+				return assertPlacedAt(p, placedAt, status.Up, status.NotApplicable)
+			}
+		}
 	}
 
 	filters = append(filters,
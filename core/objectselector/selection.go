@@ -43,6 +43,10 @@ type (
 		isConfigFilterDisabled bool
 		needCheckFilters       bool
 
+		// status is the source of the object states used to evaluate the
+		// status expressions.
+		status StatusGetter
+
 		server string
 	}
 )
@@ -153,11 +157,16 @@ func (t *Selection) checkFilters() error {
 		return nil
 	}
 	for _, s := range strings.Split(t.selectorExpression, ",") {
-		if len(s) == 0 {
-			continue
-		}
-		if configExpressionRegex.MatchString(s) {
-			return fmt.Errorf("selection with config filter disabled can't use filter: '%s'", s)
+		for _, s := range strings.Split(s, "+") {
+			if len(s) == 0 {
+				continue
+			}
+			if isStatusExpression(strings.TrimLeft(s, expressionNegationPrefix)) {
+				continue
+			}
+			if configExpressionRegex.MatchString(s) {
+				return fmt.Errorf("selection with config filter disabled can't use filter: '%s'", s)
+			}
 		}
 	}
 	return nil
@@ -299,6 +308,8 @@ func (t *Selection) localExpandOneNegative(s string) (*orderedset.OrderedSet, er
 
 func (t *Selection) localExpandOnePositive(s string) (*orderedset.OrderedSet, error) {
 	switch {
+	case isStatusExpression(s):
+		return t.localStatusExpand(s)
 	case fnmatchExpressionRegex.MatchString(s):
 		return t.localFnmatchExpand(s)
 	case configExpressionRegex.MatchString(s):
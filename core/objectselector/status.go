@@ -0,0 +1,213 @@
+package objectselector
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goombaio/orderedset"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/util/funcopt"
+)
+
+type (
+	// StatusGetter is the source of the object and instance states used
+	// to evaluate the status expressions, like ".avail=down".
+	StatusGetter interface {
+		// ObjectStatus returns the aggregated status of the object p, or
+		// nil if unknown.
+		ObjectStatus(p naming.Path) *object.Status
+
+		// InstanceStatus returns the status of the p instances, indexed by
+		// node name.
+		InstanceStatus(p naming.Path) map[string]*instance.Status
+	}
+
+	// statusMatcher returns true if the object p states match a status
+	// expression.
+	statusMatcher func(getter StatusGetter, p naming.Path) bool
+
+	daemonData struct{}
+)
+
+const (
+	statusExpressionPrefix = "."
+)
+
+var (
+	// DaemonData is the StatusGetter reading the states from the daemon
+	// in-memory cluster data.
+	DaemonData StatusGetter = daemonData{}
+)
+
+// WithStatus sets the source of the object and instance states used to
+// evaluate the status expressions.
+func WithStatus(getter StatusGetter) funcopt.O {
+	return funcopt.F(func(i interface{}) error {
+		t := i.(*Selection)
+		t.status = getter
+		return nil
+	})
+}
+
+// HasStatusExpression returns true if the selector expression contains
+// at least one status expression, whose result depends on the object
+// states.
+func HasStatusExpression(selector string) bool {
+	for _, s := range strings.Split(selector, ",") {
+		for _, s := range strings.Split(s, "+") {
+			if isStatusExpression(strings.TrimLeft(s, expressionNegationPrefix)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isStatusExpression(s string) bool {
+	return strings.HasPrefix(s, statusExpressionPrefix)
+}
+
+func (t daemonData) ObjectStatus(p naming.Path) *object.Status {
+	return object.StatusData.GetByPath(p)
+}
+
+func (t daemonData) InstanceStatus(p naming.Path) map[string]*instance.Status {
+	return instance.StatusData.GetByPath(p)
+}
+
+func (t *Selection) localStatusExpand(s string) (*orderedset.OrderedSet, error) {
+	matching := orderedset.NewOrderedSet()
+	if t.status == nil {
+		return matching, fmt.Errorf("status expression '%s' needs the daemon cluster data", s)
+	}
+	match, err := parseStatusExpression(s)
+	if err != nil {
+		return matching, err
+	}
+	paths, err := t.getPaths()
+	if err != nil {
+		return matching, err
+	}
+	for _, p := range paths {
+		if match(t.status, p) {
+			matching.Add(p.String())
+		}
+	}
+	return matching, nil
+}
+
+// parseStatusExpression returns the matcher of a status expression like:
+//
+//	.avail=down
+//	.overall=warn@node1
+//	.frozen
+//	.provisioned=false
+//	.placement=non-optimal
+//	.orchestrate=ha
+//	.running@node1
+//
+// The @<node> suffix evaluates the expression against the instance states
+// on this node, instead of the object aggregated states.
+func parseStatusExpression(s string) (statusMatcher, error) {
+	expr := strings.TrimPrefix(s, statusExpressionPrefix)
+	attr, nodename, _ := strings.Cut(expr, "@")
+	attr, value, hasValue := strings.Cut(attr, "=")
+	value = strings.ToLower(value)
+
+	needValue := func() error {
+		if !hasValue || value == "" {
+			return fmt.Errorf("invalid status expression '%s': %s needs a value", s, attr)
+		}
+		return nil
+	}
+
+	// onInstances returns a matcher true if an instance on nodename, or
+	// on any node if nodename is empty, verifies fn.
+	onInstances := func(fn func(*instance.Status) bool) statusMatcher {
+		return func(getter StatusGetter, p naming.Path) bool {
+			for n, instStatus := range getter.InstanceStatus(p) {
+				if instStatus == nil || (nodename != "" && n != nodename) {
+					continue
+				}
+				if fn(instStatus) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	// onObject returns a matcher true if the object status verifies fn.
+	onObject := func(fn func(*object.Status) bool) statusMatcher {
+		return func(getter StatusGetter, p naming.Path) bool {
+			objStatus := getter.ObjectStatus(p)
+			if objStatus == nil || objStatus.ActorStatus == nil {
+				return false
+			}
+			return fn(objStatus)
+		}
+	}
+
+	switch attr {
+	case "avail":
+		if err := needValue(); err != nil {
+			return nil, err
+		}
+		if nodename != "" {
+			return onInstances(func(v *instance.Status) bool { return v.Avail.String() == value }), nil
+		}
+		return onObject(func(v *object.Status) bool { return v.Avail.String() == value }), nil
+	case "overall":
+		if err := needValue(); err != nil {
+			return nil, err
+		}
+		if nodename != "" {
+			return onInstances(func(v *instance.Status) bool { return v.Overall.String() == value }), nil
+		}
+		return onObject(func(v *object.Status) bool { return v.Overall.String() == value }), nil
+	case "provisioned":
+		if err := needValue(); err != nil {
+			return nil, err
+		}
+		if nodename != "" {
+			return onInstances(func(v *instance.Status) bool { return v.Provisioned.String() == value }), nil
+		}
+		return onObject(func(v *object.Status) bool { return v.Provisioned.String() == value }), nil
+	case "frozen":
+		if !hasValue {
+			value = "frozen"
+		}
+		if nodename != "" {
+			switch value {
+			case "frozen":
+				return onInstances(func(v *instance.Status) bool { return v.IsFrozen() }), nil
+			case "unfrozen":
+				return onInstances(func(v *instance.Status) bool { return v.IsUnfrozen() }), nil
+			default:
+				return nil, fmt.Errorf("invalid status expression '%s': instance frozen value must be frozen or unfrozen", s)
+			}
+		}
+		return onObject(func(v *object.Status) bool { return v.Frozen == value }), nil
+	case "placement":
+		if err := needValue(); err != nil {
+			return nil, err
+		}
+		return onObject(func(v *object.Status) bool { return v.PlacementState.String() == value }), nil
+	case "orchestrate":
+		if err := needValue(); err != nil {
+			return nil, err
+		}
+		return onObject(func(v *object.Status) bool { return v.Orchestrate == value }), nil
+	case "running":
+		if hasValue {
+			return nil, fmt.Errorf("invalid status expression '%s': running accepts no value", s)
+		}
+		return onInstances(func(v *instance.Status) bool { return v.Avail == status.Up }), nil
+	default:
+		return nil, fmt.Errorf("invalid status expression '%s': unknown attribute '%s'", s, attr)
+	}
+}
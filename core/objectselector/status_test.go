@@ -0,0 +1,104 @@
+package objectselector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/placement"
+	"github.com/opensvc/om3/v3/core/provisioned"
+	"github.com/opensvc/om3/v3/core/status"
+)
+
+type testStatus struct {
+	objects   map[string]*object.Status
+	instances map[string]map[string]*instance.Status
+}
+
+func (t testStatus) ObjectStatus(p naming.Path) *object.Status {
+	return t.objects[p.String()]
+}
+
+func (t testStatus) InstanceStatus(p naming.Path) map[string]*instance.Status {
+	return t.instances[p.String()]
+}
+
+func TestStatusExpression(t *testing.T) {
+	getter := testStatus{
+		objects: map[string]*object.Status{
+			"svc1": {ActorStatus: &object.ActorStatus{
+				Avail:          status.Up,
+				Overall:        status.Warn,
+				Frozen:         "unfrozen",
+				Orchestrate:    "ha",
+				PlacementState: placement.NonOptimal,
+				Provisioned:    provisioned.True,
+			}},
+			"svc2": {ActorStatus: &object.ActorStatus{
+				Avail:          status.Down,
+				Overall:        status.Down,
+				Frozen:         "frozen",
+				Orchestrate:    "no",
+				PlacementState: placement.Optimal,
+				Provisioned:    provisioned.False,
+			}},
+		},
+		instances: map[string]map[string]*instance.Status{
+			"svc1": {
+				"node1": {Avail: status.Up},
+				"node2": {Avail: status.Down, FrozenAt: time.Now()},
+			},
+			"svc2": {
+				"node1": {Avail: status.Down, FrozenAt: time.Now()},
+				"node2": {Avail: status.Down, FrozenAt: time.Now()},
+			},
+		},
+	}
+	paths := naming.Paths{
+		naming.Path{Name: "svc1", Namespace: "root", Kind: naming.KindSvc},
+		naming.Path{Name: "svc2", Namespace: "root", Kind: naming.KindSvc},
+	}
+	tests := map[string][]string{
+		".avail=down":               {"svc2"},
+		".overall=warn":             {"svc1"},
+		".frozen":                   {"svc2"},
+		"!.frozen":                  {"svc1"},
+		".provisioned=false":        {"svc2"},
+		".placement=non-optimal":    {"svc1"},
+		".orchestrate=ha":           {"svc1"},
+		".running":                  {"svc1"},
+		".running@node1":            {"svc1"},
+		".running@node2":            {},
+		".frozen@node2":             {"svc1", "svc2"},
+		"*+.avail=up":               {"svc1"},
+		".avail=up,.avail=down":     {"svc1", "svc2"},
+		"svc*+.orchestrate=no":      {"svc2"},
+		".avail=down@node1+.frozen": {"svc2"},
+	}
+	for selector, expected := range tests {
+		t.Run(selector, func(t *testing.T) {
+			selection := New(selector, WithLocal(true), WithPaths(paths), WithStatus(getter))
+			matched, err := selection.ExpandRelaxed()
+			require.NoError(t, err)
+			require.ElementsMatch(t, expected, matched.StrSlice())
+		})
+	}
+}
+
+func TestStatusExpressionInvalid(t *testing.T) {
+	for _, s := range []string{".avail", ".running=up", ".foo=bar", ".frozen=mixed@node1"} {
+		t.Run(s, func(t *testing.T) {
+			_, err := parseStatusExpression(s)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestStatusExpressionWithConfigFilterDisabled(t *testing.T) {
+	require.NoError(t, New("*/svc/*+.avail=down", WithConfigFilterDisabled()).CheckFilters())
+	require.Error(t, New("*/svc/*+env=prod", WithConfigFilterDisabled()).CheckFilters())
+}
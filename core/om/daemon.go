@@ -7,6 +7,7 @@ import (
 
 func init() {
 	cmdDaemon := commoncmd.NewCmdDaemon()
+	cmdDaemonAudit := commoncmd.NewCmdDaemonAudit()
 	cmdDaemonDNS := commoncmd.NewCmdDaemonDNS()
 	cmdDaemonHeartbeat := commoncmd.NewCmdDaemonHeartbeat()
 	cmdDaemonListener := commoncmd.NewCmdDaemonListener()
@@ -34,13 +35,17 @@ func init() {
 		newCmdDaemonStart(),
 		newCmdDaemonStop(),
 		commoncmd.NewCmdDaemonAuth(),
-		commoncmd.NewCmdDaemonAudit(),
+		cmdDaemonAudit,
 		commoncmd.NewCmdDaemonLog(),
 		commoncmd.NewCmdDaemonStatus(),
 		commoncmd.NewCmdDaemonPs(),
 		commoncmd.NewCmdDaemonKill(),
 	)
 
+	cmdDaemonAudit.AddCommand(
+		commoncmd.NewCmdDaemonAuditLog(),
+	)
+
 	cmdDaemonDNS.AddCommand(
 		commoncmd.NewCmdDaemonDNSDump(),
 	)
@@ -135,6 +135,26 @@ func newCmdArrayList() *cobra.Command {
 	return cmd
 }
 
+func newCmdClusterBackup() *cobra.Command {
+	var options commands.CmdClusterBackup
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "archive the cluster, node and object configs and keys",
+		Long: "Write an encrypted archive of the cluster config, the node configs, the object configs and the decoded cfg, sec and usr keys.\n" +
+			"The archive is encrypted with a key derived from a passphrase read from stdin, or prompted if stdin is a terminal.\n" +
+			"The archive embeds a manifest with the sha256 checksum of each archived file.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&options.File, "file", "", "the path of the archive to write")
+	if err := cmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
 func newCmdClusterJoin() *cobra.Command {
 	var options commands.CmdClusterJoin
 	cmd := &cobra.Command{
@@ -162,6 +182,33 @@ func newCmdClusterJoin() *cobra.Command {
 	return cmd
 }
 
+func newCmdClusterRestore() *cobra.Command {
+	var options commands.CmdClusterRestore
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "restore configs and keys from a cluster backup archive",
+		Long: "Restore the object configs and keys from an archive written by 'cluster backup', using the object create, config update and key change api handlers.\n" +
+			"The cluster and node configs are restored only with '--cluster-config' and '--node-config'.\n" +
+			"The '--conflict' policy applies to the existing configs differing from their archived version.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagDryRun(flags, &options.DryRun)
+	flags.StringVar(&options.File, "file", "", "the path of the archive to restore")
+	if err := cmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+	flags.StringVar(&options.Namespace, "namespace", "", "restore only the objects of this namespace")
+	flags.BoolVar(&options.ClusterConfig, "cluster-config", false, "also restore the cluster config")
+	flags.BoolVar(&options.NodeConfig, "node-config", false, "also restore the configs of the nodes member of the cluster")
+	flags.StringVar(&options.Conflict, "conflict", "skip", "the policy applied to existing configs differing from the archive: skip|overwrite|abort")
+	flags.DurationVar(&options.Timeout, "timeout", 10*time.Second, "maximum duration to wait for a created cfg, sec or usr object before restoring its keys")
+	return cmd
+}
+
 func newCmdClusterLeave() *cobra.Command {
 	var options commands.CmdClusterLeave
 	cmd := &cobra.Command{
@@ -449,6 +496,23 @@ func newCmdObjectKeyRename(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdNamespaceUsage() *cobra.Command {
+	var options commands.CmdNamespaceUsage
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "show the namespaces usage, quotas and policies",
+		Long:  "The quotas and policies are defined by the namespace nscfg object keywords, and enforced by the daemon api at object create, config update and provision time.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	commoncmd.FlagColor(flags, &options.Color)
+	commoncmd.FlagOutput(flags, &options.Output)
+	flags.StringVar(&options.Namespace, "namespace", "", "show only this namespace")
+	return cmd
+}
+
 func newCmdNetworkList() *cobra.Command {
 	var options commands.CmdNetworkList
 	cmd := &cobra.Command{
@@ -1418,6 +1482,26 @@ func newCmdNodeSysreport() *cobra.Command {
 	return cmd
 }
 
+func newCmdNodeSysreportDiff() *cobra.Command {
+	var options commands.CmdNodeSysreportDiff
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "show the files, command outputs and packages differing between sysreport snapshots",
+		Long: "Compare the local sysreport snapshots kept by the nodes, without a collector.\n\n" +
+			"With two '--node', compare the latest snapshots of the two nodes.\n" +
+			"With a single '--node', or none for the local node, compare the latest snapshot with the previous one, or with the most recent snapshot created before '--since'.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	commoncmd.FlagColor(flags, &options.Color)
+	commoncmd.FlagOutput(flags, &options.Output)
+	flags.StringArrayVar(&options.Nodes, "node", nil, "a node whose latest snapshot is compared (repeatable, at most twice)")
+	flags.StringVar(&options.Since, "since", "", "compare with the snapshot at this RFC3339 or YYYY-MM-DD date, or this duration ago (ex: 7d)")
+	return cmd
+}
+
 func newCmdNodeUnfreeze() *cobra.Command {
 	var options commands.CmdNodeUnfreeze
 	cmd := &cobra.Command{
@@ -1752,6 +1836,26 @@ func newCmdObjectEdit(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectConfigDiff(kind string) *cobra.Command {
+	var options commands.CmdObjectConfigDiff
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "show the differences between object configuration revisions",
+		Long: `Show the unified diff between two object configuration revisions.
+
+With a single --rev, show the differences between this revision and the
+current configuration. Without --rev, show the differences between the two
+latest revisions.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	commoncmd.FlagObjectSelector(flags, &options.ObjectSelector)
+	flags.IntSliceVar(&options.Revs, "rev", []int{}, "a configuration revision id, as listed by 'config history'. can be specified twice")
+	return cmd
+}
+
 func newCmdObjectConfigEdit(kind string) *cobra.Command {
 	var options commands.CmdObjectConfigEdit
 	cmd := &cobra.Command{
@@ -2282,6 +2386,27 @@ func newCmdObjectList(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectConfigRollback(kind string) *cobra.Command {
+	var options commands.CmdObjectConfigRollback
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "restore an object configuration revision",
+		Long: `Replace the object configuration with the content of a recorded revision.
+
+The rollback is recorded as a new revision.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	commoncmd.FlagObjectSelector(flags, &options.ObjectSelector)
+	flags.IntVar(&options.Rev, "rev", 0, "the configuration revision id to restore, as listed by 'config history'")
+	if err := cmd.MarkFlagRequired("rev"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
 func newCmdObjectConfigShow(kind string) *cobra.Command {
 	var options commands.CmdObjectConfigShow
 	cmd := &cobra.Command{
@@ -2297,6 +2422,21 @@ func newCmdObjectConfigShow(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectConfigHistory(kind string) *cobra.Command {
+	var options commands.CmdObjectConfigHistory
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "list the object configuration revisions",
+		Aliases: []string{"hist"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	return cmd
+}
+
 func newCmdObjectConfigMtime(kind string) *cobra.Command {
 	var options commands.CmdObjectConfigMtime
 	cmd := &cobra.Command{
@@ -2444,6 +2584,7 @@ func newCmdObjectInstanceProvision(kind string) *cobra.Command {
 	commoncmd.FlagForce(flags, &options.Force)
 	commoncmd.FlagLeader(flags, &options.Leader)
 	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	commoncmd.FlagPlan(flags, &options.Plan)
 	commoncmd.HiddenFlagDisableRollback(flags, &options.DisableRollback)
 	commoncmd.FlagStateOnly(flags, &options.StateOnly)
 	return cmd
@@ -2498,6 +2639,89 @@ func newCmdObjectInstancePRStop(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectInstanceDrift(kind string) *cobra.Command {
+	var options commands.CmdObjectInstanceDrift
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "show the differences between the running resources and their configuration",
+		Long: `Compare the running state of the local instance resources with their
+configuration, and show the differences.
+
+An instance with drifting resources needs a restart to apply its configuration
+changes. The instance status drift flag reports the same condition.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	return cmd
+}
+
+func newCmdObjectInstanceImagePull(kind string) *cobra.Command {
+	var options commands.CmdObjectInstanceImagePull
+	cmd := &cobra.Command{
+		Use:   "pull",
+		Short: "pull the instance container images",
+		Long:  "Fetch the images of the container and task resources into the local image store.\n\nThe daemon runs this action on every node of the object when the image references change, so a failover or switch does not stall on the image download.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagsEncap(flags, &options.OptsEncap)
+	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
+	return cmd
+}
+
+func newCmdObjectInstanceMove(kind string) *cobra.Command {
+	var options commands.CmdObjectInstanceMove
+	cmd := &cobra.Command{
+		Use:   "move",
+		Short: "live migrate the instance to another node",
+		Long: "Live migrate the movable resources of the local instance to the '--to' node, and stop the other resources.\n\n" +
+			"If the move fails before a movable resource is migrated, the stopped resources are restarted. " +
+			"The 'migrate' orchestrated action runs this action on the source node, then starts the instance on the destination node.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagsLock(flags, &options.OptsLock)
+	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
+	commoncmd.FlagForce(flags, &options.Force)
+	commoncmd.FlagDisableRollback(flags, &options.DisableRollback)
+	commoncmd.FlagMigrateTo(flags, &options.To)
+	if err := cmd.MarkFlagRequired("to"); err != nil {
+		panic(err)
+	}
+	hiddenFlagLocal(flags, &options.Local)
+	return cmd
+}
+
+func newCmdObjectInstanceMoveCheck(kind string) *cobra.Command {
+	var options commands.CmdObjectInstanceMoveCheck
+	cmd := &cobra.Command{
+		Use:   "move-check",
+		Short: "verify the instance can be live migrated to another node",
+		Long:  "Verify the movable resources of the local instance can be live migrated to the '--to' node, for example the cpu compatibility and the free memory of the destination hypervisor.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
+	commoncmd.FlagMigrateTo(flags, &options.To)
+	if err := cmd.MarkFlagRequired("to"); err != nil {
+		panic(err)
+	}
+	hiddenFlagLocal(flags, &options.Local)
+	return cmd
+}
+
 func newCmdObjectInstancePGUpdate(kind string) *cobra.Command {
 	var options commands.CmdObjectInstancePGUpdate
 	cmd := &cobra.Command{
@@ -2588,6 +2812,7 @@ func newCmdObjectInstanceStart(kind string) *cobra.Command {
 	commoncmd.FlagForce(flags, &options.Force)
 	commoncmd.FlagDisableRollback(flags, &options.DisableRollback)
 	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	commoncmd.FlagPlan(flags, &options.Plan)
 	cmd.MarkFlagsMutuallyExclusive("no-lock", "node")
 	cmd.MarkFlagsMutuallyExclusive("waitlock", "node")
 	return cmd
@@ -2613,6 +2838,7 @@ func newCmdObjectInstanceStop(kind string) *cobra.Command {
 	commoncmd.FlagForce(flags, &options.Force)
 	commoncmd.FlagMoveTo(flags, &options.MoveTo)
 	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	commoncmd.FlagPlan(flags, &options.Plan)
 	cmd.MarkFlagsMutuallyExclusive("no-lock", "node")
 	cmd.MarkFlagsMutuallyExclusive("waitlock", "node")
 	return cmd
@@ -2691,6 +2917,7 @@ func newCmdObjectInstanceUnprovision(kind string) *cobra.Command {
 	commoncmd.FlagForce(flags, &options.Force)
 	commoncmd.FlagLeader(flags, &options.Leader)
 	commoncmd.FlagNodeSelector(flags, &options.NodeSelector)
+	commoncmd.FlagPlan(flags, &options.Plan)
 	commoncmd.FlagStateOnly(flags, &options.StateOnly)
 	return cmd
 }
@@ -2765,6 +2992,31 @@ func newCmdObjectPRStop(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectMigrate(kind string) *cobra.Command {
+	var options commands.CmdObjectMigrate
+	cmd := &cobra.Command{
+		GroupID: commoncmd.GroupIDOrchestratedActions,
+		Use:     "migrate",
+		Short:   "orchestrate a running instance live migration",
+		Long: "Live migrate the running failover instance to the '--to' node.\n\n" +
+			"The source node verifies the destination node capacity, shared storage visibility and cpu compatibility, " +
+			"then live migrates the movable resources and stops the other resources. " +
+			"The destination node then starts the other resources. " +
+			"On failure before a movable resource is migrated, the source instance is restored.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagMigrateTo(flags, &options.To)
+	if err := cmd.MarkFlagRequired("to"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
 func newCmdObjectPurge(kind string) *cobra.Command {
 	var options commands.CmdObjectPurge
 	cmd := &cobra.Command{
@@ -2830,6 +3082,30 @@ func newCmdObjectRestart(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectInstanceDRBDResolve(kind string) *cobra.Command {
+	var options commands.CmdObjectInstanceDRBDResolve
+	cmd := &cobra.Command{
+		Use:   "resolve",
+		Short: "resolve a drbd split-brain, keeping the data of a node",
+		Long: "Execute on all the nodes of the split-brain with the same '--keep' node.\n\n" +
+			"On the '--keep' node, the StandAlone peers are reconnected. " +
+			"On the other nodes, the instance must be stopped, and the changes made since the split-brain are discarded and resynced from the '--keep' node.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagsLock(flags, &options.OptsLock)
+	commoncmd.FlagsResourceSelector(cmd, &options.OptsResourceSelector)
+	flags.StringVar(&options.Keep, "keep", "", "the node whose data is kept")
+	if err := cmd.MarkFlagRequired("keep"); err != nil {
+		panic(err)
+	}
+	hiddenFlagLocal(flags, &options.Local)
+	return cmd
+}
+
 func newCmdObjectInstanceSyncIngest(kind string) *cobra.Command {
 	var options commands.CmdObjectInstanceSyncIngest
 	cmd := &cobra.Command{
@@ -3161,6 +3437,7 @@ func newCmdObjectSwitch(kind string) *cobra.Command {
 	commoncmd.FlagsAsync(flags, &options.OptsAsync)
 	commoncmd.FlagSwitchTo(flags, &options.To)
 	commoncmd.FlagLive(flags, &options.Live)
+	commoncmd.FlagBlueGreen(flags, &options.BlueGreen)
 	return cmd
 }
 
@@ -3407,6 +3684,44 @@ func newCmdObjectGet(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectSnapshotList(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotList
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "list the local instance data snapshots",
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	return cmd
+}
+
+func newCmdObjectSnapshotRestore(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotRestore
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "restore the local instance data from a snapshot",
+		Long: `Merge a snapshot back into its origin volume.
+
+The snapshot origin volume must not be in use, so the instance should be
+stopped first. The snapshot is consumed by the restore.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagsLock(flags, &options.OptsLock)
+	flags.StringVar(&options.ID, "id", "", "the snapshot id to restore, as listed by 'snapshot list'")
+	if err := cmd.MarkFlagRequired("id"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
 func newCmdObjectSet(kind string) *cobra.Command {
 	var options commands.CmdObjectSet
 	cmd := &cobra.Command{
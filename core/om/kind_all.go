@@ -22,6 +22,7 @@ func init() {
 	cmdObjectInstance := commoncmd.NewCmdObjectInstance(kind)
 	cmdObjectInstancePG := commoncmd.NewCmdObjectInstancePG(kind)
 	cmdObjectInstanceDevice := commoncmd.NewCmdObjectInstanceDevice(kind)
+	cmdObjectInstanceImage := commoncmd.NewCmdObjectInstanceImage(kind)
 	cmdObjectInstanceResource := commoncmd.NewCmdObjectInstanceResource(kind)
 	cmdObjectInstanceResourceInfo := commoncmd.NewCmdObjectInstanceResourceInfo(kind)
 	cmdObjectInstanceSync := commoncmd.NewCmdObjectInstanceSync(kind)
@@ -33,6 +34,7 @@ func init() {
 	cmdObjectResource := commoncmd.NewCmdObjectResource(kind)
 	cmdObjectSet := newCmdObjectSet(kind)
 	cmdObjectSchedule := commoncmd.NewCmdObjectSchedule(kind)
+	cmdObjectSnapshot := commoncmd.NewCmdObjectSnapshot(kind)
 	cmdObjectSync := commoncmd.NewCmdObjectSync(kind)
 	cmdObjectValidate := newCmdObjectValidate(kind)
 
@@ -57,6 +59,7 @@ func init() {
 		cmdObjectResource,
 		cmdObjectSet,
 		cmdObjectSchedule,
+		cmdObjectSnapshot,
 		cmdObjectSync,
 		cmdObjectValidate,
 		newCmdObjectAbort(kind),
@@ -74,6 +77,7 @@ func init() {
 		newCmdObjectLogs(kind),
 		newCmdObjectList(kind),
 		commoncmd.NewCmdObjectMonitor("", kind),
+		newCmdObjectMigrate(kind),
 		newCmdObjectPurge(kind),
 		newCmdObjectProvision(kind),
 		newCmdObjectPRStart(kind),
@@ -94,12 +98,16 @@ func init() {
 	)
 	cmdObjectInstance.AddCommand(
 		cmdObjectInstanceDevice,
+		cmdObjectInstanceImage,
 		cmdObjectInstanceSync,
 		cmdObjectInstanceResource,
 		newCmdObjectInstanceBoot(kind),
 		newCmdObjectInstanceDelete(kind),
+		newCmdObjectInstanceDrift(kind),
 		newCmdObjectInstanceFreeze(kind),
 		newCmdObjectInstanceList(kind),
+		newCmdObjectInstanceMove(kind),
+		newCmdObjectInstanceMoveCheck(kind),
 		newCmdObjectInstanceRun(kind),
 		newCmdObjectInstanceStatus(kind),
 		newCmdObjectInstanceProvision(kind),
@@ -117,6 +125,9 @@ func init() {
 	cmdObjectInstanceDevice.AddCommand(
 		newCmdObjectInstanceDeviceList(kind),
 	)
+	cmdObjectInstanceImage.AddCommand(
+		newCmdObjectInstanceImagePull(kind),
+	)
 	cmdObjectInstancePG.AddCommand(
 		newCmdObjectInstancePGUpdate(kind),
 	)
@@ -142,10 +153,13 @@ func init() {
 	)
 	cmdObjectConfig.AddCommand(
 		omcmd.NewCmdObjectConfigDoc(kind),
+		newCmdObjectConfigDiff(kind),
 		newCmdObjectConfigEdit(kind),
 		newCmdObjectConfigEval(kind),
 		newCmdObjectConfigGet(kind),
+		newCmdObjectConfigHistory(kind),
 		newCmdObjectConfigMtime(kind),
+		newCmdObjectConfigRollback(kind),
 		newCmdObjectConfigShow(kind),
 		newCmdObjectConfigUpdate(kind),
 		newCmdObjectConfigValidate(kind),
@@ -177,6 +191,10 @@ func init() {
 	cmdObjectPush.AddCommand(
 		newCmdObjectPushResourceInfo(kind),
 	)
+	cmdObjectSnapshot.AddCommand(
+		newCmdObjectSnapshotList(kind),
+		newCmdObjectSnapshotRestore(kind),
+	)
 	cmdObjectSync.AddCommand(
 		newCmdObjectInstanceSyncFull(kind),
 		newCmdObjectInstanceSyncIngest(kind),
@@ -32,8 +32,10 @@ func init() {
 		cmdObjectSSH,
 		cmdObjectPrint,
 		cmdObjectValidate,
+		newCmdClusterBackup(),
 		newCmdClusterJoin(),
 		newCmdClusterLeave(),
+		newCmdClusterRestore(),
 		commoncmd.NewCmdClusterAbort(),
 		commoncmd.NewCmdClusterFreeze(),
 		commoncmd.NewCmdClusterLogs(),
@@ -49,10 +51,13 @@ func init() {
 	)
 	cmdObjectConfig.AddCommand(
 		omcmd.NewCmdObjectConfigDoc(kind),
+		newCmdObjectConfigDiff(kind),
 		newCmdObjectConfigEdit(kind),
 		newCmdObjectConfigEval(kind),
 		newCmdObjectConfigGet(kind),
+		newCmdObjectConfigHistory(kind),
 		newCmdObjectConfigMtime(kind),
+		newCmdObjectConfigRollback(kind),
 		newCmdObjectConfigShow(kind),
 		newCmdObjectConfigUpdate(kind),
 		newCmdObjectConfigValidate(kind),
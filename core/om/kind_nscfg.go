@@ -53,10 +53,13 @@ func init() {
 
 	cmdObjectConfig.AddCommand(
 		omcmd.NewCmdObjectConfigDoc(kind),
+		newCmdObjectConfigDiff(kind),
 		newCmdObjectConfigEdit(kind),
 		newCmdObjectConfigEval(kind),
 		newCmdObjectConfigGet(kind),
+		newCmdObjectConfigHistory(kind),
 		newCmdObjectConfigMtime(kind),
+		newCmdObjectConfigRollback(kind),
 		newCmdObjectConfigShow(kind),
 		newCmdObjectConfigUpdate(kind),
 		newCmdObjectConfigValidate(kind),
@@ -22,6 +22,8 @@ func init() {
 	cmdObjectEdit := newCmdObjectEdit(kind)
 	cmdObjectInstance := commoncmd.NewCmdObjectInstance(kind)
 	cmdObjectInstanceDevice := commoncmd.NewCmdObjectInstanceDevice(kind)
+	cmdObjectInstanceDRBD := commoncmd.NewCmdObjectInstanceDRBD(kind)
+	cmdObjectInstanceImage := commoncmd.NewCmdObjectInstanceImage(kind)
 	cmdObjectInstancePG := commoncmd.NewCmdObjectInstancePG(kind)
 	cmdObjectInstanceSync := commoncmd.NewCmdObjectInstanceSync(kind)
 	cmdObjectInstanceResource := commoncmd.NewCmdObjectInstanceResource(kind)
@@ -34,6 +36,7 @@ func init() {
 	cmdObjectResource := commoncmd.NewCmdObjectResource(kind)
 	cmdObjectSchedule := commoncmd.NewCmdObjectSchedule(kind)
 	cmdObjectSet := newCmdObjectSet(kind)
+	cmdObjectSnapshot := commoncmd.NewCmdObjectSnapshot(kind)
 	cmdObjectSync := commoncmd.NewCmdObjectSync(kind)
 	cmdObjectValidate := newCmdObjectValidate(kind)
 
@@ -58,6 +61,7 @@ func init() {
 		cmdObjectResource,
 		cmdObjectSchedule,
 		cmdObjectSet,
+		cmdObjectSnapshot,
 		cmdObjectSync,
 		cmdObjectValidate,
 		newCmdObjectAbort(kind),
@@ -75,6 +79,7 @@ func init() {
 		newCmdObjectLogs(kind),
 		newCmdObjectList(kind),
 		commoncmd.NewCmdObjectMonitor("", kind),
+		newCmdObjectMigrate(kind),
 		newCmdObjectPurge(kind),
 		newCmdObjectProvision(kind),
 		newCmdObjectPRStart(kind),
@@ -95,10 +100,13 @@ func init() {
 	)
 	cmdObjectConfig.AddCommand(
 		omcmd.NewCmdObjectConfigDoc(kind),
+		newCmdObjectConfigDiff(kind),
 		newCmdObjectConfigEdit(kind),
 		newCmdObjectConfigEval(kind),
 		newCmdObjectConfigGet(kind),
+		newCmdObjectConfigHistory(kind),
 		newCmdObjectConfigMtime(kind),
+		newCmdObjectConfigRollback(kind),
 		newCmdObjectConfigShow(kind),
 		newCmdObjectConfigUpdate(kind),
 		newCmdObjectConfigValidate(kind),
@@ -118,13 +126,18 @@ func init() {
 	)
 	cmdObjectInstance.AddCommand(
 		cmdObjectInstanceDevice,
+		cmdObjectInstanceDRBD,
+		cmdObjectInstanceImage,
 		cmdObjectInstancePG,
 		cmdObjectInstanceSync,
 		cmdObjectInstanceResource,
 		newCmdObjectInstanceBoot(kind),
 		newCmdObjectInstanceDelete(kind),
+		newCmdObjectInstanceDrift(kind),
 		newCmdObjectInstanceFreeze(kind),
 		newCmdObjectInstanceList(kind),
+		newCmdObjectInstanceMove(kind),
+		newCmdObjectInstanceMoveCheck(kind),
 		newCmdObjectInstancePRStart(kind),
 		newCmdObjectInstancePRStop(kind),
 		newCmdObjectInstanceProvision(kind),
@@ -142,6 +155,12 @@ func init() {
 	cmdObjectInstanceDevice.AddCommand(
 		newCmdObjectInstanceDeviceList(kind),
 	)
+	cmdObjectInstanceDRBD.AddCommand(
+		newCmdObjectInstanceDRBDResolve(kind),
+	)
+	cmdObjectInstanceImage.AddCommand(
+		newCmdObjectInstanceImagePull(kind),
+	)
 	cmdObjectInstancePG.AddCommand(
 		newCmdObjectInstancePGUpdate(kind),
 	)
@@ -179,6 +198,10 @@ func init() {
 	cmdObjectPush.AddCommand(
 		newCmdObjectPushResourceInfo(kind),
 	)
+	cmdObjectSnapshot.AddCommand(
+		newCmdObjectSnapshotList(kind),
+		newCmdObjectSnapshotRestore(kind),
+	)
 	cmdObjectSync.AddCommand(
 		newCmdObjectInstanceSyncFull(kind),
 		newCmdObjectInstanceSyncIngest(kind),
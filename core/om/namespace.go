@@ -0,0 +1,23 @@
+package om
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	cmdNamespace = &cobra.Command{
+		Use:     "namespace",
+		Short:   "manage namespaces",
+		Long:    "A namespace groups objects. Its quotas and policies are defined in its nscfg object.",
+		Aliases: []string{"ns"},
+	}
+)
+
+func init() {
+	root.AddCommand(
+		cmdNamespace,
+	)
+	cmdNamespace.AddCommand(
+		newCmdNamespaceUsage(),
+	)
+}
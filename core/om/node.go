@@ -64,6 +64,8 @@ var (
 		Short:   "ssh commands",
 	}
 
+	cmdNodeSysreport = newCmdNodeSysreport()
+
 	// Backward compat
 
 	cmdNodeEdit     = newCmdNodeEdit()
@@ -213,10 +215,13 @@ func init() {
 		newCmdNodeScanscsi(),
 		newCmdNodeSet(),
 		newCmdNodeStonith(),
-		newCmdNodeSysreport(),
+		cmdNodeSysreport,
 		newCmdNodeUnfreeze(),
 		newCmdNodeUnset(),
 	)
+	cmdNodeSysreport.AddCommand(
+		newCmdNodeSysreportDiff(),
+	)
 	cmdNodeConfig.AddCommand(
 		omcmd.NewCmdNodeConfigDoc(),
 		newCmdNodeConfigEdit(),
@@ -0,0 +1,172 @@
+package omcmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"sort"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/clusterbackup"
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/nodeselector"
+	"github.com/opensvc/om3/v3/core/objectselector"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/util/hostname"
+)
+
+type (
+	CmdClusterBackup struct {
+		// File is the path of the backup archive to create.
+		File string
+	}
+)
+
+var (
+	ErrCmdClusterBackup = errors.New("command cluster backup")
+)
+
+func (t *CmdClusterBackup) Run() error {
+	if err := t.run(); err != nil {
+		return fmt.Errorf("%w: %w", ErrCmdClusterBackup, err)
+	}
+	return nil
+}
+
+func (t *CmdClusterBackup) run() error {
+	if t.File == "" {
+		return fmt.Errorf("the backup archive file is not set")
+	}
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	passphrase, err := readBackupPassphrase()
+	if err != nil {
+		return err
+	}
+	archive := clusterbackup.New(hostname.Hostname())
+
+	if b, err := fetchClusterConfig(c); err != nil {
+		return err
+	} else {
+		archive.AddCluster(b)
+	}
+
+	nodenames, err := nodeselector.New("*", nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return err
+	}
+	sort.Strings(nodenames)
+	for _, nodename := range nodenames {
+		b, err := fetchNodeConfig(nodename, c)
+		if err != nil {
+			return err
+		}
+		archive.AddNode(nodename, b)
+	}
+
+	paths, err := objectselector.New("**", objectselector.WithClient(c)).ExpandRelaxed()
+	if err != nil {
+		return err
+	}
+	sort.Slice(paths, func(i, j int) bool { return paths[i].String() < paths[j].String() })
+	for _, p := range paths {
+		b, err := fetchConfig(p, c)
+		if err != nil {
+			return err
+		}
+		archive.AddObject(p, b)
+		if !slices.Contains(naming.KindDataStore, p.Kind) {
+			continue
+		}
+		names, err := fetchKeyNames(p, c)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			b, err := fetchKeyData(p, name, c)
+			if err != nil {
+				return err
+			}
+			archive.AddKey(p, name, b)
+		}
+	}
+
+	var buff bytes.Buffer
+	if err := archive.Write(&buff, passphrase); err != nil {
+		return err
+	}
+	if err := os.WriteFile(t.File, buff.Bytes(), 0600); err != nil {
+		return err
+	}
+	fmt.Printf("%s: archived the cluster config, %d node configs and %d object configs\n", t.File, len(nodenames), len(paths))
+	return nil
+}
+
+// readBackupPassphrase reads the backup archive passphrase from stdin if
+// not a terminal, or prompts for it.
+func readBackupPassphrase() ([]byte, error) {
+	b, err := commoncmd.ReadPasswordFromStdinOrPrompt("Passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	b = bytes.TrimRight(b, "\r\n")
+	if len(b) == 0 {
+		return nil, fmt.Errorf("empty passphrase")
+	}
+	return b, nil
+}
+
+func fetchClusterConfig(c *client.T) ([]byte, error) {
+	resp, err := c.GetClusterConfigFileWithResponse(context.Background())
+	if err != nil {
+		return nil, err
+	} else if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("get cluster file from %s: %s", c.URL(), resp.Status())
+	}
+	return resp.Body, nil
+}
+
+// fetchKeyNames returns the sorted key names of the datastore object p.
+func fetchKeyNames(p naming.Path, c *client.T) ([]string, error) {
+	resp, err := c.GetObjectDataKeysWithResponse(context.Background(), p.Namespace, p.Kind, p.Name)
+	if err != nil {
+		return nil, err
+	} else if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("get object %s keys from %s: %s", p, c.URL(), resp.Status())
+	} else if resp.JSON200 == nil {
+		return nil, fmt.Errorf("get object %s keys from %s: no instance config", p, c.URL())
+	}
+	names := make([]string, 0, len(resp.JSON200.Items))
+	for _, item := range resp.JSON200.Items {
+		if !slices.Contains(names, item.Name) {
+			names = append(names, item.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// fetchKeyData returns the decoded value of the key name of the datastore
+// object p. It returns os.ErrNotExist if the key does not exist.
+func fetchKeyData(p naming.Path, name string, c *client.T) ([]byte, error) {
+	params := api.GetObjectDataKeyParams{Name: name}
+	resp, err := c.GetObjectDataKeyWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, &params)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("get object %s key %s from %s: %w", p, name, c.URL(), os.ErrNotExist)
+	default:
+		return nil, fmt.Errorf("get object %s key %s from %s: %s", p, name, c.URL(), resp.Status())
+	}
+}
@@ -0,0 +1,521 @@
+package omcmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/clusterbackup"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/objectselector"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/util/unstructured"
+)
+
+type (
+	CmdClusterRestore struct {
+		OptsGlobal
+
+		// File is the path of the backup archive to restore.
+		File string
+
+		// Namespace limits the restore to the objects of this namespace.
+		Namespace string
+
+		// ClusterConfig enables the cluster.conf restore.
+		ClusterConfig bool
+
+		// NodeConfig enables the node configs restore.
+		NodeConfig bool
+
+		// Conflict is the policy applied when an existing config differs
+		// from its archived version: skip, overwrite or abort.
+		Conflict string
+
+		// DryRun shows the restore plan and the config diffs, without
+		// applying changes.
+		DryRun bool
+
+		// Timeout is the maximum duration to wait for a created cfg, sec or
+		// usr object to be known by the daemon before restoring its keys.
+		Timeout time.Duration
+	}
+
+	restoreItem struct {
+		entry   clusterbackup.Entry
+		action  string
+		current []byte
+		data    []byte
+	}
+)
+
+const (
+	restoreActionCreate    = "create"
+	restoreActionUpdate    = "update"
+	restoreActionUnchanged = "unchanged"
+	restoreActionSkip      = "skip"
+	restoreActionConflict  = "conflict"
+
+	restoreConflictSkip      = "skip"
+	restoreConflictOverwrite = "overwrite"
+	restoreConflictAbort     = "abort"
+)
+
+var (
+	ErrCmdClusterRestore = errors.New("command cluster restore")
+)
+
+func (t *CmdClusterRestore) Run() error {
+	if err := t.run(); err != nil {
+		return fmt.Errorf("%w: %w", ErrCmdClusterRestore, err)
+	}
+	return nil
+}
+
+func (t *CmdClusterRestore) run() error {
+	switch t.Conflict {
+	case restoreConflictSkip, restoreConflictOverwrite, restoreConflictAbort:
+	default:
+		return fmt.Errorf("invalid conflict policy '%s': must be skip, overwrite or abort", t.Conflict)
+	}
+	if t.File == "" {
+		return fmt.Errorf("the backup archive file is not set")
+	}
+	f, err := os.Open(t.File)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	passphrase, err := readBackupPassphrase()
+	if err != nil {
+		return err
+	}
+	archive, err := clusterbackup.Read(f, passphrase)
+	if err != nil {
+		return err
+	}
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	items, err := t.plan(archive, c)
+	if err != nil {
+		return err
+	}
+	t.render(items)
+	if t.DryRun {
+		return t.printDiffs(items)
+	}
+	var conflicts []string
+	for _, item := range items {
+		if item.action == restoreActionConflict {
+			conflicts = append(conflicts, item.name())
+		}
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("abort on conflicts: %v", conflicts)
+	}
+	return t.apply(items, c)
+}
+
+// plan returns the restore item of each selected archive entry, with the
+// action to apply according to the current cluster configs.
+func (t *CmdClusterRestore) plan(archive *clusterbackup.Archive, c *client.T) ([]restoreItem, error) {
+	selected, err := t.selectPaths(archive)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]restoreItem, 0)
+	objectActions := make(map[string]string)
+	for _, e := range archive.Manifest.Entries {
+		var (
+			current []byte
+			exists  bool
+		)
+		item := restoreItem{entry: e, data: archive.Data(e)}
+		switch e.Kind {
+		case clusterbackup.KindCluster:
+			if !t.ClusterConfig {
+				continue
+			}
+			if current, err = fetchClusterConfig(c); err != nil {
+				return nil, err
+			}
+			exists = true
+		case clusterbackup.KindNode:
+			if !t.NodeConfig {
+				continue
+			}
+			if current, exists, err = fetchNodeConfigIfExists(e.Node, c); err != nil {
+				return nil, err
+			} else if !exists {
+				// a node config can't be created: the node must join the
+				// cluster first.
+				item.action = restoreActionSkip
+				items = append(items, item)
+				continue
+			}
+		case clusterbackup.KindObject:
+			if !slices.Contains(selected, e.Path) {
+				continue
+			}
+			p, err := naming.ParsePath(e.Path)
+			if err != nil {
+				return nil, err
+			}
+			if current, exists, err = fetchConfigIfExists(p, c); err != nil {
+				return nil, err
+			}
+		case clusterbackup.KindKey:
+			objectAction, ok := objectActions[e.Path]
+			if !ok {
+				continue
+			}
+			switch objectAction {
+			case restoreActionCreate:
+				// the object does not exist yet, neither do its keys.
+				item.action = restoreActionCreate
+				items = append(items, item)
+				continue
+			case restoreActionSkip, restoreActionConflict:
+				item.action = objectAction
+				items = append(items, item)
+				continue
+			}
+			p, err := naming.ParsePath(e.Path)
+			if err != nil {
+				return nil, err
+			}
+			current, err = fetchKeyData(p, e.Key, c)
+			switch {
+			case errors.Is(err, os.ErrNotExist):
+				item.action = restoreActionCreate
+			case err != nil:
+				// the archived config of an object created on another
+				// cluster has keys encrypted with another cluster secret.
+				item.action = restoreActionUpdate
+			case bytes.Equal(current, item.data):
+				item.action = restoreActionUnchanged
+			default:
+				// the key belongs to an object whose config is restored,
+				// so is restored whatever the conflict policy.
+				item.action = restoreActionUpdate
+			}
+			item.current = current
+			items = append(items, item)
+			continue
+		}
+		item.current = current
+		switch {
+		case !exists:
+			item.action = restoreActionCreate
+		case bytes.Equal(current, item.data):
+			item.action = restoreActionUnchanged
+		case t.Conflict == restoreConflictOverwrite:
+			item.action = restoreActionUpdate
+		case t.Conflict == restoreConflictAbort:
+			item.action = restoreActionConflict
+		default:
+			item.action = restoreActionSkip
+		}
+		if e.Kind == clusterbackup.KindObject {
+			objectActions[e.Path] = item.action
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// selectPaths returns the archived object paths selected by the namespace
+// and selector options. All archived objects are selected if none is set.
+func (t *CmdClusterRestore) selectPaths(archive *clusterbackup.Archive) ([]string, error) {
+	paths := archive.Paths()
+	if t.Namespace != "" {
+		l := make(naming.Paths, 0)
+		for _, p := range paths {
+			if p.Namespace == t.Namespace {
+				l = append(l, p)
+			}
+		}
+		paths = l
+	}
+	if t.ObjectSelector != "" {
+		var err error
+		paths, err = objectselector.New(
+			t.ObjectSelector,
+			objectselector.WithLocal(true),
+			objectselector.WithPaths(paths),
+			objectselector.WithConfigFilterDisabled(),
+		).ExpandRelaxed()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return paths.StrSlice(), nil
+}
+
+func (t *CmdClusterRestore) render(items []restoreItem) {
+	lines := make(unstructured.List, len(items))
+	for i, item := range items {
+		lines[i] = map[string]any{
+			"kind":   item.entry.Kind,
+			"name":   item.name(),
+			"action": item.action,
+		}
+	}
+	output.Renderer{
+		DefaultOutput: "tab=KIND:kind,NAME:name,ACTION:action",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          lines,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+}
+
+// printDiffs prints the unified diff between the current and the archived
+// version of the configs to update or in conflict. The key values are not
+// displayed.
+func (t *CmdClusterRestore) printDiffs(items []restoreItem) error {
+	for _, item := range items {
+		if item.entry.Kind == clusterbackup.KindKey {
+			continue
+		}
+		switch item.action {
+		case restoreActionUpdate, restoreActionConflict, restoreActionSkip:
+		default:
+			continue
+		}
+		if item.current == nil {
+			continue
+		}
+		s, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(item.current)),
+			B:        difflib.SplitLines(string(item.data)),
+			FromFile: item.name() + " (current)",
+			ToFile:   item.name() + " (archive)",
+			Context:  3,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := os.Stdout.Write([]byte(s)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply restores the items through the same api handlers as the create,
+// config update and key change commands.
+func (t *CmdClusterRestore) apply(items []restoreItem, c *client.T) error {
+	ctx := context.Background()
+	ready := make(map[string]bool)
+	for _, item := range items {
+		e := item.entry
+		var err error
+		switch item.action {
+		case restoreActionCreate, restoreActionUpdate:
+		default:
+			continue
+		}
+		switch e.Kind {
+		case clusterbackup.KindCluster:
+			err = putClusterConfigData(ctx, c, item.data)
+		case clusterbackup.KindNode:
+			err = putNodeConfigData(ctx, c, e.Node, item.data)
+		case clusterbackup.KindObject:
+			var p naming.Path
+			if p, err = naming.ParsePath(e.Path); err != nil {
+				break
+			}
+			if item.action == restoreActionCreate {
+				err = postConfigData(ctx, c, p, item.data)
+			} else {
+				err = putConfigData(ctx, c, p, item.data)
+			}
+		case clusterbackup.KindKey:
+			var p naming.Path
+			if p, err = naming.ParsePath(e.Path); err != nil {
+				break
+			}
+			if !ready[e.Path] {
+				if err = t.waitDataStore(ctx, c, p); err != nil {
+					break
+				}
+				ready[e.Path] = true
+			}
+			err = putKeyData(ctx, c, p, e.Key, item.data)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", item.name(), err)
+		}
+		fmt.Printf("%s: %sd\n", item.name(), item.action)
+	}
+	return nil
+}
+
+// waitDataStore waits for the daemon to know the datastore object p, so
+// its keys can be changed.
+func (t *CmdClusterRestore) waitDataStore(ctx context.Context, c *client.T, p naming.Path) error {
+	ctx, cancel := context.WithTimeout(ctx, t.Timeout)
+	defer cancel()
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		resp, err := c.GetObjectDataKeysWithResponse(ctx, p.Namespace, p.Kind, p.Name)
+		if err == nil && resp.StatusCode() == http.StatusOK && resp.JSON200 != nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for the daemon to know %s: %w", p, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t restoreItem) name() string {
+	switch t.entry.Kind {
+	case clusterbackup.KindCluster:
+		return "cluster"
+	case clusterbackup.KindNode:
+		return "node " + t.entry.Node
+	case clusterbackup.KindKey:
+		return t.entry.Path + " key " + t.entry.Key
+	default:
+		return t.entry.Path
+	}
+}
+
+func fetchNodeConfigIfExists(nodename string, c *client.T) ([]byte, bool, error) {
+	resp, err := c.GetNodeConfigFileWithResponse(context.Background(), nodename)
+	if err != nil {
+		return nil, false, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.Body, true, nil
+	case http.StatusNotFound:
+		return nil, false, nil
+	default:
+		return nil, false, fmt.Errorf("get node %s file from %s: %s", nodename, c.URL(), resp.Status())
+	}
+}
+
+func fetchConfigIfExists(p naming.Path, c *client.T) ([]byte, bool, error) {
+	resp, err := c.GetObjectConfigFileWithResponse(context.Background(), p.Namespace, p.Kind, p.Name)
+	if err != nil {
+		return nil, false, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.Body, true, nil
+	case http.StatusNotFound:
+		return nil, false, nil
+	default:
+		return nil, false, fmt.Errorf("get object %s file from %s: %s", p, c.URL(), resp.Status())
+	}
+}
+
+func putClusterConfigData(ctx context.Context, c *client.T, b []byte) error {
+	resp, err := c.PutClusterConfigFileWithBodyWithResponse(ctx, "application/octet-stream", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusBadRequest:
+		return fmt.Errorf("%s", *resp.JSON400)
+	default:
+		return fmt.Errorf("put cluster file from %s: %s", c.URL(), resp.Status())
+	}
+}
+
+func putNodeConfigData(ctx context.Context, c *client.T, nodename string, b []byte) error {
+	resp, err := c.PutNodeConfigFileWithBodyWithResponse(ctx, nodename, "application/octet-stream", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusBadRequest:
+		return fmt.Errorf("%s", *resp.JSON400)
+	default:
+		return fmt.Errorf("put node %s file from %s: %s", nodename, c.URL(), resp.Status())
+	}
+}
+
+func postConfigData(ctx context.Context, c *client.T, p naming.Path, b []byte) error {
+	resp, err := c.PostObjectConfigFileWithBodyWithResponse(ctx, p.Namespace, p.Kind, p.Name, "application/octet-stream", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusBadRequest:
+		return fmt.Errorf("%s", *resp.JSON400)
+	default:
+		return fmt.Errorf("post object %s file from %s: %s", p, c.URL(), resp.Status())
+	}
+}
+
+func putConfigData(ctx context.Context, c *client.T, p naming.Path, b []byte) error {
+	resp, err := c.PutObjectConfigFileWithBodyWithResponse(ctx, p.Namespace, p.Kind, p.Name, "application/octet-stream", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusBadRequest:
+		return fmt.Errorf("%s", *resp.JSON400)
+	default:
+		return fmt.Errorf("put object %s file from %s: %s", p, c.URL(), resp.Status())
+	}
+}
+
+// putKeyData sets the value of the key name of the datastore object p,
+// encrypted by the daemon with the target cluster secret. The key is
+// added if it does not exist.
+func putKeyData(ctx context.Context, c *client.T, p naming.Path, name string, b []byte) error {
+	putParams := api.PutObjectDataKeyParams{Name: name}
+	resp, err := c.PutObjectDataKeyWithBodyWithResponse(ctx, p.Namespace, p.Kind, p.Name, &putParams, "application/octet-stream", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+	case http.StatusBadRequest:
+		return fmt.Errorf("%s", *resp.JSON400)
+	default:
+		return fmt.Errorf("put object %s key %s from %s: %s", p, name, c.URL(), resp.Status())
+	}
+	postParams := api.PostObjectDataKeyParams{Name: name}
+	postResp, err := c.PostObjectDataKeyWithBodyWithResponse(ctx, p.Namespace, p.Kind, p.Name, &postParams, "application/octet-stream", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	switch postResp.StatusCode() {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusBadRequest:
+		return fmt.Errorf("%s", *postResp.JSON400)
+	default:
+		return fmt.Errorf("post object %s key %s from %s: %s", p, name, c.URL(), postResp.Status())
+	}
+}
@@ -0,0 +1,143 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/nspolicy"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+type (
+	CmdNamespaceUsage struct {
+		OptsGlobal
+
+		// Namespace limits the report to a namespace.
+		Namespace string
+	}
+
+	// namespaceUsageLine is a usage or policy line of a namespace.
+	namespaceUsageLine struct {
+		Namespace string `json:"namespace"`
+		Resource  string `json:"resource"`
+		Usage     string `json:"usage"`
+		Quota     string `json:"quota"`
+	}
+)
+
+func (t *CmdNamespaceUsage) Run() error {
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	params := api.GetNamespaceUsageParams{}
+	if t.Namespace != "" {
+		params.Namespace = &t.Namespace
+	}
+	resp, err := c.GetNamespaceUsageWithResponse(context.Background(), &params)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return fmt.Errorf("%s", *resp.JSON401)
+	case http.StatusForbidden:
+		return fmt.Errorf("%s", *resp.JSON403)
+	case http.StatusInternalServerError:
+		return fmt.Errorf("%s", *resp.JSON500)
+	default:
+		return fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+	lines := make([]namespaceUsageLine, 0)
+	for _, report := range resp.JSON200.Items {
+		lines = append(lines, namespaceUsageLines(report)...)
+	}
+	output.Renderer{
+		DefaultOutput: "tab=NAMESPACE:namespace,RESOURCE:resource,USAGE:usage,QUOTA:quota",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          lines,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
+
+// namespaceUsageLines flattens a namespace report to one line per quota
+// or policy. The object kinds and pools without quota are reported if used.
+func namespaceUsageLines(report nspolicy.Report) []namespaceUsageLine {
+	l := make([]namespaceUsageLine, 0)
+	add := func(resource, usage, quota string) {
+		l = append(l, namespaceUsageLine{Namespace: report.Namespace, Resource: resource, Usage: usage, Quota: quota})
+	}
+
+	kinds := make(map[naming.Kind]any)
+	for kind := range report.Usage.Objects {
+		kinds[kind] = nil
+	}
+	for kind := range report.Policy.MaxObjects {
+		kinds[kind] = nil
+	}
+	kindNames := make([]string, 0, len(kinds))
+	for kind := range kinds {
+		kindNames = append(kindNames, kind.String())
+	}
+	sort.Strings(kindNames)
+	for _, s := range kindNames {
+		kind := naming.ParseKind(s)
+		quota := "-"
+		if n, ok := report.Policy.MaxObjects[kind]; ok {
+			quota = strconv.Itoa(n)
+		}
+		add("objects."+s, strconv.Itoa(report.Usage.Objects[kind]), quota)
+	}
+
+	quota := "-"
+	if report.Policy.MaxFlexInstances > 0 {
+		quota = strconv.Itoa(report.Policy.MaxFlexInstances)
+	}
+	add("flex_instances", strconv.Itoa(report.Usage.FlexInstances), quota)
+
+	pools := make(map[string]any)
+	for pool := range report.Usage.PoolSize {
+		pools[pool] = nil
+	}
+	for pool := range report.Policy.MaxPoolSize {
+		pools[pool] = nil
+	}
+	poolNames := make([]string, 0, len(pools))
+	for pool := range pools {
+		poolNames = append(poolNames, pool)
+	}
+	sort.Strings(poolNames)
+	for _, pool := range poolNames {
+		quota := "-"
+		if size, ok := report.Policy.MaxPoolSize[pool]; ok {
+			quota = sizeconv.BSizeCompact(float64(size))
+		}
+		add("pool_size."+pool, sizeconv.BSizeCompact(float64(report.Usage.PoolSize[pool])), quota)
+	}
+
+	for _, e := range []struct {
+		resource string
+		patterns []string
+	}{
+		{"allowed_drivers", report.Policy.AllowedDrivers},
+		{"denied_drivers", report.Policy.DeniedDrivers},
+		{"allowed_nodes", report.Policy.AllowedNodes},
+	} {
+		if len(e.patterns) > 0 {
+			add(e.resource, "-", strings.Join(e.patterns, " "))
+		}
+	}
+	return l
+}
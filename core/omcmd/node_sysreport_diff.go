@@ -0,0 +1,151 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/util/converters"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/sysreport"
+)
+
+type (
+	CmdNodeSysreportDiff struct {
+		OptsGlobal
+
+		// Nodes are the one or two nodes whose snapshots are compared.
+		Nodes []string
+
+		// Since is the date, or the duration ago, of the snapshot to
+		// compare with the latest snapshot of a single node.
+		Since string
+	}
+)
+
+func (t *CmdNodeSysreportDiff) Run() error {
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	nodes := t.Nodes
+	if len(nodes) == 0 {
+		nodes = []string{hostname.Hostname()}
+	}
+	var a, b sysreport.Snapshot
+	switch {
+	case len(nodes) > 2:
+		return fmt.Errorf("compare at most two nodes")
+	case len(nodes) == 2 && t.Since != "":
+		return fmt.Errorf("--since compares the snapshots of a single node")
+	case len(nodes) == 2:
+		if a, err = latestSysreportSnapshot(c, nodes[0]); err != nil {
+			return err
+		}
+		if b, err = latestSysreportSnapshot(c, nodes[1]); err != nil {
+			return err
+		}
+	default:
+		snapshots, err := fetchSysreportSnapshots(c, nodes[0])
+		if err != nil {
+			return err
+		}
+		if len(snapshots) < 2 {
+			return fmt.Errorf("node %s: need at least two sysreport snapshots, found %d", nodes[0], len(snapshots))
+		}
+		b = snapshots[len(snapshots)-1]
+		a = snapshots[len(snapshots)-2]
+		if t.Since != "" {
+			since, err := parseSysreportSince(t.Since)
+			if err != nil {
+				return err
+			}
+			// the most recent snapshot created before since, or the
+			// oldest snapshot.
+			a = snapshots[0]
+			for _, snapshot := range snapshots[:len(snapshots)-1] {
+				if snapshot.CreatedAt.After(since) {
+					break
+				}
+				a = snapshot
+			}
+		}
+	}
+	fmt.Fprintf(os.Stderr, "a: %s snapshot at %s\n", a.Node, a.CreatedAt.Format(time.RFC3339))
+	fmt.Fprintf(os.Stderr, "b: %s snapshot at %s\n", b.Node, b.CreatedAt.Format(time.RFC3339))
+
+	diffs := sysreport.Compare(a, b)
+	for i, diff := range diffs {
+		if diff.Kind == sysreport.DiffKindPackage {
+			continue
+		}
+		diffs[i].A = shortChecksum(diff.A)
+		diffs[i].B = shortChecksum(diff.B)
+	}
+	output.Renderer{
+		DefaultOutput: "tab=KIND:kind,NAME:name,A:a,B:b",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          diffs,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
+
+func fetchSysreportSnapshots(c *client.T, nodename string) ([]sysreport.Snapshot, error) {
+	resp, err := c.GetNodeSysreportSnapshotsWithResponse(context.Background(), nodename)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.JSON200.Items, nil
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("node %s: %s", nodename, *resp.JSON401)
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("node %s: %s", nodename, *resp.JSON403)
+	case http.StatusInternalServerError:
+		return nil, fmt.Errorf("node %s: %s", nodename, *resp.JSON500)
+	default:
+		return nil, fmt.Errorf("node %s: unexpected response: %s", nodename, resp.Status())
+	}
+}
+
+func latestSysreportSnapshot(c *client.T, nodename string) (sysreport.Snapshot, error) {
+	snapshots, err := fetchSysreportSnapshots(c, nodename)
+	if err != nil {
+		return sysreport.Snapshot{}, err
+	}
+	if len(snapshots) == 0 {
+		return sysreport.Snapshot{}, fmt.Errorf("node %s: no sysreport snapshot", nodename)
+	}
+	return snapshots[len(snapshots)-1], nil
+}
+
+// parseSysreportSince parses s as a RFC3339 date, a YYYY-MM-DD date, or
+// a duration before now.
+func parseSysreportSince(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, s, time.Local); err == nil {
+		return t, nil
+	}
+	d, err := converters.ParseDuration(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s is neither a date nor a duration", s)
+	}
+	return time.Now().Add(-d), nil
+}
+
+func shortChecksum(s string) string {
+	if len(s) > 12 {
+		return s[:12]
+	}
+	return s
+}
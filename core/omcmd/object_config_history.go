@@ -0,0 +1,209 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/objectselector"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+type (
+	CmdObjectConfigHistory struct {
+		OptsGlobal
+	}
+
+	CmdObjectConfigDiff struct {
+		ObjectSelector string
+		Revs           []int
+	}
+
+	CmdObjectConfigRollback struct {
+		ObjectSelector string
+		Rev            int
+	}
+)
+
+// selectOnePath expands the selector and verifies it matches a single
+// object.
+func selectOnePath(selector string, c *client.T) (naming.Path, error) {
+	paths, err := objectselector.New(
+		selector,
+		objectselector.WithClient(c),
+	).MustExpand()
+	if err != nil {
+		return naming.Path{}, err
+	}
+	switch len(paths) {
+	case 0:
+		return naming.Path{}, fmt.Errorf("no match")
+	case 1:
+		return paths[0], nil
+	default:
+		return naming.Path{}, fmt.Errorf("more than one match: %s", paths)
+	}
+}
+
+func getConfigHistory(c *client.T, p naming.Path) (api.ConfigRevisionItems, error) {
+	resp, err := c.GetObjectConfigHistoryWithResponse(context.Background(), p.Namespace, p.Kind, p.Name)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.JSON200.Items, nil
+	case 401:
+		return nil, fmt.Errorf("%s: %s", p, resp.JSON401)
+	case 403:
+		return nil, fmt.Errorf("%s: %s", p, resp.JSON403)
+	case 404:
+		return nil, fmt.Errorf("%s: %s", p, resp.JSON404)
+	case 500:
+		return nil, fmt.Errorf("%s: %s", p, resp.JSON500)
+	default:
+		return nil, fmt.Errorf("%s: unexpected response: %s", p, resp.Status())
+	}
+}
+
+func getConfigHistoryFile(c *client.T, p naming.Path, rev int) ([]byte, error) {
+	resp, err := c.GetObjectConfigHistoryFileWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, rev)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.Body, nil
+	case 404:
+		return nil, fmt.Errorf("%s: revision %d: %s", p, rev, resp.JSON404)
+	default:
+		return nil, fmt.Errorf("%s: get config revision %d: %s", p, rev, resp.Status())
+	}
+}
+
+func (t *CmdObjectConfigHistory) Run(kind string) error {
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	p, err := selectOnePath(mergedSelector, c)
+	if err != nil {
+		return err
+	}
+	items, err := getConfigHistory(c, p)
+	if err != nil {
+		return err
+	}
+	output.Renderer{
+		DefaultOutput: "tab=REV:id,CREATED_AT:created_at,NODE:node,AUTHOR:author,OPS:ops",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          api.ConfigRevisionList{Kind: api.ConfigRevisionListKindConfigRevisionList, Items: items},
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
+
+// Run prints the unified diff between two configuration revisions. With a
+// single revision, the diff is against the current configuration. Without
+// revision, the diff is between the two latest revisions.
+func (t *CmdObjectConfigDiff) Run(kind string) error {
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	p, err := selectOnePath(mergedSelector, c)
+	if err != nil {
+		return err
+	}
+	revs := t.Revs
+	switch len(revs) {
+	case 0:
+		items, err := getConfigHistory(c, p)
+		if err != nil {
+			return err
+		}
+		if len(items) < 2 {
+			return fmt.Errorf("%s: not enough config revisions to diff", p)
+		}
+		revs = []int{items[len(items)-2].Id, items[len(items)-1].Id}
+	case 1, 2:
+	default:
+		return fmt.Errorf("at most two --rev can be specified")
+	}
+
+	fromFile := "rev " + strconv.Itoa(revs[0])
+	from, err := getConfigHistoryFile(c, p, revs[0])
+	if err != nil {
+		return err
+	}
+	var (
+		to     []byte
+		toFile string
+	)
+	if len(revs) == 2 {
+		toFile = "rev " + strconv.Itoa(revs[1])
+		to, err = getConfigHistoryFile(c, p, revs[1])
+	} else {
+		toFile = "current"
+		to, err = (&CmdObjectConfigShow{}).extractPath(p, c)
+	}
+	if err != nil {
+		return err
+	}
+	s, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(from)),
+		B:        difflib.SplitLines(string(to)),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write([]byte(s))
+	return err
+}
+
+func (t *CmdObjectConfigRollback) Run(kind string) error {
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	p, err := selectOnePath(mergedSelector, c)
+	if err != nil {
+		return err
+	}
+	resp, err := c.PostObjectConfigHistoryRollbackWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, t.Rev)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusNoContent:
+		return nil
+	case 400:
+		return fmt.Errorf("%s: %s", p, resp.JSON400)
+	case 401:
+		return fmt.Errorf("%s: %s", p, resp.JSON401)
+	case 403:
+		return fmt.Errorf("%s: %s", p, resp.JSON403)
+	case 404:
+		return fmt.Errorf("%s: %s", p, resp.JSON404)
+	case 500:
+		return fmt.Errorf("%s: %s", p, resp.JSON500)
+	default:
+		return fmt.Errorf("%s: unexpected response: %s", p, resp.Status())
+	}
+}
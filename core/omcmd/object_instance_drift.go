@@ -0,0 +1,67 @@
+package omcmd
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/objectselector"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/core/resource"
+)
+
+type (
+	CmdObjectInstanceDrift struct {
+		OptsGlobal
+	}
+
+	drifter interface {
+		Drift(context.Context) (resource.Drifts, error)
+	}
+
+	objectDrift struct {
+		Path naming.Path `json:"path"`
+		resource.Drift
+	}
+)
+
+func (t *CmdObjectInstanceDrift) Run(kind string) error {
+	ctx := context.Background()
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	sel := objectselector.New(
+		mergedSelector,
+		objectselector.WithLocal(true),
+	)
+	paths, err := sel.MustExpand()
+	if err != nil {
+		return err
+	}
+	data := make([]objectDrift, 0)
+	for _, p := range paths {
+		obj, err := object.New(p)
+		if err != nil {
+			continue
+		}
+		i, ok := obj.(drifter)
+		if !ok {
+			continue
+		}
+		drifts, err := i.Drift(ctx)
+		if err != nil {
+			return err
+		}
+		for _, drift := range drifts {
+			data = append(data, objectDrift{Path: p, Drift: drift})
+		}
+	}
+	output.Renderer{
+		DefaultOutput: "tab=OBJECT:path,RESOURCE:rid,ATTR:attr,CURRENT:current,TARGET:target",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          data,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
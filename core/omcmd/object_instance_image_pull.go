@@ -0,0 +1,49 @@
+package omcmd
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/objectaction"
+	"github.com/opensvc/om3/v3/core/xerrors"
+)
+
+type (
+	CmdObjectInstanceImagePull struct {
+		OptsGlobal
+		commoncmd.OptsEncap
+		commoncmd.OptsResourceSelector
+	}
+)
+
+func (t *CmdObjectInstanceImagePull) Run(kind string) error {
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	return objectaction.New(
+		objectaction.WithObjectSelector(mergedSelector),
+		objectaction.WithRID(t.RID),
+		objectaction.WithTag(t.Tag),
+		objectaction.WithSubset(t.Subset),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithColor(t.Color),
+		objectaction.WithIgnoreNotFound(t.IgnoreNotFound),
+		objectaction.WithSlaves(t.Slaves),
+		objectaction.WithAllSlaves(t.AllSlaves),
+		objectaction.WithMaster(t.Master),
+		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (interface{}, error) {
+			type imagePuller interface {
+				ImagePull(context.Context) error
+			}
+			o, err := object.New(p)
+			if err != nil {
+				return nil, err
+			}
+			i, ok := o.(imagePuller)
+			if !ok {
+				return nil, xerrors.InstanceActionNotSupported
+			}
+			return nil, i.ImagePull(ctx)
+		}),
+	).Do()
+}
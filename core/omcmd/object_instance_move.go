@@ -0,0 +1,50 @@
+package omcmd
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/objectaction"
+)
+
+type (
+	CmdObjectInstanceMove struct {
+		OptsGlobal
+		commoncmd.OptsLock
+		commoncmd.OptsResourceSelector
+		DisableRollback bool
+		Force           bool
+		Local           bool
+
+		// To is the node to live migrate the movable resources to.
+		To string
+	}
+)
+
+func (t *CmdObjectInstanceMove) Run(kind string) error {
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	return objectaction.New(
+		objectaction.WithObjectSelector(mergedSelector),
+		objectaction.WithRID(t.RID),
+		objectaction.WithTag(t.Tag),
+		objectaction.WithSubset(t.Subset),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithColor(t.Color),
+		objectaction.WithIgnoreNotFound(t.IgnoreNotFound),
+		objectaction.WithLocal(t.Local),
+		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (interface{}, error) {
+			o, err := object.NewActor(p)
+			if err != nil {
+				return nil, err
+			}
+			ctx = actioncontext.WithLockDisabled(ctx, t.Disable)
+			ctx = actioncontext.WithLockTimeout(ctx, t.Timeout)
+			ctx = actioncontext.WithRollbackDisabled(ctx, t.DisableRollback)
+			ctx = actioncontext.WithForce(ctx, t.Force)
+			return nil, o.Move(ctx, t.To)
+		}),
+	).Do()
+}
@@ -0,0 +1,42 @@
+package omcmd
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/objectaction"
+)
+
+type (
+	CmdObjectInstanceMoveCheck struct {
+		OptsGlobal
+		commoncmd.OptsResourceSelector
+		Local bool
+
+		// To is the node the movable resources would be live migrated to.
+		To string
+	}
+)
+
+func (t *CmdObjectInstanceMoveCheck) Run(kind string) error {
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	return objectaction.New(
+		objectaction.WithObjectSelector(mergedSelector),
+		objectaction.WithRID(t.RID),
+		objectaction.WithTag(t.Tag),
+		objectaction.WithSubset(t.Subset),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithColor(t.Color),
+		objectaction.WithIgnoreNotFound(t.IgnoreNotFound),
+		objectaction.WithLocal(t.Local),
+		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (interface{}, error) {
+			o, err := object.NewActor(p)
+			if err != nil {
+				return nil, err
+			}
+			return nil, o.MoveCheck(ctx, t.To)
+		}),
+	).Do()
+}
@@ -0,0 +1,171 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/actionplan"
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/nodeselector"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/objectselector"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+type (
+	// instancePlan holds the options of the instance actions supporting
+	// the --plan flag.
+	instancePlan struct {
+		OptsGlobal
+		commoncmd.OptsResourceSelector
+		Action       string
+		To           string
+		Force        bool
+		NodeSelector string
+	}
+)
+
+// Run prints the resource operations graph the action would execute on the
+// selected instances, in the human tree, json or graphviz dot format. The
+// local instances are planned inline, and the instances of the --node
+// selection are planned by their node through the api.
+func (t instancePlan) Run(kind string) error {
+	var (
+		plans []*actionplan.T
+		err   error
+	)
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	if t.NodeSelector == "" {
+		plans, err = t.local(mergedSelector)
+	} else {
+		plans, err = t.remote(mergedSelector)
+	}
+	if err != nil {
+		return err
+	}
+	if t.Output == "dot" {
+		for _, plan := range plans {
+			fmt.Print(plan.DOT())
+		}
+		return nil
+	}
+	output.Renderer{
+		DefaultOutput: "human",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          plans,
+		HumanRenderer: func() string {
+			l := make([]string, len(plans))
+			for i, plan := range plans {
+				l[i] = plan.Render()
+			}
+			return strings.Join(l, "\n")
+		},
+		Colorize: rawconfig.Colorize,
+	}.Print()
+	return nil
+}
+
+func (t instancePlan) local(selector string) ([]*actionplan.T, error) {
+	paths, err := objectselector.New(selector, objectselector.WithLocal(true)).MustExpand()
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	ctx = actioncontext.WithRID(ctx, t.RID)
+	ctx = actioncontext.WithSubset(ctx, t.Subset)
+	ctx = actioncontext.WithTag(ctx, t.Tag)
+	ctx = actioncontext.WithTo(ctx, t.To)
+	ctx = actioncontext.WithForce(ctx, t.Force)
+	plans := make([]*actionplan.T, 0, len(paths))
+	for _, p := range paths {
+		o, err := object.NewActor(p)
+		if err != nil {
+			return nil, err
+		}
+		plan, err := o.Plan(ctx, t.Action)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+func (t instancePlan) remote(selector string) ([]*actionplan.T, error) {
+	c, err := client.New()
+	if err != nil {
+		return nil, err
+	}
+	nodenames, err := nodeselector.New(t.NodeSelector, nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return nil, err
+	}
+	paths, err := objectselector.New(selector, objectselector.WithClient(c)).MustExpand()
+	if err != nil {
+		return nil, err
+	}
+	params := api.GetInstancePlanParams{
+		Action: api.GetInstancePlanParamsAction(t.Action),
+	}
+	if t.Force {
+		params.Force = &t.Force
+	}
+	if t.RID != "" {
+		params.Rid = &t.RID
+	}
+	if t.Subset != "" {
+		params.Subset = &t.Subset
+	}
+	if t.Tag != "" {
+		params.Tag = &t.Tag
+	}
+	if t.To != "" {
+		params.To = &t.To
+	}
+	ctx := context.Background()
+	plans := make([]*actionplan.T, 0)
+	for _, p := range paths {
+		for _, nodename := range nodenames {
+			plan, err := t.remotePlan(ctx, c, p, nodename, &params)
+			if err != nil {
+				return nil, err
+			}
+			if plan != nil {
+				plans = append(plans, plan)
+			}
+		}
+	}
+	return plans, nil
+}
+
+// remotePlan returns the plan of the instance of p on nodename, or nil if
+// the object has no instance on this node.
+func (t instancePlan) remotePlan(ctx context.Context, c *client.T, p naming.Path, nodename string, params *api.GetInstancePlanParams) (*actionplan.T, error) {
+	response, err := c.GetInstancePlanWithResponse(ctx, nodename, p.Namespace, p.Kind, p.Name, params)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case response.JSON200 != nil:
+		return response.JSON200, nil
+	case response.JSON404 != nil:
+		return nil, nil
+	case response.JSON400 != nil:
+		return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON400)
+	case response.JSON401 != nil:
+		return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON401)
+	case response.JSON403 != nil:
+		return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON403)
+	case response.JSON500 != nil:
+		return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON500)
+	default:
+		return nil, fmt.Errorf("%s: node %s: unexpected response: %s", p, nodename, response.Status())
+	}
+}
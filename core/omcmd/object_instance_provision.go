@@ -27,6 +27,7 @@ type (
 		Force           bool
 		Leader          bool
 		NodeSelector    string
+		Plan            bool
 
 		// StateOnly indicates that the command will only change the instance-selected
 		// resources provisioned state value to `provisioned` without any provisioning
@@ -36,6 +37,16 @@ type (
 )
 
 func (t *CmdObjectInstanceProvision) Run(kind string) error {
+	if t.Plan {
+		return instancePlan{
+			OptsGlobal:           t.OptsGlobal,
+			OptsResourceSelector: t.OptsResourceSelector,
+			Action:               "provision",
+			To:                   t.To,
+			Force:                t.Force,
+			NodeSelector:         t.NodeSelector,
+		}.Run(kind)
+	}
 	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
 	return objectaction.New(
 		objectaction.WithObjectSelector(mergedSelector),
@@ -25,10 +25,21 @@ type (
 		Force           bool
 		DisableRollback bool
 		NodeSelector    string
+		Plan            bool
 	}
 )
 
 func (t *CmdObjectInstanceStart) Run(kind string) error {
+	if t.Plan {
+		return instancePlan{
+			OptsGlobal:           t.OptsGlobal,
+			OptsResourceSelector: t.OptsResourceSelector,
+			Action:               "start",
+			To:                   t.To,
+			Force:                t.Force,
+			NodeSelector:         t.NodeSelector,
+		}.Run(kind)
+	}
 	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
 	return objectaction.New(
 		objectaction.WithObjectSelector(mergedSelector),
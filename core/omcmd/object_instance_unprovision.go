@@ -26,6 +26,7 @@ type (
 		Force        bool
 		Leader       bool
 		NodeSelector string
+		Plan         bool
 
 		// StateOnly indicates that the command will only change the instance-selected
 		// resources provisioned state value to `unprovisioned` without any unprovisioning
@@ -35,6 +36,16 @@ type (
 )
 
 func (t *CmdObjectInstanceUnprovision) Run(kind string) error {
+	if t.Plan {
+		return instancePlan{
+			OptsGlobal:           t.OptsGlobal,
+			OptsResourceSelector: t.OptsResourceSelector,
+			Action:               "unprovision",
+			To:                   t.To,
+			Force:                t.Force,
+			NodeSelector:         t.NodeSelector,
+		}.Run(kind)
+	}
 	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
 	return objectaction.New(
 		objectaction.WithObjectSelector(mergedSelector),
@@ -0,0 +1,34 @@
+package omcmd
+
+import (
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/objectaction"
+)
+
+type (
+	CmdObjectMigrate struct {
+		OptsGlobal
+		commoncmd.OptsAsync
+		To string
+	}
+)
+
+func (t *CmdObjectMigrate) Run(kind string) error {
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	target := instance.MonitorGlobalExpectMoved.String()
+	options := instance.MonitorGlobalExpectOptionsMoved{
+		Destination: t.To,
+	}
+	return objectaction.New(
+		objectaction.WithObjectSelector(mergedSelector),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithColor(t.Color),
+		objectaction.WithIgnoreNotFound(t.IgnoreNotFound),
+		objectaction.WithAsyncTarget(target),
+		objectaction.WithAsyncTargetOptions(options),
+		objectaction.WithAsyncTime(t.Time),
+		objectaction.WithAsyncWait(t.Wait),
+		objectaction.WithAsyncWatch(t.Watch),
+	).Do()
+}
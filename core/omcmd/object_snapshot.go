@@ -0,0 +1,94 @@
+package omcmd
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/objectaction"
+	"github.com/opensvc/om3/v3/core/objectselector"
+	"github.com/opensvc/om3/v3/core/output"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/core/resource"
+)
+
+type (
+	CmdObjectSnapshotList struct {
+		OptsGlobal
+	}
+
+	CmdObjectSnapshotRestore struct {
+		OptsGlobal
+		commoncmd.OptsLock
+		ID string
+	}
+
+	snapshotLister interface {
+		SnapshotList(context.Context) (resource.Snapshots, error)
+	}
+
+	objectSnapshot struct {
+		Path naming.Path `json:"path"`
+		resource.Snapshot
+	}
+)
+
+func (t *CmdObjectSnapshotList) Run(kind string) error {
+	ctx := context.Background()
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	sel := objectselector.New(
+		mergedSelector,
+		objectselector.WithLocal(true),
+	)
+	paths, err := sel.MustExpand()
+	if err != nil {
+		return err
+	}
+	data := make([]objectSnapshot, 0)
+	for _, p := range paths {
+		obj, err := object.New(p)
+		if err != nil {
+			continue
+		}
+		i, ok := obj.(snapshotLister)
+		if !ok {
+			continue
+		}
+		snaps, err := i.SnapshotList(ctx)
+		if err != nil {
+			return err
+		}
+		for _, snap := range snaps {
+			data = append(data, objectSnapshot{Path: p, Snapshot: snap})
+		}
+	}
+	output.Renderer{
+		DefaultOutput: "tab=OBJECT:path,RESOURCE:rid,ID:id,ORIGIN:origin,TAG:tag,CREATED_AT:created_at,SIZE:size,THIN:thin",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          data,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
+
+func (t *CmdObjectSnapshotRestore) Run(kind string) error {
+	mergedSelector := commoncmd.MergeSelector("", t.ObjectSelector, kind, "")
+	return objectaction.New(
+		objectaction.WithObjectSelector(mergedSelector),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithColor(t.Color),
+		objectaction.WithLocal(true),
+		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (interface{}, error) {
+			o, err := object.NewActor(p)
+			if err != nil {
+				return nil, err
+			}
+			ctx = actioncontext.WithLockDisabled(ctx, t.Disable)
+			ctx = actioncontext.WithLockTimeout(ctx, t.Timeout)
+			return nil, o.SnapshotRestore(ctx, t.ID)
+		}),
+	).Do()
+}
@@ -0,0 +1,23 @@
+package ox
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/opensvc/om3/v3/core/commoncmd"
+)
+
+var (
+	cmdAuth = &cobra.Command{
+		Use:   "auth",
+		Short: "query the authorizations of the current user",
+	}
+)
+
+func init() {
+	root.AddCommand(
+		cmdAuth,
+	)
+	cmdAuth.AddCommand(
+		commoncmd.NewCmdAuthCanI(),
+	)
+}
@@ -6,6 +6,7 @@ import (
 
 func init() {
 	cmdDaemon := commoncmd.NewCmdDaemon()
+	cmdDaemonAudit := commoncmd.NewCmdDaemonAudit()
 	cmdDaemonDNS := commoncmd.NewCmdDaemonDNS()
 	cmdDaemonHeartbeat := commoncmd.NewCmdDaemonHeartbeat()
 	cmdDaemonListener := commoncmd.NewCmdDaemonListener()
@@ -27,13 +28,17 @@ func init() {
 		newCmdDaemonShutdown(),
 		newCmdDaemonStop(),
 		commoncmd.NewCmdDaemonAuth(),
-		commoncmd.NewCmdDaemonAudit(),
+		cmdDaemonAudit,
 		commoncmd.NewCmdDaemonLog(),
 		commoncmd.NewCmdDaemonStatus(),
 		commoncmd.NewCmdDaemonPs(),
 		commoncmd.NewCmdDaemonKill(),
 	)
 
+	cmdDaemonAudit.AddCommand(
+		commoncmd.NewCmdDaemonAuditLog(),
+	)
+
 	cmdDaemonDNS.AddCommand(
 		commoncmd.NewCmdDaemonDNSDump(),
 	)
@@ -2906,6 +2906,27 @@ func newCmdObjectStop(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectMigrate(kind string) *cobra.Command {
+	var options commands.CmdObjectMigrate
+	cmd := &cobra.Command{
+		GroupID: commoncmd.GroupIDOrchestratedActions,
+		Use:     "migrate",
+		Short:   "orchestrate a running instance live migration",
+		Long:    "Live migrate the running failover instance to the '--to' node.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	commoncmd.FlagsAsync(flags, &options.OptsAsync)
+	commoncmd.FlagMigrateTo(flags, &options.To)
+	if err := cmd.MarkFlagRequired("to"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
 func newCmdObjectSwitch(kind string) *cobra.Command {
 	var options commands.CmdObjectSwitch
 	cmd := &cobra.Command{
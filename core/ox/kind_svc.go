@@ -69,6 +69,7 @@ func init() {
 		newCmdObjectLogs(kind),
 		newCmdObjectList(kind),
 		commoncmd.NewCmdObjectMonitor("", kind),
+		newCmdObjectMigrate(kind),
 		newCmdObjectPurge(kind),
 		newCmdObjectProvision(kind),
 		newCmdObjectPRStart(kind),
@@ -0,0 +1,50 @@
+package resource
+
+import (
+	"context"
+)
+
+type (
+	// Drift describes a difference between the running state of a resource
+	// and its configuration.
+	Drift struct {
+		// RID is the id of the drifting resource.
+		RID string `json:"rid"`
+
+		// Attr is the name of the drifting attribute, usually the keyword
+		// name.
+		Attr string `json:"attr"`
+
+		// Current is the running value of the attribute.
+		Current string `json:"current"`
+
+		// Target is the configured value of the attribute.
+		Target string `json:"target"`
+	}
+
+	Drifts []Drift
+
+	// Drifter is implemented by resource drivers able to compare their
+	// running state with their configuration, so we know which instances
+	// need a restart after a config change.
+	Drifter interface {
+		Drift(context.Context) (Drifts, error)
+	}
+)
+
+// GetDrift returns the differences between the running state of the
+// resource and its configuration, with the resource id set.
+func GetDrift(ctx context.Context, r Driver) (Drifts, error) {
+	i, ok := r.(Drifter)
+	if !ok {
+		return nil, nil
+	}
+	l, err := i.Drift(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for j := range l {
+		l[j].RID = r.RID()
+	}
+	return l, nil
+}
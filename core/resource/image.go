@@ -0,0 +1,59 @@
+package resource
+
+import (
+	"context"
+)
+
+type (
+	// ImageStatus describes the container image used by a resource, as seen
+	// from the local node image store.
+	ImageStatus struct {
+		// Name is the image reference, as configured.
+		Name string `json:"name"`
+
+		// Present is true if the image is available in the local image store.
+		Present bool `json:"present"`
+
+		// Digest is the identifier of the image in the local image store.
+		Digest string `json:"digest,omitempty"`
+
+		// RunningDigest is the identifier of the image the running container
+		// was created from. It is empty if no container is running.
+		RunningDigest string `json:"running_digest,omitempty"`
+	}
+
+	// ImagePuller is implemented by drivers running a container from an
+	// image the daemon can pre-fetch, so a failover or switch to this node
+	// does not stall on the image download.
+	ImagePuller interface {
+		PullImage(context.Context) error
+	}
+
+	// ImageStatuser is implemented by drivers able to report the presence
+	// and digest of their image in the local image store.
+	ImageStatuser interface {
+		ImageStatus(context.Context) *ImageStatus
+	}
+)
+
+// PullImage fetches the resource image into the local image store.
+func PullImage(ctx context.Context, r Driver) error {
+	var i any = r
+	s, ok := i.(ImagePuller)
+	if !ok {
+		return ErrActionNotSupported
+	}
+	defer EvalStatus(ctx, r)
+	if r.IsDisabled() {
+		return ErrDisabled
+	}
+	return s.PullImage(ctx)
+}
+
+func getImageStatus(ctx context.Context, r Driver) *ImageStatus {
+	i, ok := r.(ImageStatuser)
+	if !ok {
+		return nil
+	}
+	return i.ImageStatus(ctx)
+}
@@ -56,6 +56,14 @@ type (
 		PreMoveRollback(ctx context.Context, to string) error
 	}
 
+	// MoveChecker implements a MoveCheck function that is exposed by
+	// resource drivers that support live migration, to verify the
+	// destination node can host the resource before the move starts
+	// (eg cpu compatibility and free memory for container.kvm).
+	MoveChecker interface {
+		MoveCheck(ctx context.Context, to string) error
+	}
+
 	// PostMover implements a PostMove function that is called by a moveable
 	// driver (eg container.kvm) after the move is done.
 	PostMover interface {
@@ -146,6 +154,9 @@ type (
 	splitter interface {
 		Split(context.Context) error
 	}
+	splitBrainResolver interface {
+		ResolveSplitBrain(ctx context.Context, keep string) error
+	}
 	fuller interface {
 		Full(context.Context) error
 	}
@@ -0,0 +1,26 @@
+package resource
+
+import (
+	"context"
+)
+
+// IsMovable returns true if the resource driver supports live migration.
+func IsMovable(r Driver) bool {
+	var i any = r
+	_, ok := i.(Mover)
+	return ok
+}
+
+// MoveCheck verifies the resource can be live migrated to the destination
+// node.
+func MoveCheck(ctx context.Context, r Driver, to string) error {
+	var i any = r
+	s, ok := i.(MoveChecker)
+	if !ok {
+		return ErrActionNotSupported
+	}
+	if r.IsDisabled() {
+		return ErrDisabled
+	}
+	return s.MoveCheck(ctx, to)
+}
@@ -91,7 +91,9 @@ func Provision(ctx context.Context, r Driver, leader bool) error {
 	if err := r.Trigger(ctx, trigger.NoBlock, trigger.Pre, trigger.Provision); err != nil {
 		r.Log().Warnf("trigger: %s (exitcode %d)", err, exitCode(err))
 	}
-	if err := provision(ctx, r, leader); err != nil {
+	if err := doAction(ctx, r, trigger.Provision, func(ctx context.Context) error {
+		return provision(ctx, r, leader)
+	}); err != nil {
 		return fmt.Errorf("provision: %w", err)
 	}
 	if err := r.Trigger(ctx, trigger.Block, trigger.Post, trigger.Provision); err != nil {
@@ -133,7 +135,9 @@ func Unprovision(ctx context.Context, r Driver, leader bool) error {
 	if err := r.Trigger(ctx, trigger.NoBlock, trigger.Pre, trigger.Unprovision); err != nil {
 		r.Log().Warnf("trigger: %s (exitcode %d)", err, exitCode(err))
 	}
-	if err := unprovision(ctx, r, leader); err != nil {
+	if err := doAction(ctx, r, trigger.Unprovision, func(ctx context.Context) error {
+		return unprovision(ctx, r, leader)
+	}); err != nil {
 		return fmt.Errorf("unprovision: %w", err)
 	}
 	if err := r.Trigger(ctx, trigger.Block, trigger.Post, trigger.Unprovision); err != nil {
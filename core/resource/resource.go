@@ -159,6 +159,9 @@ type (
 		RunRequires             string
 		EnableProvision         bool
 		EnableUnprovision       bool
+		ActionTimeout           *time.Duration
+		ActionTimeoutGrace      *time.Duration
+		OnErrorCmd              string
 
 		configurationError error
 		statusLog          StatusLog
@@ -237,6 +240,18 @@ type (
 		// The daemon must watch for these datastore changes
 		// (e.g. reinstall files in fs and volumes)
 		Datastores naming.Paths `json:"datastores,omitempty"`
+
+		// Image describes the container image used by the resource, as seen
+		// from the local image store.
+		Image *ImageStatus `json:"image,omitempty"`
+
+		// HasDrift is true if the resource is up and its running state
+		// differs from its configuration.
+		HasDrift bool `json:"drift,omitempty"`
+
+		// ActionTimeout is the last action timeout of the resource,
+		// without the diagnostics.
+		ActionTimeout *ActionTimeout `json:"action_timeout,omitempty"`
 	}
 
 	Files []File
@@ -591,7 +606,7 @@ func formatResourceLabel(ctx context.Context, r Driver) string {
 	}
 }
 
-func (t *T) trigger(ctx context.Context, s string) error {
+func (t *T) trigger(ctx context.Context, s string, env ...string) error {
 	cmdArgs, err := command.CmdArgsFromString(s)
 	if err != nil {
 		return err
@@ -600,10 +615,11 @@ func (t *T) trigger(ctx context.Context, s string) error {
 		return nil
 	}
 	cmd := command.New(
+		command.WithContext(ctx),
 		command.WithName(cmdArgs[0]),
 		command.WithVarArgs(cmdArgs[1:]...),
 		command.WithLogger(t.log),
-		command.WithEnv(append(os.Environ(), "OPENSVC_RID="+t.RID())),
+		command.WithEnv(append(append(os.Environ(), "OPENSVC_RID="+t.RID()), env...)),
 		command.WithStdoutLogLevel(zerolog.InfoLevel),
 		command.WithStderrLogLevel(zerolog.ErrorLevel))
 	return cmd.Run()
@@ -913,7 +929,7 @@ func Start(ctx context.Context, r Driver) error {
 	if err := SCSIPersistentReservationStart(ctx, r); err != nil {
 		return err
 	}
-	if err := s.Start(ctx); err != nil {
+	if err := doAction(ctx, r, trigger.Start, s.Start); err != nil {
 		return fmt.Errorf("start: %w", err)
 	}
 	if err := r.Trigger(ctx, trigger.Block, trigger.Post, trigger.Start); err != nil {
@@ -961,6 +977,25 @@ func Split(ctx context.Context, r Driver) error {
 	return nil
 }
 
+// ResolveSplitBrain execute the resource ResolveSplitBrain function, if
+// implemented by the driver.
+func ResolveSplitBrain(ctx context.Context, r Driver, keep string) error {
+	var i any = r
+	s, ok := i.(splitBrainResolver)
+	if !ok {
+		return ErrActionNotSupported
+	}
+	defer EvalStatus(ctx, r)
+	if r.IsDisabled() || r.IsActionDisabled() {
+		return ErrDisabled
+	}
+	Setenv(r)
+	if err := s.ResolveSplitBrain(ctx, keep); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Full execute the resource Update function, if implemented by the driver.
 func Full(ctx context.Context, r Driver) error {
 	var i any = r
@@ -1086,7 +1121,7 @@ func shutdown(ctx context.Context, r Driver) error {
 	if err := createStoppedIfHasResourceSelector(ctx, r); err != nil {
 		return err
 	}
-	if err := fn(ctx); err != nil {
+	if err := doAction(ctx, r, trigger.Stop, fn); err != nil {
 		return err
 	}
 	if err := SCSIPersistentReservationStop(ctx, r); err != nil {
@@ -1281,6 +1316,10 @@ func GetStatus(ctx context.Context, r Driver) Status {
 	// EvalStatus must be called before formatResourceLabel (it uses context,
 	// on containers it will set the initial inspect.
 	resStatus := EvalStatus(ctx, r)
+	var actionTimeout *ActionTimeout
+	if !r.IsDisabled() {
+		actionTimeout = lastActionTimeout(r)
+	}
 	return Status{
 		Label:      formatResourceLabel(ctx, r),
 		Type:       r.DriverID().String(),
@@ -1291,6 +1330,9 @@ func GetStatus(ctx context.Context, r Driver) Status {
 		Info:       getStatusInfo(ctx, r),
 		Files:      getFiles(ctx, r),
 		Datastores: getDatastores(ctx, r),
+		Image:      getImageStatus(ctx, r),
+
+		ActionTimeout: actionTimeout,
 
 		IsStopped:   r.IsStopped(),
 		IsMonitored: r.IsMonitored(),
@@ -1455,6 +1497,15 @@ func (t *Status) Unstructured() map[string]any {
 	if len(t.Info) > 0 {
 		m["info"] = t.Info
 	}
+	if t.Image != nil {
+		m["image"] = t.Image
+	}
+	if t.HasDrift {
+		m["drift"] = t.HasDrift
+	}
+	if t.ActionTimeout != nil {
+		m["action_timeout"] = t.ActionTimeout
+	}
 	return m
 }
 
@@ -0,0 +1,50 @@
+package resource
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Snapshot describes a point-in-time copy of a resource data, as
+	// exposed by a Snapshotter.
+	Snapshot struct {
+		// ID identifies the snapshot in the Snapshotter scope.
+		ID string `json:"id"`
+
+		// RID is the id of the resource owning the snapshot origin.
+		RID string `json:"rid"`
+
+		// Origin is the snapshotted data identifier, for example a vg/lv.
+		Origin    string    `json:"origin"`
+		Tag       string    `json:"tag"`
+		CreatedAt time.Time `json:"created_at"`
+		Size      string    `json:"size"`
+		Thin      bool      `json:"thin"`
+	}
+
+	Snapshots []Snapshot
+
+	// SnapshotOptions are the options of a Snapshotter Snapshot call.
+	SnapshotOptions struct {
+		// Tag is embedded in the snapshot names, so retention can be
+		// applied per tag.
+		Tag string
+
+		// Size is the space reserved for snapshots not sharing a pool
+		// with their origin. The driver chooses a default if empty.
+		Size string
+
+		// Keep is the number of snapshots with the same tag to retain.
+		// Zero disables the retention.
+		Keep int
+	}
+
+	// Snapshotter is implemented by resource drivers able to take,
+	// list and restore snapshots of their data (eg disk.lv, disk.vg).
+	Snapshotter interface {
+		Snapshot(ctx context.Context, options SnapshotOptions) (Snapshots, error)
+		Snapshots(ctx context.Context) (Snapshots, error)
+		RestoreSnapshot(ctx context.Context, id string) error
+	}
+)
@@ -0,0 +1,285 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/trigger"
+	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/proc"
+)
+
+type (
+	actionTimeouter interface {
+		ActionTimeouts() (timeout, grace time.Duration)
+		OnError(ctx context.Context, action trigger.Action, err error) error
+	}
+
+	// ActionTimeout describes the last action timeout of a resource.
+	ActionTimeout struct {
+		// Action is the name of the timed out action.
+		Action string `json:"action"`
+
+		// At is the time the timeout was reported.
+		At time.Time `json:"at"`
+
+		// Timeout is the action_timeout value.
+		Timeout time.Duration `json:"timeout"`
+
+		// Abandoned is true if the action function did not return after
+		// the processes termination. The action may still be running, so
+		// the resource needs attention.
+		Abandoned bool `json:"abandoned"`
+
+		// Diagnostics is the diagnostics of the terminated processes. It
+		// is not set in the resource status.
+		Diagnostics string `json:"diagnostics,omitempty"`
+	}
+)
+
+var (
+	ErrActionTimeout = errors.New("the resource action timed out")
+
+	// ErrActionAbandoned is wrapped in the error of a timed out action
+	// that did not return after its processes termination.
+	ErrActionAbandoned = errors.New("the resource action was abandoned while still running")
+)
+
+const (
+	defaultActionTimeoutGrace = 10 * time.Second
+)
+
+// ActionTimeouts returns the action_timeout and action_timeout_grace
+// keyword values. A zero timeout means the resource actions are not
+// bounded by a resource timeout.
+func (t *T) ActionTimeouts() (time.Duration, time.Duration) {
+	var timeout time.Duration
+	grace := defaultActionTimeoutGrace
+	if t.ActionTimeout != nil {
+		timeout = *t.ActionTimeout
+	}
+	if t.ActionTimeoutGrace != nil {
+		grace = *t.ActionTimeoutGrace
+	}
+	return timeout, grace
+}
+
+// OnError executes the on_error trigger command, if set, after the
+// failure of the resource action.
+func (t *T) OnError(ctx context.Context, action trigger.Action, err error) error {
+	if t.OnErrorCmd == "" {
+		return nil
+	}
+	t.Log().Infof("trigger on error %s: %s", action, t.OnErrorCmd)
+	return t.trigger(ctx, t.OnErrorCmd, "OPENSVC_ACTION="+action.String(), "OPENSVC_ERROR="+err.Error())
+}
+
+// doAction executes the resource driver action function fn, bounded by the
+// resource action_timeout if set.
+//
+// On timeout, the commands bound to the action context are sent a SIGTERM,
+// then a SIGKILL after the action_timeout_grace delay. If fn still has not
+// returned after two more grace delays, the action is abandoned: it fails
+// with ErrActionAbandoned without waiting further, and the resource status
+// reports it needs attention. The diagnostics of the terminated processes
+// are logged and saved in the resource var dir, for EvalStatus to report
+// the timeout and the daemon to publish them in the instance monitor.
+//
+// On error, the on_error trigger is executed.
+func doAction(ctx context.Context, r Driver, action trigger.Action, fn func(context.Context) error) error {
+	var i any = r
+	o, ok := i.(actionTimeouter)
+	if !ok {
+		return fn(ctx)
+	}
+	var err error
+	timeout, grace := o.ActionTimeouts()
+	if timeout > 0 {
+		err = doWithTimeout(ctx, r, action, timeout, grace, fn)
+	} else {
+		err = fn(ctx)
+	}
+	if err == nil {
+		if err := removeActionTimeout(r); err != nil {
+			r.Log().Warnf("remove the last action timeout diagnostics: %s", err)
+		}
+		return nil
+	}
+	if ctx.Err() != nil {
+		// the action context is done, give the trigger a chance to run
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.WithoutCancel(ctx), grace)
+		defer cancel()
+	}
+	if errOnError := o.OnError(ctx, action, err); errOnError != nil {
+		r.Log().Warnf("trigger: %s (exitcode %d)", errOnError, exitCode(errOnError))
+	}
+	return err
+}
+
+func doWithTimeout(ctx context.Context, r Driver, action trigger.Action, timeout, grace time.Duration, fn func(context.Context) error) error {
+	var (
+		mu    sync.Mutex
+		diags []proc.Diag
+		err   error
+
+		returned  bool
+		abandoned bool
+	)
+	addDiag := func(d proc.Diag) {
+		mu.Lock()
+		defer mu.Unlock()
+		diags = append(diags, d)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ctx = command.ContextWithTerminate(ctx, grace, func(pid int) {
+		addDiag(proc.Diagnose(pid))
+		r.Log().Warnf("%s timeout: send SIGTERM to pid %d, SIGKILL in %s", action, pid, grace)
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(ctx)
+	}()
+
+	select {
+	case err = <-done:
+		returned = true
+	case <-ctx.Done():
+	}
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		// not a timeout, or the action was interrupted by the caller
+		if !returned {
+			err = <-done
+		}
+		return err
+	}
+	if returned && err == nil {
+		return nil
+	}
+	if !returned {
+		select {
+		case err = <-done:
+		case <-time.After(2 * grace):
+			r.Log().Errorf("%s did not return %s after its timeout: abandon", action, 2*grace)
+			addDiag(proc.Diagnose(os.Getpid()))
+			abandoned = true
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	return reportActionTimeout(r, action, timeout, abandoned, err, diags)
+}
+
+// reportActionTimeout logs and saves the diagnostics of a resource action
+// timeout, and returns the action error.
+func reportActionTimeout(r Driver, action trigger.Action, timeout time.Duration, abandoned bool, err error, diags []proc.Diag) error {
+	var sb strings.Builder
+	for _, d := range diags {
+		sb.WriteString(d.String())
+	}
+	v := ActionTimeout{
+		Action:      action.String(),
+		At:          time.Now(),
+		Timeout:     timeout,
+		Abandoned:   abandoned,
+		Diagnostics: sb.String(),
+	}
+	path := actionTimeoutFile(r.VarDir())
+	if err := writeActionTimeout(path, v); err != nil {
+		r.Log().Warnf("save the %s timeout diagnostics: %s", action, err)
+	}
+	r.Log().
+		Attr("diagnostics", v.Diagnostics).
+		Attr("diagnostics_file", path).
+		Errorf("%s, diagnostics saved in %s:\n%s", v, path, v.Diagnostics)
+	switch {
+	case abandoned:
+		return fmt.Errorf("%w after %s: %w", ErrActionTimeout, timeout, ErrActionAbandoned)
+	case err != nil:
+		return fmt.Errorf("%w after %s: %w", ErrActionTimeout, timeout, err)
+	default:
+		return fmt.Errorf("%w after %s", ErrActionTimeout, timeout)
+	}
+}
+
+// String returns a one line description of the action timeout.
+func (t ActionTimeout) String() string {
+	s := fmt.Sprintf("%s timeout after %s at %s", t.Action, t.Timeout, t.At.Format(time.RFC3339))
+	if t.Abandoned {
+		s += ", abandoned while still running"
+	}
+	return s
+}
+
+// actionTimeoutFile is the full path of the last action timeout diagnostics
+// file in the resource var dir.
+func actionTimeoutFile(varDir string) string {
+	return filepath.Join(varDir, "timeout.json")
+}
+
+func writeActionTimeout(path string, v ActionTimeout) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
+
+// LoadActionTimeout returns the last action timeout, with its diagnostics,
+// saved in the resource var dir. It returns nil if the last action did not
+// time out.
+func LoadActionTimeout(varDir string) (*ActionTimeout, error) {
+	b, err := os.ReadFile(actionTimeoutFile(varDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var v ActionTimeout
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func removeActionTimeout(r Driver) error {
+	err := os.Remove(actionTimeoutFile(r.VarDir()))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// lastActionTimeout returns the last action timeout of the resource,
+// without the diagnostics, and reports it in the resource status log. An
+// abandoned action is reported as an error, as the resource needs
+// attention.
+func lastActionTimeout(r Driver) *ActionTimeout {
+	path := actionTimeoutFile(r.VarDir())
+	v, err := LoadActionTimeout(r.VarDir())
+	if err != nil {
+		r.StatusLog().Warn("last action timeout: %s", err)
+		return nil
+	} else if v == nil {
+		return nil
+	}
+	if v.Abandoned {
+		r.StatusLog().Error("last %s, needs attention, diagnostics in %s", v, path)
+	} else {
+		r.StatusLog().Warn("last %s, diagnostics in %s", v, path)
+	}
+	v.Diagnostics = ""
+	return v
+}
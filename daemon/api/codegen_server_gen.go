@@ -24,6 +24,9 @@ type ServerInterface interface {
 	// (GET /api/array)
 	GetArray(ctx echo.Context, params GetArrayParams) error
 
+	// (GET /api/auth/can-i)
+	GetAuthCanI(ctx echo.Context, params GetAuthCanIParams) error
+
 	// (GET /api/auth/info)
 	GetAuthInfo(ctx echo.Context) error
 	// Refresh access token
@@ -81,6 +84,9 @@ type ServerInterface interface {
 	// (POST /api/instance/path/{namespace}/{kind}/{name}/status)
 	PostInstanceStatus(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (GET /api/namespace/usage)
+	GetNamespaceUsage(ctx echo.Context, params GetNamespaceUsageParams) error
+
 	// (GET /api/network)
 	GetNetworks(ctx echo.Context, params GetNetworksParams) error
 
@@ -159,6 +165,9 @@ type ServerInterface interface {
 	// (POST /api/node/name/{nodename}/daemon/audit)
 	PostDaemonAudit(ctx echo.Context, nodename InPathNodeName, params PostDaemonAuditParams) error
 
+	// (GET /api/node/name/{nodename}/daemon/audit/log)
+	GetDaemonAuditLog(ctx echo.Context, nodename InPathNodeName, params GetDaemonAuditLogParams) error
+
 	// (GET /api/node/name/{nodename}/daemon/dns/dump)
 	GetDaemonDNSDump(ctx echo.Context, nodename InPathNodeName) error
 
@@ -294,6 +303,9 @@ type ServerInterface interface {
 	// (GET /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/log)
 	GetInstanceLogs(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params GetInstanceLogsParams) error
 
+	// (GET /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/plan)
+	GetInstancePlan(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params GetInstancePlanParams) error
+
 	// (GET /api/node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/resource/file)
 	GetInstanceResourceFile(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params GetInstanceResourceFileParams) error
 
@@ -327,6 +339,9 @@ type ServerInterface interface {
 	// (PUT /api/node/name/{nodename}/ssh/trust)
 	PutNodeSSHTrust(ctx echo.Context, nodename InPathNodeName) error
 
+	// (GET /api/node/name/{nodename}/sysreport/snapshots)
+	GetNodeSysreportSnapshots(ctx echo.Context, nodename InPathNodeName) error
+
 	// (GET /api/node/name/{nodename}/system/disk)
 	GetNodeSystemDisk(ctx echo.Context, nodename InPathNodeName) error
 
@@ -381,6 +396,9 @@ type ServerInterface interface {
 	// (POST /api/object/path/{namespace}/{kind}/{name}/action/giveback)
 	PostObjectActionGiveback(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (POST /api/object/path/{namespace}/{kind}/{name}/action/migrate)
+	PostObjectActionMigrate(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
+
 	// (POST /api/object/path/{namespace}/{kind}/{name}/action/provision)
 	PostObjectActionProvision(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
@@ -420,6 +438,15 @@ type ServerInterface interface {
 	// (PUT /api/object/path/{namespace}/{kind}/{name}/config/file)
 	PutObjectConfigFile(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (GET /api/object/path/{namespace}/{kind}/{name}/config/history)
+	GetObjectConfigHistory(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
+
+	// (GET /api/object/path/{namespace}/{kind}/{name}/config/history/{rev}/file)
+	GetObjectConfigHistoryFile(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, rev InPathRev) error
+
+	// (POST /api/object/path/{namespace}/{kind}/{name}/config/history/{rev}/rollback)
+	PostObjectConfigHistoryRollback(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, rev InPathRev) error
+
 	// (GET /api/object/path/{namespace}/{kind}/{name}/config/keywords)
 	GetObjectConfigKeywords(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params GetObjectConfigKeywordsParams) error
 
@@ -499,6 +526,35 @@ func (w *ServerInterfaceWrapper) GetArray(ctx echo.Context) error {
 	return err
 }
 
+// GetAuthCanI converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuthCanI(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthCanIParams
+	// ------------- Required query parameter "verb" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "verb", ctx.QueryParams(), &params.Verb, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter verb: %s", err))
+	}
+
+	// ------------- Required query parameter "path" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", ctx.QueryParams(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter path: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuthCanI(ctx, params)
+	return err
+}
+
 // GetAuthInfo converts echo context to params.
 func (w *ServerInterfaceWrapper) GetAuthInfo(ctx echo.Context) error {
 	var err error
@@ -972,6 +1028,28 @@ func (w *ServerInterfaceWrapper) PostInstanceStatus(ctx echo.Context) error {
 	return err
 }
 
+// GetNamespaceUsage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNamespaceUsage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNamespaceUsageParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNamespaceUsage(ctx, params)
+	return err
+}
+
 // GetNetworks converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNetworks(ctx echo.Context) error {
 	var err error
@@ -1701,6 +1779,91 @@ func (w *ServerInterfaceWrapper) PostDaemonAudit(ctx echo.Context) error {
 	return err
 }
 
+// GetDaemonAuditLog converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDaemonAuditLog(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDaemonAuditLogParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "since", ctx.QueryParams(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "until", ctx.QueryParams(), &params.Until, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "user" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "user", ctx.QueryParams(), &params.User, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user: %s", err))
+	}
+
+	// ------------- Optional query parameter "method" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "method", ctx.QueryParams(), &params.Method, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter method: %s", err))
+	}
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "path", ctx.QueryParams(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter path: %s", err))
+	}
+
+	// ------------- Optional query parameter "target" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "target", ctx.QueryParams(), &params.Target, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter target: %s", err))
+	}
+
+	// ------------- Optional query parameter "route" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "route", ctx.QueryParams(), &params.Route, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter route: %s", err))
+	}
+
+	// ------------- Optional query parameter "failed" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "failed", ctx.QueryParams(), &params.Failed, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter failed: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDaemonAuditLog(ctx, nodename, params)
+	return err
+}
+
 // GetDaemonDNSDump converts echo context to params.
 func (w *ServerInterfaceWrapper) GetDaemonDNSDump(ctx echo.Context) error {
 	var err error
@@ -1773,6 +1936,13 @@ func (w *ServerInterfaceWrapper) GetDaemonEvents(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cache: %s", err))
 	}
 
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "since", ctx.QueryParams(), &params.Since, runtime.BindQueryParameterOptions{Type: "integer", Format: "uint64"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
 	// ------------- Optional query parameter "selector" -------------
 
 	err = runtime.BindQueryParameterWithOptions("form", true, false, "selector", ctx.QueryParams(), &params.Selector, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
@@ -1780,6 +1950,23 @@ func (w *ServerInterfaceWrapper) GetDaemonEvents(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter selector: %s", err))
 	}
 
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID EventLastEventID
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "integer", Format: "uint64"})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetDaemonEvents(ctx, nodename, params)
 	return err
@@ -4427,6 +4614,94 @@ func (w *ServerInterfaceWrapper) GetInstanceLogs(ctx echo.Context) error {
 	return err
 }
 
+// GetInstancePlan converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstancePlan(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInstancePlanParams
+	// ------------- Required query parameter "action" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "action", ctx.QueryParams(), &params.Action, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "force", ctx.QueryParams(), &params.Force, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	// ------------- Optional query parameter "rid" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "rid", ctx.QueryParams(), &params.Rid, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rid: %s", err))
+	}
+
+	// ------------- Optional query parameter "subset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "subset", ctx.QueryParams(), &params.Subset, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subset: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "tag", ctx.QueryParams(), &params.Tag, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", ctx.QueryParams(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInstancePlan(ctx, nodename, namespace, kind, name, params)
+	return err
+}
+
 // GetInstanceResourceFile converts echo context to params.
 func (w *ServerInterfaceWrapper) GetInstanceResourceFile(ctx echo.Context) error {
 	var err error
@@ -4796,6 +5071,26 @@ func (w *ServerInterfaceWrapper) PutNodeSSHTrust(ctx echo.Context) error {
 	return err
 }
 
+// GetNodeSysreportSnapshots converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeSysreportSnapshots(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeSysreportSnapshots(ctx, nodename)
+	return err
+}
+
 // GetNodeSystemDisk converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNodeSystemDisk(ctx echo.Context) error {
 	var err error
@@ -5302,6 +5597,42 @@ func (w *ServerInterfaceWrapper) PostObjectActionGiveback(ctx echo.Context) erro
 	return err
 }
 
+// PostObjectActionMigrate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostObjectActionMigrate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostObjectActionMigrate(ctx, namespace, kind, name)
+	return err
+}
+
 // PostObjectActionProvision converts echo context to params.
 func (w *ServerInterfaceWrapper) PostObjectActionProvision(ctx echo.Context) error {
 	var err error
@@ -5816,6 +6147,130 @@ func (w *ServerInterfaceWrapper) PutObjectConfigFile(ctx echo.Context) error {
 	return err
 }
 
+// GetObjectConfigHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetObjectConfigHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetObjectConfigHistory(ctx, namespace, kind, name)
+	return err
+}
+
+// GetObjectConfigHistoryFile converts echo context to params.
+func (w *ServerInterfaceWrapper) GetObjectConfigHistoryFile(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "rev" -------------
+	var rev InPathRev
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rev", ctx.Param("rev"), &rev, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rev: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetObjectConfigHistoryFile(ctx, namespace, kind, name, rev)
+	return err
+}
+
+// PostObjectConfigHistoryRollback converts echo context to params.
+func (w *ServerInterfaceWrapper) PostObjectConfigHistoryRollback(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", ctx.Param("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "rev" -------------
+	var rev InPathRev
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rev", ctx.Param("rev"), &rev, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rev: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostObjectConfigHistoryRollback(ctx, namespace, kind, name, rev)
+	return err
+}
+
 // GetObjectConfigKeywords converts echo context to params.
 func (w *ServerInterfaceWrapper) GetObjectConfigKeywords(ctx echo.Context) error {
 	var err error
@@ -6466,6 +6921,7 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	}
 
 	router.GET(options.BaseURL+"/api/array", wrapper.GetArray, options.OperationMiddlewares["GetArray"]...)
+	router.GET(options.BaseURL+"/api/auth/can-i", wrapper.GetAuthCanI, options.OperationMiddlewares["GetAuthCanI"]...)
 	router.GET(options.BaseURL+"/api/auth/info", wrapper.GetAuthInfo, options.OperationMiddlewares["GetAuthInfo"]...)
 	router.POST(options.BaseURL+"/api/auth/refresh", wrapper.PostAuthRefresh, options.OperationMiddlewares["PostAuthRefresh"]...)
 	router.POST(options.BaseURL+"/api/auth/token", wrapper.PostAuthToken, options.OperationMiddlewares["PostAuthToken"]...)
@@ -6485,6 +6941,7 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/api/instance", wrapper.GetInstances, options.OperationMiddlewares["GetInstances"]...)
 	router.POST(options.BaseURL+"/api/instance/path/:namespace/:kind/:name/progress", wrapper.PostInstanceProgress, options.OperationMiddlewares["PostInstanceProgress"]...)
 	router.POST(options.BaseURL+"/api/instance/path/:namespace/:kind/:name/status", wrapper.PostInstanceStatus, options.OperationMiddlewares["PostInstanceStatus"]...)
+	router.GET(options.BaseURL+"/api/namespace/usage", wrapper.GetNamespaceUsage, options.OperationMiddlewares["GetNamespaceUsage"]...)
 	router.GET(options.BaseURL+"/api/network", wrapper.GetNetworks, options.OperationMiddlewares["GetNetworks"]...)
 	router.GET(options.BaseURL+"/api/network/ip", wrapper.GetNetworkIP, options.OperationMiddlewares["GetNetworkIP"]...)
 	router.GET(options.BaseURL+"/api/node", wrapper.GetNodes, options.OperationMiddlewares["GetNodes"]...)
@@ -6511,6 +6968,7 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/api/node/name/:nodename/daemon/action/shutdown", wrapper.PostDaemonShutdown, options.OperationMiddlewares["PostDaemonShutdown"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/daemon/action/stop", wrapper.PostDaemonStop, options.OperationMiddlewares["PostDaemonStop"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/daemon/audit", wrapper.PostDaemonAudit, options.OperationMiddlewares["PostDaemonAudit"]...)
+	router.GET(options.BaseURL+"/api/node/name/:nodename/daemon/audit/log", wrapper.GetDaemonAuditLog, options.OperationMiddlewares["GetDaemonAuditLog"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/daemon/dns/dump", wrapper.GetDaemonDNSDump, options.OperationMiddlewares["GetDaemonDNSDump"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/daemon/event", wrapper.GetDaemonEvents, options.OperationMiddlewares["GetDaemonEvents"]...)
 	router.POST(options.BaseURL+"/api/node/name/:nodename/daemon/hb/name/:name/action/restart", wrapper.PostDaemonHeartbeatRestart, options.OperationMiddlewares["PostDaemonHeartbeatRestart"]...)
@@ -6556,6 +7014,7 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/console", wrapper.PostInstanceResourceConsole, options.OperationMiddlewares["PostInstanceResourceConsole"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/container/log", wrapper.GetInstanceContainerLog, options.OperationMiddlewares["GetInstanceContainerLog"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/log", wrapper.GetInstanceLogs, options.OperationMiddlewares["GetInstanceLogs"]...)
+	router.GET(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/plan", wrapper.GetInstancePlan, options.OperationMiddlewares["GetInstancePlan"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/resource/file", wrapper.GetInstanceResourceFile, options.OperationMiddlewares["GetInstanceResourceFile"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/resource/info", wrapper.GetInstanceResourceInfo, options.OperationMiddlewares["GetInstanceResourceInfo"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/instance/path/:namespace/:kind/:name/schedule", wrapper.GetInstanceSchedule, options.OperationMiddlewares["GetInstanceSchedule"]...)
@@ -6567,6 +7026,7 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/api/node/name/:nodename/ssh/hostkeys", wrapper.GetNodeSSHHostkeys, options.OperationMiddlewares["GetNodeSSHHostkeys"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/ssh/key", wrapper.GetNodeSSHKey, options.OperationMiddlewares["GetNodeSSHKey"]...)
 	router.PUT(options.BaseURL+"/api/node/name/:nodename/ssh/trust", wrapper.PutNodeSSHTrust, options.OperationMiddlewares["PutNodeSSHTrust"]...)
+	router.GET(options.BaseURL+"/api/node/name/:nodename/sysreport/snapshots", wrapper.GetNodeSysreportSnapshots, options.OperationMiddlewares["GetNodeSysreportSnapshots"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/system/disk", wrapper.GetNodeSystemDisk, options.OperationMiddlewares["GetNodeSystemDisk"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/system/group", wrapper.GetNodeSystemGroup, options.OperationMiddlewares["GetNodeSystemGroup"]...)
 	router.GET(options.BaseURL+"/api/node/name/:nodename/system/hardware", wrapper.GetNodeSystemHardware, options.OperationMiddlewares["GetNodeSystemHardware"]...)
@@ -6585,6 +7045,7 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/api/object/path/:namespace/:kind/:name/action/delete", wrapper.PostObjectActionDelete, options.OperationMiddlewares["PostObjectActionDelete"]...)
 	router.POST(options.BaseURL+"/api/object/path/:namespace/:kind/:name/action/freeze", wrapper.PostObjectActionFreeze, options.OperationMiddlewares["PostObjectActionFreeze"]...)
 	router.POST(options.BaseURL+"/api/object/path/:namespace/:kind/:name/action/giveback", wrapper.PostObjectActionGiveback, options.OperationMiddlewares["PostObjectActionGiveback"]...)
+	router.POST(options.BaseURL+"/api/object/path/:namespace/:kind/:name/action/migrate", wrapper.PostObjectActionMigrate, options.OperationMiddlewares["PostObjectActionMigrate"]...)
 	router.POST(options.BaseURL+"/api/object/path/:namespace/:kind/:name/action/provision", wrapper.PostObjectActionProvision, options.OperationMiddlewares["PostObjectActionProvision"]...)
 	router.POST(options.BaseURL+"/api/object/path/:namespace/:kind/:name/action/purge", wrapper.PostObjectActionPurge, options.OperationMiddlewares["PostObjectActionPurge"]...)
 	router.POST(options.BaseURL+"/api/object/path/:namespace/:kind/:name/action/restart", wrapper.PostObjectActionRestart, options.OperationMiddlewares["PostObjectActionRestart"]...)
@@ -6598,6 +7059,9 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/api/object/path/:namespace/:kind/:name/config/file", wrapper.GetObjectConfigFile, options.OperationMiddlewares["GetObjectConfigFile"]...)
 	router.POST(options.BaseURL+"/api/object/path/:namespace/:kind/:name/config/file", wrapper.PostObjectConfigFile, options.OperationMiddlewares["PostObjectConfigFile"]...)
 	router.PUT(options.BaseURL+"/api/object/path/:namespace/:kind/:name/config/file", wrapper.PutObjectConfigFile, options.OperationMiddlewares["PutObjectConfigFile"]...)
+	router.GET(options.BaseURL+"/api/object/path/:namespace/:kind/:name/config/history", wrapper.GetObjectConfigHistory, options.OperationMiddlewares["GetObjectConfigHistory"]...)
+	router.GET(options.BaseURL+"/api/object/path/:namespace/:kind/:name/config/history/:rev/file", wrapper.GetObjectConfigHistoryFile, options.OperationMiddlewares["GetObjectConfigHistoryFile"]...)
+	router.POST(options.BaseURL+"/api/object/path/:namespace/:kind/:name/config/history/:rev/rollback", wrapper.PostObjectConfigHistoryRollback, options.OperationMiddlewares["PostObjectConfigHistoryRollback"]...)
 	router.GET(options.BaseURL+"/api/object/path/:namespace/:kind/:name/config/keywords", wrapper.GetObjectConfigKeywords, options.OperationMiddlewares["GetObjectConfigKeywords"]...)
 	router.GET(options.BaseURL+"/api/object/path/:namespace/:kind/:name/data", wrapper.GetObjectData, options.OperationMiddlewares["GetObjectData"]...)
 	router.PATCH(options.BaseURL+"/api/object/path/:namespace/:kind/:name/data", wrapper.PatchObjectData, options.OperationMiddlewares["PatchObjectData"]...)
@@ -6623,296 +7087,340 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"7L17cxs3sjj6VVA6p8qbcylKsp3dxLdSpxQrTnTih1ayd+tu5CODMyCJ1QwwATCUmJSq7te4X+9+kl+h",
-	"AcyDBIYzJCXrMf9EMQePBtDd6G7048+diKcZZ4QpufPqz50MC5wSRQT86+j0x6NTInkuIvIep0T/FhMZ",
-	"CZopytnOq51YjGIkbBPEdJvBDtVffs+JmO8MduC3Vzv2kyC/51SQeOeVEjkZ7MhoSlKsx1XzTLeTSlA2",
-	"2bm5GdRn5zE5Plo1f8QZI5H+hBiPyS6NQ9DwmFzA10YAcoHNPIvTpvgaxe6rf4rK53IOco3TLNGfv5U7",
-	"A8+UP80IU69xNLV7nQkSYVXu18Lqi+8IJxRLxMfoiyBZgudfhuifNEnQiCBBUj4jMaIMYTTOVS4ImhEh",
-	"KWfDAPARQFCFPCZjnCdq59UYJ5IUoI84TwhmJexvaKKIWN6xhEqlwSO6ERqbVv7Ji4/l7FSRVC4Paloi",
-	"cp0JIvV6XqHfLimLP/82SPCIJD/McJKTz//12zDGCl9fX9sfzvWplGfxYfRvEqkzhVUuP2Wx3s9BhtX0",
-	"hzHny6dU/ICFwPNy5aew78tAmvNAaqrxM81wpI/LbMOUSsWF/oYVigTBikjTMBdCN4iSXOoVavAlUcNz",
-	"ZpZM2QRhFiNJEhIpLiTCgiCcZQklMVK8abbheQhlDaRdj/0tTanyHXhKFYKDQxHPmQpMCu38RHIw2Blz",
-	"kWK182qHMvXXl+VhUKbIhAgDAJ+swrqET7aFcxh5sK6CbXXUGw6HNVSTNP7he/wd2X9J/ro7ig6e7758",
-	"Qf66+92L+GB3TA72429f/PUFwX9rhXZ64TxJ+JWHMuB3QIOET2Ro1aa3hwvWDphPfhYka97dlEiJJwSV",
-	"+JlhpYhgobknesg6qtW3WTeobHJ9H2OOhv/l5aBv+eQtZUR6CZELhdSUSsTydESEBj7DUqEE/sMniDAl",
-	"KJFBXGXwbWm7quior6oPMCdOloHQN09BtgvLC91UgSuEPR/gP34g+YF3H06wmi5Pz4HVdQFAM8LGi7ty",
-	"KKODwRUZ/VcQnvC2rA3XWnDIMC5bQPToUjNSSVgMJITGXDSAItvwjsrgda4wiw4GSM6i563o/pQkeP7a",
-	"XA0+oQiYv705aIwKCU+vT3+TCVf6A2fwT0EM1/cKAmYYIyu1Ft4GO9e7E75rxyghdbBrEmFeeVLDw+zX",
-	"jQB3g3SUOQG8U5Jy5QHueIxgBFRwEoIkSA0aQIDGXN+SiJnee4mihBr4h+h4jOASRVwgxjWuq8BIlSFI",
-	"OiJxTGIz+jB4cQPAK/g4rO2TJMK/9XZ1IFeY3f09J4BDU2yWJThXaCIwA8CxaVYwfsFTA3lGIjrWckgu",
-	"iTCAowwLRUEwp0wq3deus5jlmSwbhdaZO+BbHGIDjbuT4oiyKMljokVjA4zMOJPEyVvB7V4Ukwp6X0G8",
-	"dcKwcGqIaRzmjYV604E7uj4BDjmW/3EwoJmXQZ7yhDRsHs4oEjwJ6Xn2k2dr/lOQ8c6rnf/YKzXOPdNM",
-	"7uk5vayOMs2vfyFYqBHByimhMLNlo20VzKb5jzBJOatPU07/K2VxYFatbaw9K4xbTvOWSkUYEbe7yNos",
-	"5eSbTLqMQ+WYMsNR08Dmezv5QhGpvChrp+MxaVpGmxuhjvMfKxcpCG6KW35WYV1I8SH6cvEFGOeXhEc4",
-	"mXKpviBBxkQglZ0zd6sZRU+QiFCtkFcGGS6opMUwgfX+XVPdYZKcJXhmqNVHjNJ8DS/wMFL6MsVJggiL",
-	"cAbcGbOIyAEsJ+bsmULYtNLgapCKRoiO4SbD8pLEWj7SjCmhEVUkgbvKcwk50DWFvw+LAPr618wGjXB0",
-	"qUUwrbrqa8awhkYbUzNiwvSvORtTkYb2LbKfV1yobjBhTETekcSCBSg8zBFJiAqfZQyf20iZH2sbKK1B",
-	"THFkhhigK6qmPFdoJPTmKllXrRSWl/+RsyvMFIlbyaNuAVTiUUJOeZLoUwsuxDS7EK5dy+0RdOZT8eWU",
-	"XyHOkjm6JPMrLmIrQlGJYtMlYJ9zH/3345Bcq5dNxPcTmwXPirBZm4M6ZIiwGRWcpVpInGFB9c4YtUM5",
-	"oUSfhxaFU8xiicg1iXI40IgzRa5V/fDe/T//ODz9IZ3PcNLl6H6a4STHigQX5L6HWckRAX5HWEQGSEY8",
-	"M5JkxNmMWAnXHhAS+AqBeaSZR7zhIgpCNOaL0k14oJ8FIeojTQnPVWi8iW5zoWwjrwnMZ7OtC3S1iSoA",
-	"/PLj4fKGnYGcPEcYTUcYzjzCDLgoI1dolPDoEsVkRiMiQ1LedIT9CPzt/v7Byxff7e8/f/ni+csX+w14",
-	"fJxmREjOGk6fVpo0X5ZwyQHv0cJ12Q1dTQlDFovAeOmQYYjOiIKfas0th3J49wNoJoKoXDCJMPoRx+jU",
-	"Xr9ECC6GTaT6K5nX5IKuTxMLVGu0A8UFYLR79Fg1u1wx/Wpu0WreZo3DAFKDDVhmCLbLq3aQOcoG+Sjl",
-	"M1LnSoTNhuvcKG8/vW+im4RPaIQTlDOqnEVvLTpK8tAzzUHTyb4lODZXkndQ87Udi3qHpQoPlZqvK+W4",
-	"ZQmtYk2gmjEvCXV1sW8Die4dn5GPPLgCPiO7ireTzgq9ocGMWlEdQkTlvreZkcfkzGrXPlt0yGqLEnpJ",
-	"0Bf228HzF5+/DNAX9l/6v+ncvAHAPZyTL21tu0H4PmT+d0gQfmpXq7uDYzSaF7KfPnWeNTxWFh8DxoLn",
-	"TWRwwnmyjiifcZ5sLMm7B+I3NAk8Uet7SWuCBogxTUgVqZGcYmF2C5cPyUY4NOpa3RammZg0fA60OPis",
-	"GTPi4+H23r49qzulsX9xgsYGUqrFw4zAk5/i+v+5JDX4Y7N+vR1By1E3U28FVh98lT31kE8jCC2mPCNY",
-	"hZVf+OiV5A6W3hHr16QZtzZR1ECAMs8yLvTuLqkgoElOrCuAo8fAssuv61ChY19hnukOIDh98bnV1sMJ",
-	"mucH/3DQYNHLonjQzXMaN6+n6WhVN7mEZ8QegUU+EiMs0Xm+v/8iuryCv+Q380/KYnJtfvlsfuGZ+af5",
-	"F7B084NRpRHPzD3wA/q/fkC7PyzLPgSrH8Yip0p2kX5a2HZa7UIpGyzYeCqm+tEcBAc99jYtPy0WqbAi",
-	"H1gyD65TN7jQCn5LUeosH2n8CA1nvrbC8Y94EhpG4UnbMcSEqCYpVkGL9QRX0zeoA+7vf/+3F99+d/Dd",
-	"t/vffddAa2G5ra3I9onJBnrNWUuKrZqujNxaGK+MXrFt49WNvurMow+A83x/X/8B2wqDYwOnmgiYx96/",
-	"pbkD2tnbTwQfJSQ1s9TX+eFXDcvz/ZfLW/Ceo9d29pvBzsu7gaeiT5tZD+5i1k8M52rKBf2DxGbaF3cx",
-	"7RsuRjSOCTNzvryLOd9zhd7wnNl1fncXczoDSWGQ0jN/fxczv+ZsnNDITHlwJ4f6I4/nSHGOEs0S9cTf",
-	"3g3pHDNFBMMJOjMv9j8JwYWZ/04WfmZUe/SJ4RmmCR4l5lnPdtUjH4oRVQIrLoyPIzj6Ci0TKWrYnix+",
-	"b4LC9r4Z7OQi8b52XxE6maqAY1SpU/wGAwzctEW/zwV/Np4yekh4KTpWJF2G2vkxBJi877qqwlA1oDXO",
-	"LFu/Y5fAegxx8PEtlWp5Jd0GhyO4tI/ShOWpXg18rawjsGgzk+3uXXWupodRRKT8yC8JW4YVw8cLcp3p",
-	"MS+wqon1MVZkV1G/adJ2VW7gZlCXJ1oYIQT+MRvzZbhToqY8rm+32zyeEQbqyAhLGmlt8Nv97zWCWj31",
-	"cws7qx1jaV7jAnRhPi2NQqXMjeFvxbmZdoPKcJ8X27gVhvbllIwFkdPAuQrzda2DdX0bTtYLUQEKTpIP",
-	"451Xv62ggAXcvBmsbl9b9M3nm8HOa5zhEU2omrdmKT7O4dvlcmg/x4qxWsnrK+B5yHxhBh9ipmT1JO95",
-	"TN7pdotLsw4tMMbAwLt6oe152AL4HjIqW2zAKhfBa9xImGcl47QbY6b3bolxFCmv2OUWPE2pgmiOpVXJ",
-	"i2iK2YTEAeW2zguKxj5Ajt6fnZKICy8vwtLvp+Ywc+lD+JJVie+iX+f2HVjAzKANaHf0/uxfnJHWeFBu",
-	"hQfTjk5/PDpMEh4VQT71zVqHERou32zkqtsajVtsShkX/u3MuGgjUUEzN9Bgp3Zr0gCinP54BJ4wkzCn",
-	"KpYymiv/M2cViPDBeRzsllTf4jMydiir1qNnB0Nx/QwsI9OiibW/gw//s+noPw6eVcyrlQe8obj2HVTd",
-	"Ga79FWT6neUjOZeKpIVIvCQlxbHwks3CcYZkH93dNl7ez8+LSkB9Nch8HNlgog8ZYWf/eI1iaIQS10q6",
-	"RYDrMRmcs6spjaaISucIS0cJgW2H90M2geEOT46H52x5D/1nWsBk6b08malS2S5lRIWP58QnT2U1SSpI",
-	"DiGc956fx5vF7SBe3L9i214Zrwaq0BWWJoTExHDFg3PmDP8kRpih3EaVoSt4p1GyiPCCrddbjlkMH2hs",
-	"fAEXGHcxXCd2ZOFZg4WtZlkAuRfF7Wo7zLpwePXV1pZRGx2AdZD4T1vhX4lHztPcTLbgb4MuEuHADtsA",
-	"yQYyTWWEoFBTnWVziWZhxg4KOLxs+z7YwX2fJP2DtCBs+2huBxoUT8W6d4tFrL3fXgnCNOk8pncsKi89",
-	"1zCZZTaQa21CTXlM/OYaQSaUs/bgn0J7H/Tu8ErJJxSwGZQPBzszwmLeRhfWaOt2xs5d9HbrLURLt0gv",
-	"clB5ub6mBkfmo0I36m1pZwCcHappWR0Q04EcwMxN+FYBTGCrtsWtCgfhrar0ZtgNkMSA5Vs7fJFfF1OK",
-	"1XU40HJHfNgCXzfBlwpI4V3bEtJA5L4DdinsCt4FiyYgpSEXByWlZi6l+EAZhifPpWN8k5DrkJaV4ut6",
-	"xPu+j2GmlNVaPfdy1eKhuXwBHqy6TPXIA4CiGMC3Sz8Lnmee4/QJ4r4bqB0JAlsP0iHAsD4ZmiV48Kkc",
-	"92vRYAFBexopgfZQIHzcgAAr8IT2a0vU9wsW8RUWpJOhqkqkvu/FNbCseockqXYWKytuVAEoDVdFOEnw",
-	"Ocktdn0cLrbLcyy10b8WJleBaI9vNdA9+Oy+b4DSdcAatm9biO3MVKdc66an9ioJsdCu9sJlxukD4vjk",
-	"MI4FkZ73Xlx+WEKUcYIn1cRDS+boOjxvEjw5KpuDS5Aae0dOcRT43ag+a9KlHnZQLGlpARYgO00DgRb7",
-	"tT6FllvuwbH6+F+LRmtQtKegOvAeKi0abECmC7D59vCoOsvmhHpsHRo9N1AhsjVCbPtbAQ+0bUZtFEGb",
-	"ju9s85tBSycM19FZnm8aVnUI9vDDKCKZ99Wp4qPbkQuV7r+LW14Zs2nDQxIxzjIvK4imJLqUeRr4SJNY",
-	"mJfk9qkYYpH5HtsGEL7p54zketXxVKT9EhkucOE+3h48VkmSsmxHE9GUSCWsCbYJog+VpiAECZfsrz0s",
-	"QckpS3BEUsLURcYTGs1XOjK59iemOTyIcL91KhPkYnkDPc0oF/Yxf1ktcuEH7tqjxjv+pIZ0zTYvM0B5",
-	"qEs4rZvGedKB0Z3ZHkuDlga1iGek2yEt2eDCJjip9L5O/ehvXKRXr8E0qyyBZzzhk5U48NG128ZTgeYX",
-	"Fe5Q4QWGwAc2GHsBkbzYNajmiKhS2MDJ/o54PIhfQcQq1lWxw51qucWVTVt42HAntMRDLR8uztSw06E9",
-	"hsrXXZq6t0ZDvjsTqqb5aBjxdI9nhMlZtMfTF3uzF3sRF2TPjQV77Pj0BrJQMZznGq+Ovq4kVFyhG/ix",
-	"VAHpIKdUwffJQvb7JqJQDbCGLWwnCK10+ys2E2frcsrqgYfHtwe7YKPt/F60sL7yOUiP1LjAUj5bEPgq",
-	"QsRS70nCRzi5MOF1XkhrLS5MQKVcPdZFdw442KHyYoovkiL8eJmHU7nqcyYI5BiL/S0gAU3TeqsN1lpE",
-	"nflemMQWHccomXQpxjaJrR+q7Y1tcmEIeRFbB6PlPamITkuHujU5o6IQLAsaNXm9pXze9FJvgqvWOb2N",
-	"L+46RTVQRYi0qki+QBIL6BtGVg8GhTBisBDRqGw2jMUdbETsBcqrCwq1QUpJo+BLbUUBh0FblwVC0QMQ",
-	"eNA+eCAKqXEQnrjp7VPOs0Q+Y8H/IKwrp60xysV8yPUnI9cUUWlyKVIbBmxzO06xhLDJESGFTxCKc8iW",
-	"gs9Z6dwW8yumQUIRn5EiPD3FWoRnEICZEUF5PDxn4IMEiRiXviLCYjmoJpeUU54nMRoRlDPrQjo4Z5jF",
-	"qAD9ymYNlyYmEdZpPJI8lwSW6kIqLDrz7UqQcjuk0fuAkw4dMsFnVNOrObgV0TVF022y8gZUFDljei9a",
-	"e16Y9hDZ4NUWcUL8CvDmGhZQtyVbR6RVYlrGg8oBlye3xPuqJ1TnhG533MJqq2jLBs9ccNGWuKDNlXNE",
-	"xpQBSvhVI0jETzraVyLMYqpX2LWfya4VeO8qmFX424eGhzTT4iO5Do2Qaf7S0ebme1io3ADu6drn7sSm",
-	"RFA/LE45ag9IShlNceIX9HjWYG2ySGupd7lzSTte40rEM4jR838lYTuXCh2E/qHTMSxZbJ35o5IGxkJZ",
-	"RTELQh0zyjMr8W0Ru2r4XSLOwCnptT116xkUlFQefQ1/Kosoj9On+XkJt70m7qd7D0otNdxA/Q/A7LED",
-	"+Gfd/GXEjutnci4vG0BS5b6YwVYU3y+wHyFtYo6tOY9CUo4WXl4LzqMOjAWI3Xgr9qXzca7AnM3xZRWW",
-	"bAs3FkaXs0jvGaRzisZwbxP9Sy4112DS/BbpP58D70r2R4ZTyibDXw0E69/cZhxXEOI1Z0rw5Ecee1zB",
-	"EzIjST3RI9Vi1qBYXkxG+QTYEPx8hQXEmECg92BnjBXIORlmEDrKtLa4co/NrCtkmRL0HVfZoslTzTZY",
-	"00/tPVFXXHh8kGGhHe/5sSAkaKNw9x6dDI9NmouwI3kJ1CqH8VVzBN2Oc0ni1uM0RrE5aPWYeAL7rvfB",
-	"TtHgpG73/vjEQ/7ZSi/vk4WdanQfcDO5425iuMFnP7HaAnXqc1TJnIW2eFExub4c8I17043lllvqQc/i",
-	"4wYsdwEuD9Otz7K5jX7p7DoEZDTQ0Trxmm0ObJ3jajisLRzVioPa1jFZclrHn0T37exLAi5BXf1IIOVl",
-	"gw+J/n4P/UcqG+TZ4qRM4dlul18XXRr8PqacX3ZAtmLwXzj3IjQkB220Li1jYMW6dzEROCIXxsa3KIAr",
-	"mpJhUbgQOl5fZFjgJCGBeO2Usgsw8lykJL3IIrWqmbzCWbhdJi7JfNXtcHJqw6AEwfG87VoE+TenrNv6",
-	"ZZZQ1eQ/IuW0BcBnZ78AxAvYapwLDIIUB9twWgvn4dt8704vbJR/KxYWWyzNnUkzPb2uUk+dsMaExERc",
-	"hLL+UCZJlIugTUPMGjqrMi97wzkubHsFoMr0tbnKkZuXDUTqYSWQb7+b0AtlFjsKyu1CIxw4xRwNgRJQ",
-	"KtWbAafkOwulfeB3eLSYkkJxgJzH5tOw0AZb8L63uouX7VWC7VtGS9Zi9H23FAtWEiuqiNllVdMKwtKu",
-	"pkSY7Lh2/fAMAnXfsIDiY5RNoKyVN6Fl5q8jZwbwbaXi9SImSGJm5mu9vWeH76Gs3yqLXsGHKv5Mrkhd",
-	"cQpB3Fnb4wcEH5945Ub9WtlqHADdLvGQtaZE8qD8vZysvEAJ3RGQ0YtVhR2rPgL8XB9isYBGs9getmfB",
-	"ajYQrYutDRz8FoXqTr5DPstfcOCQT1BXt591PClu39Pmbr1knqiTytf0OGl6HbUoHvTdmNjklMvJ+jK6",
-	"6gAPT46hZZFfcu0n86UUlb7LHgpaq7ZpBtbw9pjYZHSBBayetZXnHZ8RkXAct8r1Zc7HnEZ9p4v9qL/F",
-	"T8D1ecE1qjJlCEGkkxnbs/26M4LXgq3ZrqRszIfvNrPnu3Fgg0zB9kOnrLTL3GQ6veYQP7mhC1P3SBBz",
-	"RCtjNQDGN6btRmEe3f1lthDJUQxRMPNWI0AO+M18dtaLPbgoUuFfmBL11ej5Fyuj551njD3bxZiB0vHF",
-	"FyywsFeL7jC1uIAlOL0pwZbI2mJ8NJ6sRyWfyzG2MAT3xXkXC2vt7I+zxaCfZjSx7aohNc2RObrRkuzQ",
-	"1OWTaXm4/DpUrq4WHeKiQFZc22bfjqzihdm89d4fWotmJ4bYoqnGpJZNW7c8I1HblrO2LT/Jtqv/Bzyt",
-	"tmzpuHmJ1G8Krr6QYh5+dwobnkwEmZj6I3xcqb9hGIfJAScrD84FQ0npNXADtqf125zZD5+refSKxkvi",
-	"jIFxfX2+goAe5a4y+rp6vRliE82+BKK9yloB3KPdm68baMRVkILbtiWtuLKBS8B2DJ0JD3/iLF3trZol",
-	"aW94a2ia7zhEd+ZXTqcZx4YQ/8NEs94uxOtzrPLH5cSaNhC3ZC1ymvr4inO8qKQt+tuLv708+O75y/3B",
-	"6vDTpcS14DcU9I34UJeBSy8dVvXRmWIwdxr9WCgvS6rZNf6ek9z3pukzlnR52VwyniyS2+L4vjWf4OgS",
-	"TzzyEhbRNPQGo3CSkHhZ38V+fXfBicT1P1xU4eAl5qMeoclRR9JJF+eCwc6MCOl/kgtYMG37gdmDwhOh",
-	"unADRsOGrn8XuhPxcPTq2F8rq0cFhvY3VRVwDxO3nze4CmtQhXduS16JJ1hF02DG1/IF2M2O4xjCqzCb",
-	"mDyRKZ+Z/1l4WyuPcuO0sQP3f15a4W2KYrhQ9dD7X3UbuhxVZfN8yLCg7i9wYkZ2FoOhDESoUHRRofu6",
-	"A4CUyYZdD3YSjmOEZxP7aiURF8Z8ZQeXETdPvJkgGOyhUzr28/kFw8KSdL4EmVPBy3IgCrzJYfLdyr+s",
-	"GB6TsX9ie4MuvCW7SgO0a3jHJp6XLbJATPVGdsr5H/QUNFVUAw8C7fNShH0017H+W7/OFvPOeJKnpDQC",
-	"rcpBbK4k68loL6KpQcvaaS+MXOyT1zF0pUFAo1dHDs+59yle/74JXy8A8TF1N/bm6o0e6h+wgc3h+u2p",
-	"g8oLLrIpZqEI71Cem1CSmtbI7Zd6rRdsJWlJCWGDTFxuTHd8sBsawArzdUPcqIIWwJDKPNvAE6mcgfBE",
-	"8Ik/6R2VFxkWioaivrbizRh+yAz7OTZlsNdL08JhWavD1Wn0RB66WiZrLKEshGJWsV79jzoIDXYbvaxC",
-	"+aWcnRIjByy7nnERkcA72cpRz66oMvrSYlZ1qSjDq3NypdQFix34/JlmpMULXnUy2ym0I6ckwfN3REqv",
-	"7heZAkMtHtZtKSJzkq5b8FJP5SR42bd0TyshW5jPjF4Zy7v0ijF/oXoIvyICOdM5+GVV3lhiNKZCqloF",
-	"2G+9GZRd/UQPJij78LdYOnqap5jtalkTj2zJeMxsGW9TNjlCipvwfh6Zch6R8zA7Z5mZsRY5X3dpyAP1",
-	"b3/5+PHExetHPCboL7+dvnn9t+cvDj4PkC1Ejv76DZoQRswujOZmTi7ohDJknCChbosfOuQDriqFUZUQ",
-	"357IKRdqsLg1Mk9TLOYLgyM97hChY4XOfvnw6e3ROXv/4SMy6ha41VUBUzwM5gCR64hk6pzpJWW5yLgk",
-	"UGIdnCzoH+ZU/kKGk+EA5ZKyie6qNaUZQbb+5jljZMIVhbb/N5KEIM+2vhi+/MZ7ZEs0rczTX1HB0uyZ",
-	"H7t5FEwqGqWBQOgEZ4via+y8YQcr3JC2FlUZKLUTiP8BaSgOufB0zhEh81HrgM7MuNE4D4RaKgK3lWZE",
-	"A+NgybNHH4RZ14oz7CAJVQ7eJ22Zz5uIWlWofHJWZYYtmFcMgPNAgGA3RdLkegg4hKtABoBakO9B9fLT",
-	"PzzfebXD8nRUZOF+0XApu+BNVz3GgOMmb/KXdNuwgSnRbWTlyL6KW2x1KZ2wrtwAP17D980QuwKYH7PL",
-	"ObaC2lW/k/q1J02B5UHxbou4QC5bCap4bSyZkSB3ztIrrhK537hoq+t0qgE0cZUZ1q4O1KIiUyu/t4YC",
-	"PSVfNmYOA7TvIO6fAHwRLOTXmJ9X6IVs05Il2kniZt4q6IMu0vlCErJi3uBZGf+xgGxze8d1kdTyNFYk",
-	"k1s5M7lY0/UeHydsTYsjLVbV4my7VAirI4Xnfqg02eCKWILQc0sszrS5ocll9lo3vHc5mXTLEF9Pdsh2",
-	"Yb6LuchuGlYVcgyg8iKmUut6cdCp2a6joYW+POPRPJQ0qbD/eHN4648XsSPQFirRcsnkYgkL8NaAKyFp",
-	"m2ZsYfO2lm7MjfuGJj50C+VQTIHptGZFLe07JvVbakdpuBJKmLuQcmWlXoZhvh+zMfffNN743TV13pBq",
-	"u2aGIZPfwkThhpWKxSV237xic1Zs4EYsdxFIL89dmGt7THd9jatg200Ab+K+UbDnDbSxKiBrHMqKs9/G",
-	"ua868y2f91s+6QzjWz75iSkxb9wK1yacCsqDBIVO0iavU9mhaYF+v01IsHkRZF1b42mr0/VUIBl4GVvj",
-	"4kIxlZWrvoPE4x6Ibm463stbz4YcAMwTbS9VJ7VAkBTThbySIQ27bDsoJmo6jcLCEYoD7Cg3tAvwqcbo",
-	"LD5/WluJmbcJ9BDEVp5bNthMKVPG8b6w0tAJ44JIhJPEVlxXAjMJUX7IuFRJb4bjIiV1fQrKYhphBQX2",
-	"sVqYS6IpZnFSPMwgGETmCTzWQECftFmXDVwxsmNM5xkRMyq5QMBHAmmXx068aitVSeMhauL76iu5JPNd",
-	"E12eYSqksWfFlE2QRj0Bb5f6/w1a6O1SHNlEO+d6B8nuFY0JwiOeK/Pe5HaiCn15rImLnPfEOU86sPkF",
-	"5am+KkWSxKCALdpPx4gql/5aCTqZEIEwsgNYFEAul/Y5q54m4wrlWeAsqpmsF3Ck3An3nOcCQUisd5ej",
-	"DyZCDCyLBMeIj9HhDNOkNDWajsNz9hO4giHKkJuxHD3m7JlCUvEM4RB6B8DvEHEXYiWGGzjVbikzod0A",
-	"s/M4ucJzCfnHswEiM8IQHis4CgC/G/DtNOAKmFB1x4MtC+lBTLs6MkNSRSnphJEYKe7jiQpPOvrqtcu8",
-	"5hhdJfc2TWxeXEhIaUjKEFBJFLUk3PXgwlLbLd4v7d7YVYSqJNbvWrc320i1LQoRXbN+bvh66alrChWP",
-	"EhxdJlQq98MEPGHA985kzt8Z7Pybw6eEYPDm1VcGNvth/QjoH/BEJDgHm/TvOVaqlg+lYpKvpF1f9rfp",
-	"cLd3f0ltyKKwJAwYH6KqQ5F5EA0IBS6NjCcakyqKW5ij7AjHRftaAeoWPT+axssBk27AxnrUS9N7Lmj7",
-	"ycXgTblUSOqbyqXdQYTFGacM/Ee6pHHB6IqLJIZrL2f0d7g7K+MhGhOm6JgSUXNN2aG/s+Hz/f2Xuwf7",
-	"mg6G+ShnKn+1f/CK/HUUv8QvRt9++9LLWSyfWGBb86zICVPMDV4X9VllJGnbPDHB4qiLW76+Mu7DnUWN",
-	"0jvb14qt8AHTodSfbymeu2Cx3QYKux/gFtu8pedUN+w6+9SwNVvYkRUbsd31fywY4gLdwu+Ochdygt0L",
-	"DvX97sEBcCh7Uw+lmL2Kyew5OxhaeIdmFcOD7vwK3xHHsnUXm0KBfHnp/bqJ1rFF3i2dzOrUm4xcdx/W",
-	"bkLgDRO+XdRSoQZrVFwsiP++chXlJrYMTCq6OLP3QsbL6lbWd6Bcmm8hfqibTj5YY7j7+a8+ygd+Ktvd",
-	"+Q2kAwfnbZnqt1E1tLrM7kV/gxKA/b7JPVcDzHfRVefY3FR/5lKqFMzbvIAdWIPxc92rvT58FnCLPiVQ",
-	"N4opfXk4TbHukGVj+ow+O0DGF/hZnj0boGcxv2L67xUW+u9wOBxWvLRyrVHrJmVph2qcn9aR49EcQTPz",
-	"v9C4loMDPi4tz1RIDobbL7OTkLdi0bR17avqzFuzfNcrPrfGySosnkP/WEndVIaUjjFN+AwUdW/wZiU/",
-	"UuluV3SB/Fw+DlHm6qklOni+//zbXS32fP9x/6+vXuy/2t//V7VqRvg+boiV/ySJ5/nDawjwOea1e5o3",
-	"5RNCD/IahGOQ9Xx+uzgPehViEwcZyoTX1cRVzdgbjC3FKZEZDngFC3x1UYDVSjAse7gFVecI7tbaNxcc",
-	"t4flFqN+Lf3VAdD+GilA9hyo/rbBDVUCE9iqrehgppRaLqia6xsvNQCOsKTRoUV6AAiYrv61pOupUpBh",
-	"bESwIMK1Nv964/jB//zzo5WpzBDwdXGMm8qji3Vq37E81rwCIZPUsciEsfNyeDD81rwqEAb5N3deDPeH",
-	"+zuVdNN7OKN75jRe/bljFUxj5KScHcc7r3Z+JuoQGkAZWZwSRYQM5qIpm+xR9veciDl0fq+p6ObzoKgu",
-	"BLM/39+33m7K5g3FWZZQE/O3929pxGpz2KtzfgpsPLhhq+ps/sOveh9e7h+ERinA2tONoO2LNm1f6Lbf",
-	"mmU0t9WNqpgEO1jBod8+3wz+rOHJb58hDx+8A/xmSeazHsIcWq6mew4hvJYBqN4EGcNyNdVc2+wrSoma",
-	"8lgimWf6+i5fFk2ol4lYWsaBXE2PzQvB7Z2hmyNwhDeV7dBbtLAbgowFkcYSzX2lrU6JygVDGDFyhXAU",
-	"ESmR4pe25G2UUE1GEWYolwRhLR5qiLiwQWFQUzcmAlGGqJJozJOEX1E2QcJE0crhOftonnxArLAvQLWZ",
-	"nKEGpzCF/n/OisciuwTTFuL+ZjSGBz77Geapg4UMVL5zO+ESDu7U7kxXEj7l5rl3cSNTfF1flXOdHKAU",
-	"X9M0T01GcfT85RRelnZe7fyumYETL17tmO4XFZ/LEkdKUepgP/WZbnxvbpAH0U6bS3hXQ5Eg8AQ4JRZO",
-	"uLpRlGCaBuBy6RR90DDpMVDdLlfL1fQQduqjhr+Jt+234Vf7t8kHX+6/bNP2ZTeeqdu+aNP2hYe/LrFT",
-	"G14KzMCQWhWPd5oZjGnz9djLOTtnx4ZRfLGc4gsqyFWzFqvZQvUJW3P7ixI5+TIAXbfGXKA2N04kRyOC",
-	"KIuSvMZpzMYO9ZwfS9ZDYiQ0U4DoaZKOSKw7wWKeAXE9M9SF6BilWEVTDb8eMJfinLkmtlJmE8v6aM/j",
-	"8TIsA4i7K2DXBijNpdLngRki19T4y9iwDI02IsS18iIqygPUmPN7z0WXoDkeF6hbRUhTUt6i6yJSa+x1",
-	"WqaJp6/fvkN0PEY8pUrjMRfoCwTVfRkgzpK53vPFq1oASROLqb6ViuJqLddaGB40/AOPPcaHnvWFhPDz",
-	"xT6K8Vw2A7MKSQ2S3/U91t9g69xgqzWE8kr7mSjP7bPiUruacpzSRvUvV9N/Tvlhenybwn/NurQFHW4T",
-	"Xau+TZb/7pnnjz08ckZPrxRwqD8blmX8fRz/tq6NxjmwlrgTLtlTYtzEbEEp50xoUgsgk1rAMgHOwPcU",
-	"MtuF7lAbBWmLLgLIt3h4vmSoj4bSX+5/16btd6bt923afn9ndgOLfGF0HgtCTGS2H5/fwHdAOCPGGmHE",
-	"Id85OxFQRs64Q5vwdoe9EsUkgic+OYAUMvYOcu0kUviScGN1OGdQOcR5d46Iy2g+ImMutEg0R5VSiKjA",
-	"eU0PILvMpSLp4JxV4LwyWX7ge4oZnmhptUTzduRjtqCnnxr9PGaayNkqqvhkWzTQxSmRSuNtkCY08sP9",
-	"4JI8ztchEpfH35FJQvDMKV0m96lzig4RjyEYSz2oA/EMkOQoZ1gpwrQa6N7MEJXnjDAIkEV4gilrRWZu",
-	"T3tCe/yEVka4h6ROixrFs/Najw8/aYHJ1ANq2+U4zYiQnHXr9auxaMjbfeSws6x65vj6WHvH2AUvWiY3",
-	"Y31HjkhClOakkWVYOdNStjOPWTuUdFYv6w5gkNPq0GhME3ApXOBeesKt4KiBUXZAtk96EV06nEHz28TM",
-	"1zw1ZpUeL1dyvb2xzcLgfbazVuSqTFHDx8D7XA0VIflBp9PmkSJqVypBcFo/9TJnK2UYjE2LhiPfeZsM",
-	"1sTkG//wbvctlmr3HY/pmC4mIqx4w2QQPKOH+N/z8/jPlze7+s9z9+ej+fOq9ucv5+dD/X8Hg+9vvvnv",
-	"f/33f/ohfJpcMffcrSd5AFnAwP8jj+d3iCc3S1jaQi9/7vTyh2ZHeGDi2Z67H9swK1eevHQrqN6uduCh",
-	"HrgFAyvEqXXvVEFnRHS6IY1vc/seH8we3IW8d0TGEIJmytff/Q37lZFxOtoT3KUICBipuDAh5gwn8LzK",
-	"WTIHddmGI5WXaRHdqaVCQRSCsZ0V9iO3764uZ3BEJOQAtg4aZW8DknsXHcCsukX5bGvMYmOaaLQZnLNd",
-	"9IvrfQqdz3Iw0w+GNP7h+vra0wICtcvvTTr0Qs/bVKIXpjq189x3Rfq+ct/BzvWuQ17zZrhEAhCGvAb2",
-	"H8axfRGCRwX7JOpIoXA6ck/7OKPQcOnVXxQP0/D2S2Lo+Exwrp4hLtAzDeAz4xpQdF6mHt2qcGKCGPg5",
-	"i6aCM56X3SBlefHcSyUCjwaXTaE+hiGxKZZoRAhDWT5KqJzCe+3HKZX2O5UIotpJDKv74Tzf338R4YxC",
-	"Ohr4F2lF/dW521H8/3DKHJkH5x7gOCbxReV7+Q39BU4Ms5hqSdmcY7Fg6Ahv9FXz4zdu5mOTEaRh5mLg",
-	"DrNfYYlwIgiO5wjXZi4mNnxrg2kxQ5BV2SRyR3GuZUhkkk/WpgS545tm1vg/Joh/QZJYTpa/sE7F9f4u",
-	"7W7g7d2mNCr9is3jv+/9vZhn13ZKKXtL2ETziOetH+ZX6lxnRMxIvPvj3F8aoLooiPWE7DMW6S2FW1zv",
-	"VarWnNpkimjwEZtQaczx0LLgZIojUzNugaRQStIRmP478eO3evDVDLkOw5ocuT7IHbPk2uTteDLszWqm",
-	"bI4jyJbrjNg29rNimHALvBimtDmEPIwXprlfnPetzZuykvW6V6vqBJszWt10V/HdohrjdhhtJ953KypR",
-	"mazIq5Yf5WlWvExW023hGaYJ1FmxoqDJaNVsUywS8qylir93UVIfXAKhLlq5CRouu96qDbu23McZRrKM",
-	"UkVQZsNbnAtz7o4EJ1hNuxz8ex6Tuzltt6aQSQWyariYYGMPG5Qp3lhso4Of1MtGgSvL6LOXYTXd+7OI",
-	"ibzZ+/OSsvjG/HSzl1Ur6XXUYj/JMkjp9ek7EMwZ47bUUyUxn/H3BU5HQcaAbJrgDcGd7DBAdGyysbk8",
-	"fdjcqDaRXzlVmDd6SwR254+aOAr22I4t6i6/Uha3b10JvWtj4O9GRN6N8BDTa1Ooy1xHlqYcLdk0fVr+",
-	"HScQjW0EPRhMi3k2pWb1oGMaw5nZ2kzDJXng5jau8kdDvQ16TFt6LiWQW6Vmm+vSCvsF7tgUp4RBmkvs",
-	"UjuuotW1JZmHT6kLW+ChUX2O9cwXPVWtdycyoq64uGySqN6bJnKVblTNJlqqfCMcXWrcdxMFFCVbmaXA",
-	"j7uM+LALfMQR2W7zl859j2Ytjv745LGf/fHJ0zp9m0t/1UO5lXsGRR5nFlv9AsVYYTjtpugOjULWDt3t",
-	"Grs73UrP5M7+Kd0FgAJ1jFjM0eA/y9vOrFBO8kip0bPxmgXu/eleN246h2+ZAsLK2DoX47W8YuYJWQy4",
-	"WkvO5DG5/XwpvSP8Xb71d8DPKCFYhPHztf4sjYleor9U4kUGEH9B4m+cN3MtjhC07BDiapQziAvD3xbi",
-	"rvLq29956pdFACdirRDmtWfFJu5zZJtveowd7PSQLf746PbFCstfo4hkvbd5VzQSuO5G1IhE0Li/wvor",
-	"rDOetQwpdnfUcIUwVYTf9tys52YllmW5nO5haYvwhNxtbJInCPhiceEB6dJRmycjPQiKqYz4jIj5cIWM",
-	"dJLL6aE0BW6eMko+ITSLqbzcFMv0GN2Q7EjP2uPYE8Gx7HKyKYplOLrEE9INy04uJz2SPQEkkxFme0Wu",
-	"CZc3vhHbCitCtRuKcDQlw3P2ushbgfTYjAiTFrDIWmofeSPI3jJxeQlHc0Q0blZKDkK0lhsR22n0UC4F",
-	"nUkmgbhAtn4dGhOsckEkGmHdxj4XO5udxXk2sWkt2po/ziJcLosS2RPGkyAMSYE6wgSh8cL4I0SSQvk3",
-	"iGqUBItoivgYQmz0BS9b4Njrs2M93lfBrdZ9fvnxsENrV4GvdYe3n973iH7niD6XgmSNzx+vjTxRyhkm",
-	"eqzouUqgOCumuDPsfsNF1Kv3jw5ZO+TgamtIqiSY6k1JPa6RmyVxeGVGlqocbJ0SbcDso5CGrT/CVkXg",
-	"Ww3ZKDa9z4nVHulX5l4DHFg3qdWarLLMoDa4q+xufaq2O0bLbeVpMzaJtlnavgY290ndnixjbZ3ebRmL",
-	"oT5BEY9vI30hyplH2LiEgkfwAMUQz3Q9b7rEq9m97vIK73PJPT62HUgkdxt41qehe2Jp6Dqw1u0lpIOU",
-	"Byt45wZZ6NYVG/q8dQ8tb10b7DUBjc6yJQiErTY9v0GDaiwkaPQgEFSyc4E8AAVAWYxmPCniI6WWD7Ts",
-	"EJm4W6fv2zQwxGUnAasC4wpuUk0rPBe1NPEmNlfCW/PclF5iXJ0zJebwAm0T05ep6m26EFuxSa8i9CBy",
-	"BAuzS+09ju8KVdGeRaluOCunuYJK5eEnsmmuoJh5kXgkjJ5QZYAhqXhWj7Q/ZydLyFlD0HoVg4wIyuNB",
-	"HUGVmJ8zL3JiiSTnzNbdpKJS892+7tlVWoCeyXPmcu7on5tR+cxtUVdcPnLVutpHCt+Jcc0s64T26t9m",
-	"pKN41kA2HhpYi7dvzNk1risP1eRM0cTWACn6X0wEjsiFIUBNH+Q6o4LEK0hEb8V9tif3KL8hyucxbRBs",
-	"PpoUK5CwQbd0fBemak63Yk7mEMbfgji+HGltAErIjCSBmGr3rZJpzJaah2isncHOFRamTiREc8ZklE+0",
-	"AqpJxVuFPhTxXbwtyXxknmwkpMmwqw9UAA1Ue9S/xXlSLSkfhiAThKRZPQDS7AwdQwUuKl1du2EAEjuE",
-	"vyImFNz0lMT8/LAyS9xLHborscZM7sV5mjWnkasmDD56f4b+4AyslprZBqyPhlaP3p/pAe43v39/9i/O",
-	"yCN2DeqKFJAuM4gRWo8nrMqvTX5N2YQIP0GLOzCidBGk39KUtnJYA+jfQPrQ1s1PSZbgeevmr3E0Jbec",
-	"FlGRa2UO12s2bSISgLHBgtMx73DxiuEuuSLdE+TkF5BGdQrFl20N8t4K35mMpyP3pepT1dryZI9kOkJf",
-	"9ABftKD2xU3ypVlGK6sDbMm201YrLibuTUJfAbcknTRZh+iEIVwpnxFTedkOjXTXHoeeBg41c6ez7fGm",
-	"s54zPSGsWmmA2xJObcG61aPUQ0CpK5o1OKb/k2ZkzctOd+1x6JHhUAJaMxHbEMndWGswqre2613L5W7e",
-	"Hsu+GpZ1Eay2gGFnPX49NfxqK2JtBbvuUM7qkevrIVfCJ3sRZ0rwpDlrWR0/3vLJa9vrK2LJ9lO4l+uC",
-	"YT3G2DOiEF6itIRPzLumIa9WKd17jN4Yozsi7/aQ9quhH2TRssjncK5HuLtCOFvfxly/CTHlkusH9Cu1",
-	"rnn2lGwX37VrApisP4wd+Vb8LjIau4cgCw5SHF3SJAk6GNC45lxAFUllJcc9ZYpM4LXO/YKFwHOvl8Ft",
-	"ufk/IleCgf8p+GeiPKhUrTbX6B1wqzjV4ENjCkFW0S1Y0XVtl5p1X3Fbdz2l8e36RdjTWeW2/6C5phjF",
-	"ezhJuNmgoMtDp0JGE0cUYhTbQH6UUsYFYnk6gpQALEYZF6pSQtnAUIbtWx//UGzK0emPR4cl3PfavaYO",
-	"6lZ8Ku8wqKOhTFYYpZai6zdApzFR0RSNBU8RNn4T2KDWcuwzGgs8ScM+WQ5z7iwQWk92anNa3A2m2aX1",
-	"nrtB7B1so1ibSz/ZGiN1Y/BeT5Km7Gj3ATtvp0JifXWntqa6B0+PVu2kvj3KOwvRvvLhHbBzRiK1rUKH",
-	"8tLRzZgLhNEXPQmOU2Tn+YIinqb6mMk1iXI9x2qSAQC/Bs107MNj4s+E9XiDre87emeCpljMbx297Tzd",
-	"0fvEAng/BJYeUb8WokoScRbfBaoWM3VH1rMCyB5dnzC6arU/nKLCGc5MEIVtHNLYTD6Ie63jA4h9nrPW",
-	"ySBaVthuys/nijnf2fvmXda+viU8dXt2rEjqw9QJUWU+AKODDYrqeZCIzlbOfnrPUMW27CE5i3YGnt9n",
-	"8G65/Hs0nnh/l8Q/Ti7FlujHuaaMOG/Q3n7kNsOaLXzuBh821pE3uXZ138dGga2fIA6T5CzBs04pDt9h",
-	"qTpmN+qeuB7eRtrP0HUNZ/lIdsp0/xFPurTmd8MF+2zRG7G67bKo8rnez6RsdtQ12ZTp/WQZ1R2lYO8J",
-	"69ZkiJCsEJItmAx92b500aHU5Rqke4eVL5+ujNFZAugZypO9qbPJXp7FuOmy/gTfa/5sE8HzDEmiFGUT",
-	"CfbGNRnCyc9m+J4l9GpHC7Wj50/3gj+FBZI75FyCz6i0jm5+znXimni5E/qnSdmJFbngLJmjAucQlUiJ",
-	"nAzgwaV8gimmJLFNN0ilzVoYt2J2Bcg9t+uQQtqUBDrlSTLC0eUtFlJ7C1l/nhoj1oj8gSXz3mbUc/qv",
-	"ys9Xxo1PKCTtwyxGgkBiLWDsBVhQyzQmNsurdHm7AfpLMg/56i0w6dO7DfbtWTTpRd+ee/bcc2Pu2RSx",
-	"fiR4ZpkmnLm0XFSzVGF/mZKkcCpyPNOFcHh5bHuGeofx7T0/7flpz097frohP83ldM9VsN2D/OcNgulY",
-	"EDktS4wrbgvjJiYg0md+KMvjVgNM27DTXE6dm+Sxycvev4PeK/LsSW4tkutURWqNp4a7zhLWCyK9INIL",
-	"Ir0gsiFXzBseOE5z79MGUlhetmKJef8U0YXAId5VpF16iE61LHuueStcs30BfjaTPZN9cky2XTFIKKS4",
-	"pvC5di3Fp8xue27Yy5A9e9sCe2uTLHldxtbr1L1O3fPDnh8+NH6oe8Sj+RpsEVFwG9S9Ucrj9mzyzE7Z",
-	"c8ueW/bcsueWD4Zbqlyufv70cUrTtyWD1LP0j5k9gT09AltZa2Rt5ax3vLpfFqd3fEY6WaR7IaPngU+B",
-	"B85ZtEfZhMgGQ9UxfC89p2ZYQDpZiQSJCJ2VOfH0qLOq1+qcRcgEuiIzYyv2OWeRmbOXS26P/fSBoD2D",
-	"WM0gcrYqM8Un22JdYcn17wWmPjtFT/T3hOhbRHl/KhvdkzjvCkQ9M+njtbcfft1rbD1v/mq8OUoIFmF2",
-	"/Fp/RpghIgQX6C/nO8Zrf4xpQuLzHcgWZCuPfYPoQnihy08LbHdVfCFM9URSBvd4fitpexsy2dx+Ql+T",
-	"lHlvTBMSTK5+SlQuaoKNt5wOT5Gbf4iOx8U/tOTCbEbghEc4gS8DFHMt5VzPA8W1CgqDud5oAJ90Zm4e",
-	"KaJ2pRIEp/V7y8Tu7bzaGVFm6iQs1k/0XVKDnSnILjD1h3e7b7FUu+94TMeUxLVhY6zIrqKpOQClJdSd",
-	"Vzv/e34e//nyZlf/ee7+fDR/XtX+/OX8fKj/72Dw/c03//2v//5PP4Q9K3kIGcAjziRPyCqfFYzklCSJ",
-	"u1w1TmPKiCgtp6YGSMYlQVQzB8HzyRRhlIsEqSlWKMIMjQjiGWHGqorRSPArSQQyxUWUmu/KKRbkC4oS",
-	"GijTV72sXczqa7uGp6oYdVMPfhaEqI80JTxXnfQWrLyBDAcegU0QrMXpGk96W6kiek/Zxb2srrLMWrZH",
-	"+oaI9xIeLsZ5BkmRSoJP+ESuvOFN27d80tNkc+u3fPKGJwm/atn4LWWkVTiRItdqj8wI88sYK4rY96Vq",
-	"vp4yvJoYGblqQYZv+eQJOj9pgoLy5S0b/yxI1hNqL4J/RRG8yAnTqLWHK/cZfV4WgjlhytX1t9V6SWyU",
-	"eiztr2bf0YjH8wG6osq4Wuo2////+/9JlBKFY6ww+otUWFE25t8gyqIkj0nsVIBiECviDdHHKZWoYEdI",
-	"/wPeRojQqqfuaYCSGYlAKzVA6d3RjWdEmF+xtIqE0RLYcoKbFSYGpxc8RiNDewGksgkbdAU55n5ZNn4W",
-	"PM88WsTgq5s9AIITItIQdJ8kEfdY/7mPQlXX0pKdua7LxLXKVlrLroUUHiXEsdnF9+F27Okxptq6zTe7",
-	"6r71cs/XU1D0ecR5uwcG13YTejlz8/W00ppW3J7dfzp5IDa326YphVWpADhLfF3uESTBis7Irh7LJ0U0",
-	"2crBKeTRvrqBlvMjj+d3KJbePKFS4i/3v2/T9vuHSaSbWtw0bdyRte2embdWt9ULvAd2sEdeLz0lStAo",
-	"XE//RPDrOVIcXDWeSeQ6IMLijFOmBmgMaEXZBLlvIyxJjDgrrEjimUSnPx6+RhOBmZLDc/ZzDtEzXIt2",
-	"RuArbjh41E2S8gepfxkRZb1kZT4e04gSpjRcOIIqcFYwtBAMz9kp53Z8KhEjuhEW80qPGJOUs0qPEIG+",
-	"s1u0KY22xOQswXRBXmt5qTwp5WUVYmd6q0JYjeWlqSqgONINAd+iJIeSLvpDEz6c6JG3jwwPSwa4N+e8",
-	"uU6px2o47q0pkb3Wdr8QR073plyqSzKXrZBHTlGWjxIaId0N6X5Imuz6GSEC3JSUyCU4OKaIMkSVRJeM",
-	"X7EL3UPCq0UTpp398osDqL9sHhouXZJ5RzS6JHMUkzG1Xm3Ah6Sc6p/9eEWVwyqcqykX9A8SXwAersas",
-	"X8m8R6oHh1Rw7mDYyT1o9dFxmwWskvpqA9wJyjInuUMMGOQryzOP/STnUpF0L6byMsgi/kHJFRwltArR",
-	"MQx0ZFrcX2lEA9hLIl3RY+Jep5vxwzRrRJCfbZP7iyEAYY8iXVFkikV8hQVZjSWupWzGlF/cgPcZWRyQ",
-	"Pb50xRea4TgWRMqtsJXjk0M72n3GlgLKHl26okuGo0s8acFdXMNGdDkpGt1fZLEw9qjSGVWEPnk1b4Er",
-	"rmUzspSt7jG2WCB7dOmKLhKzPcqoolhxsRpnyqaNSHN2+P640vIem2cP3+vJCmB7BFoHgZz3SjPuKCwm",
-	"RMmVmKMP5CEgTY8rXXElt77SzXiiW63AEnC6vs8oogHs8cOHH8YfIIgFetPg0de0k0V4unkDDpjSP5jG",
-	"nVFCI8QHmBont4sQBsIeJQAlLA4sIkXzPVJ5qkk0kvCx8y3R3SRKsYqmlE2M2Z3YotrkOhMmPRea0Blh",
-	"LvcrhCgVmNCIVsbfaR3UuguUst5Yj9JPqglPap63chY5t9vYlAIJZ7+wtUIAC66mPCFIziLEBZI8BdcD",
-	"qmQRGRKoSnA2i+ww695C3T1pbzWDxLby6/a+MgEkXsrz0AKVCWvG5J/YNhDZjNLjcY/HW8XjWjBE5VIP",
-	"XLJ3h3/3La7HrP9YkfRR3+KFP3/xTxO3X/zThOuXjUmtcT04vxXSufzAeMTrhS2X2aA5A5s5FJo/XXQU",
-	"0ZRIZTbo7znJ73sO1W5BL9+1afvdvQyQWY+OXO7KWyCsmCREkfaUdWTa96TVk1ZPWs2ktVzGopm03mxU",
-	"lKInrZ60vgZprUkcEzojUOa1NXn87Hr0BNITyH0mkDUpwlsApZkkTjYtPtLTRE8TD+jSyHIxIe3qAxU2",
-	"U8iAbbScSpKb4Tk7ovISPo4rFlY05UmMYqzwEP1IrrAgA1SpTYRymeMkmdsBTd4+aH3OTnIxgWhXMOHG",
-	"nJh8/AAztJvx8kU0l2UJQzmLQim1a8QOi+8JvSf0x0/ogkApmfY34antcP/Jo01OnI6Ok4G9AOrQE1JB",
-	"YpujryfQXjpdiyI70uPZA6HGnhZ6WliDFupl/FeRwvql+XtK6CnhXlPCFVXRtAMtmPa9lFZsRS+k9eS4",
-	"NXJcXTr9MEn4FcK54ilWNIJanXxGBOJjMFtA0YEvvMAS8sMUfxmeM9NPTQn6PeciT9GMKwIFPtUUCg9C",
-	"lqeylavuaQBDV1PC0Bf74w8ayb9ULTSCoJhMBI5JDBYZxhWyKiAeJaSNdWTTmu79TduT9gMykHSul163",
-	"h14SkgUrjd6CbbQCicdEmi/Udd/QULqFouw9N+i5wUPgBoZuV3vmmuq+95saWrt7/zTDSY5Vly7HaUaE",
-	"5Kxbr1/J/IqLWN4updpZ+rCyW/figgJDRl1dCCcyz4OSwP0h9bUmiYILUP+9tHjg4hiD5bk98Rl6wkdI",
-	"g2bHZIcen/SWyk6VbdUtU95rnqZUqcd0Mz4xT8vt1tXHzOQJNVowRjHJEj6H2nq2Ig56y/mlVXuJbxwr",
-	"wZYF+NGYCqmgUv/ChynW0m9ZE6FWhGdl3f4qT9mkfkhfg7+vwf9gb/MVNucHRR19rZwnVivnlmkj95FG",
-	"3lNGTxlPmjLWki+dAtglr4nMs4wLReKa+mimXS3SFaaHR6IuCjprVxyrUP5AFe/Qw6QAuhNTzREZQw49",
-	"zr6O0eaJEWGMFV5FeRhJJfJI5YLEBQlekjnYcGY4yYmrYiUbFaojPdfjoLlfyRxAuuVs9FjhX8kcshc9",
-	"Sc1mI8PjIZKUTRKyqwRm0j6WRzzVsgr8Px8jHMcDFE0xm0DxNhvKUOCvdDaHSzLfBUxHUnEB//YXpyhN",
-	"kvcf22/LGUfvQRV1V/vgPDT573ZeyF4etIHh4J7SYfd7xxUeKtMkeF8OMNw1YET0kKKPCk3Hkgw3qCB0",
-	"P++dPiPTbdwjK2QguEwAFw36YeOF4cBFIx7PV8o/TwIVb83+/LAMAPdXYPJ6NL0WBAO7ZeQK0Jyytgy3",
-	"NAs/Zhy/A1vZIxOUHrRAM/CXrntttAXwpQOq0GoEQ+SaSkXZpCvl5D3h9ITzuAhnPU1ANqc8t/QkO9DW",
-	"ouAln67Hqt0BZ1LtnW/uHM2dp/ceZWPe5q3DdUC6Q1n3u0z9X3i3NFtdT+04x3reJ0sA1V24/96gD8or",
-	"rSslbFb3XuN/xd2sHQ1sWgn/4eP/wymz/1BxPyMMZ7QpXODsCk8mUJdno2O20q+t/XC/U2K7PTQVvivb",
-	"lXGeNO3VCefJOvIaaB+6c0eFBUon2Zoot1yKj/NkFRU+YJsrHGz9nPdmPMlTsuq4/wGttnDot316BtCn",
-	"c4aCJHi+lxIp6yVWl07xVDd8Z9t1PUbo/N5WRGtDudDhtSl7dXzUuscnSQS7A3mzshWPE0sALVb4Ci9g",
-	"xG3lfli12xpAhE1oQIwVlkTZqAQEq0BTgoUaEax2WiaMWGVK2n9SD20OFeocQyqs8rBZ52eikGUq0kn2",
-	"0LEemGygjCmbuEwIHyHWY0LZXoalvOIiNh0UR2OioilozCI1Th5YGFutxKn5n+KoYZqA2gAIdWbgX4uR",
-	"ydb86JSkXN0FNzLLecTX1jIWGp2/+cqyAfgblkZcfdj6auvS/pTGd1N50W1BCDMmRJXGKOO0OyhzkLAY",
-	"WTp/WgzPotbnm5ubm/8TAAD//w==",
+	"7P35chs5sjgKvwqC50R45nwUJdnumW5/0XFCbfWi0150JHsm7rT8k8GqJIlRFVANoCizOxRxX+O+3n2S",
+	"G9hqRRWrSGqxVP90WywsCSAzkcj1z1HA4oRRoFKMXv05SjDHMUjg+q/jsx+Oz0CwlAfwDsegfgtBBJwk",
+	"kjA6ejUK+TRE3DZBVLUZj4j68nsKfDUaj/Rvr0b2E4ffU8IhHL2SPIXxSAQLiLEaV64S1U5ITuh8dHMz",
+	"Ls/OQjg5Xjd/wCiFQH1ClIWwR8ImaFgIl/prKwApx2ae6rQx/oJC99U/ReFzPgd8wXESqc/fiNHYM+WP",
+	"S6DyNQ4Wdq8TDgGW+X5VVp99RzgiWCA2Q585JBFefZ6gf5IoQlNAHGK2hBARijCapTLlgJbABWF00gB8",
+	"oCEoQh7CDKeRHL2a4UhABvqUsQgwzWH/iUQSeH3HIiKkAg9UIzQzrfyTZx/z2YmEWNQHNS0RfEk4CLWe",
+	"V+i3K0LDT7+NIzyF6PsljlL49F+/TUIs8ZcvX+wPF+pU8rN4P/03BPJcYpmKj0mo9nOcYLn4fsZY/ZSy",
+	"HzDneJWv/A0WUv/Dh6dyAYiEagPUvyIspN0JDgEQdTrTFcLqL4vCdI4E8CXwPQFUXlDdWqAgIkDlBJ1I",
+	"tMBCDyZwDAhmMwgkwgJ9FoQG8HmMrhckWCCJr0BcUIUnEAINYHKRYewCcAg833i1gj29hL2T49L+zxiP",
+	"sRy9GqWEyr+9zDeFUAlz4PkunGnsq2+AwUoNsOI4OFBIa7ZgQYRkXH3DEgUcsASzsiDlXDUIolSoc1aH",
+	"KEBOLqg5eLVJmIZIQASBZFwgzAHhJIkIhEiyttkK21BBPwPpRsh/rrbet3aRxqChMTAIyQHHCM/UqvKf",
+	"r4lcILkgApFwgj4sAP2bpZziCMIMAZJ0GhGxgDDrrZvrlQs1yBRmjJvJIrK0Q4vJBT2iSAP5M07sfESY",
+	"LjPChURkhgSLweKocD3RNXD1b6L28ILOOIuRzEFr3keNh/2x6A2JifTxj5hIpPkAClhKZcOsup2f5x6O",
+	"cwjaAGDzdUwsYvNdsTCMPEyswLzKnGwymZQ4lyDh99/hb+HgJfxtbxocPt97+QL+tvfti/BwbwaHB+E3",
+	"L/72AvDfO3ExtXAWRezaw2j17wap2Fw0rdr09lyqRUp5w+Y/c0jadzcGIfAcUE7oCZYSOG2ae66GLNNs",
+	"eZtVg8Iml/cxZGjyX94L+Q2bvyEUhJejMS4NAdI0ngJXwCeKtWv+rpYBVHICohFXKYgS0B50VJLPez0n",
+	"jupAKEEm43+V5TUJPg0SCX0+xn98D+mhdx9OsVzUp2f65uwDgLpXW+XAwqFMD8fXMP2vRniat2VjuDaC",
+	"QzTjsgVEjS7UjSSAhpqE0IzxFlBEF95RGLzMFZbB4RiJZfC8E92fQYRXr80d2yS7uCuYhCh7MDhpRkRM",
+	"qg+M6j85mOvTK1eaYYzo3fktMB592ZuzPTtGDqmDXZEI9T5PFDzUft0KcDdIzyeMBu8MYiY9wJ3MkB4B",
+	"ZZwEkNBCqAJQQ2MlPC0IIuLEP8RmE3QyQ1oaQYwjyhSuy4aRCkNAPIUwhNCMPmmUgDTAa/i4XttHAdy/",
+	"9XZ1WkAzu/t7ChqHnODKGZNozjHVgGPTLGP8Ts4QCQRkpgS6VAA3gKMEc0n0O49QIVVfNivP8kzkjZrW",
+	"mTrgOxxiC427k2KI0CBKQ1AvLQOMSBgV4ATXxu2uypsZva8h3jJhWDgVxCRs5o3Za7kHd3R9GjjkTPzH",
+	"4ZgkXgZ5xiJo2TycEMRZ1KQ2sJ88W/OfHGajV6P/2M8VGPummdhXc3pZHaGKX/8CmMspYOl0Gnpmy0a7",
+	"6iva5j/GEDNaniaf/ldCw4ZZ1eN141n1uPk0b4iQQIHf7iJLs+STbzNpHYfyMUWCg7aBzfdu8oUEIUfj",
+	"5ulYCG3L6HIjlHH+Q+EiVd0VyzD8rMC6kGQT9Pnys2acnyMW4GjBhPyMOMyAI5lcUHermRdzpkEoDDKp",
+	"aDiyYVrWewbLhqVyWPZdJaMzMrcaMMRhSRR3QSQEKhUz5xP/24vQ/1X0fxRF5xFegsgAqr4tzddmII4C",
+	"qa51HEUIaIATfU9gGoAY640NGX0mETat1MapzckaqbewulOxuIIQzQyLjEhAJESrAujF69CBrnjNu2Zh",
+	"BJsXNkZTHFwpYVBIxtWFZ5hUq/K0nUT09K/VxvO4ad8C+3nN1e4G44w2jsQZ7TjMMUQgm88y1J+7yLsf",
+	"ShsorKZXMmSGGGsFCkslmnK1uVKUH3kSi6v/SOk1phLCTpKxWwAReBrBGYsidWqNCzHNLrlr13F7OFn6",
+	"lA1iwa4Ro9EKXcHqmvHQCnNEoNB0aVA8u4/+m3oCX+TLBjag4fmRLhvPCuiyy0EdUQR0STijMVCJlpgT",
+	"tTPmASSdeKTOQwnlMaahQPAFglQfaMCohC+yfHhv/69/HJ19H6+WOOpzdD8qvQmW0Lgg972ZlRyD5rxA",
+	"AxgjEbDEyLQBo0uwsrY9IMTxNVIDQjuP+InxoBGiGavKWc0D/cwB5AcSA0tl03hz1eZS2kZerabPGFEW",
+	"LUsTFQD45Yej+oada4ldqbMXU6zPPMBUc1EK12gaseAKhUqhCKJJ3lxMsR+Bvzk4OHz54tuDg+cvXzx/",
+	"+eKgBY9P4gS4YLTl9EmhSfuFpq9bzXuUmJ93Q9cLoMhikdZHO2SYoHOQ+qdSc8uhbA/4Xr+ROMiUU4Ew",
+	"+gGH6MwKAsA5K16U9SX+CquShNLX5lahWvNOkYxrjHbWvHWzizXTr+cWneZtf/sYQEqwaZbZBNvVdTfI",
+	"HGVLZs1oZa4EdDnZ5EZ58/FdG91EbE4CHKGUEul0ixvRUZQ22R8P2072jTEMNexdZL52Y1FvsZDNQ8Xm",
+	"61o5ri6hFfQaRDHmmlBXFvu2kOjesiV8YI0rYEvYk6ybdJa9YFoUuoVHTBNRue9dZmQhnNt3vk8r3qQ/",
+	"RhG5AvSZ/nb4/MWnz2P0mf6X+m+8MtYIfQ+n8LmrlrkRvveJ38CuhZ/S1eruYG0pdbKfOnWWtFjhs48N",
+	"aovnbWRwyli0iSifMBZtLck7z4efSNTge6HuJfVQM0DMSARFpEZigXluVzaDWcnRPBzLWjnFxIThc/o9",
+	"qT8rxqy0jbtz6vCs7oyE/sVxEhpIiRIPE9BWXMnUv5mAEvyhWb/ajiZgOQk3hNUHX2FPPeTTCkKHKc8B",
+	"y+bHr/7oleQOa6/q8jVpxi1NFLQQoEiThHG1u7UnSPmFb+mxYdn5102o0LGvZp7pDqBx+uxzp63XJ2gM",
+	"If7hdIOq+1Bu3E5J2L6etqOV/eQSloA9Aot8yjFAoIv04OBFcHWt/w+/mT8JDeGL+eWT+YUl5k/zl2bp",
+	"5gfzlEYsMffA9+j/9z3a+74u+wCW3894SqToI/100O102oVcNqjoeApGg+lKCw5q7F1qfjosUmIJ72m0",
+	"alynanCpHvgdRanzdCqg8Z0nzNdOOP4Bz5uGkXjedQw+B9kmxUrdYjPB1fRtfAMeHHz39xfffHv47TcH",
+	"337bQmvNcltXke0jFS30mtKOFFtUXRm5NVNemXfFrpVXN+ORMz9pcJ4fHKj/ad0K1cem/aQCzTz2/y3M",
+	"HdBN83/K2TSC2MxSXuf7XxUszw9e1rfgHUOv7ew349HLu4Gn8J42sx7exawfKU7lgnHyB4Rm2hd3Me1P",
+	"jE9JGAI1c768iznfMYl+Yim16/z2LuZ0CpJMIaVm/u4uZlbq9YgEZsrDOznUH1i4QpIxFCmWqCb+5m5I",
+	"54RKUB5+6Nz4DvzIOeNm/jtZ+Ll52qOPFC8xiZQCWTNm21WNfMSnRHIsGTfOu+q3hLMEuCSG7Yns9zYo",
+	"bO+b8Sjlkdfufg1kvpANLlr5m+I3PcDYTZv1+5TxZ+Ozo4bUlqITCXEdaudR0cDkfddVEYaiAq11ZtHZ",
+	"op4D61HE6Y/KEFxfSb/B9RFcWfM40DRWq9FfC+toWLSZyXb3rjoNiTyDgPGwDicOQw5C+F/6zjJr33uu",
+	"recSxrL0FAixhD1J/OrMsDG6oDina6WMJhRTJiBgNFSTr/VlHY+0c43o51FChEiNwq7WNAa5YKH3k9b4",
+	"NLpfGTUdnRef7L4tsYflHafg7GYlSyPdF4YcK+kd05VvaNvkkvjh5yyVfpLLOUh9f4ViPjBf+SFWMgBQ",
+	"aRkjyhp7oJMNwrQsmx76LzvlxLuqVHjPuEJTWI5KOzd2mj3dvbD+DNXGo5w6LLq4zTXAFHhjRgBrqNXP",
+	"I5Vqai1jyQfxcpbKHJ/GXpxfO41Ssr5V7ar7Z9159BhjA3GHtfZgnJUF+Hhz3mQbDl2FsH039UxrObbd",
+	"HQOAf1/k4igIQIgP7Aqoh2vrj5fwJVFjXvbhvrardAOvIYTaRJURmsA/hkC7vnhgV77yEPqe/Jaa/OxA",
+	"f0K6t+OoS+BT3xoT67Mt68wQWymt9kmPtX4/LPDj3J9bj2j7N+3GCZ2x+k4YPlFGRodULAGqGc8UCxIo",
+	"beY3B9+Nxk4J5yXZKgnYMWrzGmfaphuh8R6syh2m3bgw3KdqG7fCpn05gxkHsWjAcm6+boTmrm8Lnnsh",
+	"ykDBUfR+Nnr12zr+UKbUm/H69qVF33y6GY9e4wRPSUTkqrNI7JN8fbucD735bVIAz8P+KjPc111SBqM7",
+	"h6+A7yGjvMUWF0kVvNaN3NE1YgMGzquCXKEFi2MiJXiYBBGXwQLTuZ9T13hB1tgLiLaZnFlvyPpcRmnk",
+	"5UbBAoIrkcb+jxyw7MkYSOgXaZ0gX+vAkp4PCUH+gA4PZs3dCyvIZEy7G2bmwg7YkddvcE8CKPX1EkCp",
+	"xTZE4AHTRwj1+bYnhuN3501v4CDCwn+0jjvWPjQrKmTkx6/+GoyxBcwM2sL6jt+d/4tR6HwM+VZ4Dlsl",
+	"HziKlN+09NLqJpcxCUttvYbCsr3WBDnFhDLu386E8S5aKd3MDTQeleRY4mdWagMMBjbfltlSpivpdxUr",
+	"AtF8cJ5wiZrsm31GxpZnTSPo2eGEf3mmrUuLrIn1YdARmc8W0/84fFYwURecoCb8i++gyqEN3cUg00/Z",
+	"6VZCQpypFX3aJi/ZVI6zSfpW3W3j+n5+qipSy6tB5uPUxti/T4Ce/+M1CnUjFLlWwi1CB5LB+IKafAJE",
+	"uLAmojx61bYXlTtHpycq9qC2h/4zzWCy9J6fzELKZI9QkM3Hc+qT6RP/vVYlhyac956fxyPY7SCu7l+2",
+	"ba+MZyiR6BoLExBsL7nxBXXOE8pYT1FqU06ga+3rIkWW+EBvvdpyZbhWH0hoIjsqjDsbrhc72lpsaGFZ",
+	"GnK/5ikJe85aObzyaiuyQ2H0sREtDCT+05b4V/C8NRQ3Ex3427jPq2Rsh22BZAuRojBCozxRnGUHgkR5",
+	"xh5GjGYRM1P/1j51FCat6GgHGrudbxQYa9u24X57JQjTpPeY3rGIuPJcw7BsVPF0JdSYhRA1KInmhNHu",
+	"4J/p9m0vgfX5PxrlQ6VYoiHroo9RaOt2xs6d9XbrzURLt0gvchBxtbm2QPX2UqEb9bY0BBo4O1Tbsnog",
+	"pgO5ATO34VsZMA1btStulQVZ7VStZIbdAkkMWL616y/ifjElW12PA836eLFFf90GXwogNe/ajpDGpPWy",
+	"wNaC6LVvVdZES2nIRbULAUUL7ZRQzL32uZ8i+NL0yorxl3L+ogMfw4wJLbV67muU2xdzL7rxustUjTzW",
+	"UGQD+HbpZ87SxHOcPkHcdwN1I0FjfWyiQw3D5mRoluDBp3zc+6LBDILuNJID7aFA/XELAizA07RfO6K+",
+	"XzAPrzGHXoqqIpH6vmfXQHdjWTeNlRU3igDkiqssJLfRJcctdnMczrbLcyyl0e8Lk4tAdMe3EugefHbf",
+	"t0DpMmAt27crxHZqqjMmsYQze5U0sdC++sI64/QBcXJ6lLs8NfpC1RBlFuF5MStpzSRShuenCM+P8+ba",
+	"rVrOvCPHOGj4XVx5P3SjSzVs0SmlsgALkJ2mhUCz/dqcQvMt9+BYefz7otESFN0pqAy8h0qzBluQaQU2",
+	"3x4eF2fZnlBPbFCI5wbKRLZWiG1/K+Dp1zYlNhKzS8e3tnnJDa1LR6d5vmlZ1ZHWhyurfeK1fBbinHpy",
+	"oTyEqrrlhTHbNrxJIsZJsoF1dEGikBtvhu7Wy5AnftccoMsGzghf1h1PQdpXrDDGc7gMyRyEvNRL8M9o",
+	"2iUckjSKWpo45k1MnNxpaevaRSWT449RiQkFjvRwecitGCMdO5Z7HmapjC9o3ktnelM5PVxkpECpsIaB",
+	"WKutawceY0IlUHXml3GTWrDY6JrQkF37mxliucRZRGP306aFDIK1xowHCzCujusO+H2hqZYpOfR2wG0U",
+	"RJMIBxADlZcJi0iwWutb79qfmuZqCBWZ7B2bw2V9Az3NCOPWP6f+yszOvQ0R21WIZoCcRmoYo5qGadTj",
+	"3ji3PWqDZjuuk7ps5tzQwQlbSLWvCz/hmqi99WswzQpLYAmL2HwtDnxw7XZheVHst+SIkbFWwy/HNj9Q",
+	"BZG82DUuJlArUljmUuiIx4P4BUQsYl0RO9yp5ltc2LSKncidUO1KstdadqbmdprYYyh83SOxM90a8h3N",
+	"iVyk00nA4n2WABXLYJ/FL/aXL/YDxmHfjTW6KVx7W4iW2XAeqag4+qaCZSaRbOGaVgSkh9hXBN8nWtrv",
+	"20iWJcBatrCbXLk2EiXbTJxsyimLB948vj3Y8pb0N79V1pdb19RIrQvMxd2K/FyQyWq95xGb4ujSZHzw",
+	"QlpqcWlyfIj1Y13254Bj5dO3wJdRlhHHI3yJdZ8TDjoBb4O3t87O2LbeYoONFuEXodoQ7G3e45+mQ03E",
+	"ujQp23qCkvP6/HHR9ph4X2x/cuwZQlyG1u2rvrUFCayGGzsTVwrPtNok5VdUx1dTm/+E/rIREmx9/5cJ",
+	"s4W4mii0SCsVyqpQQTPOezCoCSPGlVwd0uZ5q+5gK2JXCLgsb5QGyQWWjL11lSgcBu1cpDiNsDdupllD",
+	"DiLwk1LjvdH4YBES/E7DOXnUsmd4dOrOH9gJjhpEN/qaHTadkgjTyYetdjcfqLS/TXHHOmS5e9hx0KS8",
+	"CDmZ+QKBeKpTlegowJRSXS5IO4vpXFB5uicymwEXJps6kSJ3PdOUMkbCFMnJ0p9QgFCYESTmOnmHCu5e",
+	"ZW/9vDMyru6i+LIv6UmC7cWbfIdqmDPj7A+gfa/y0k1cLetT3mPXVFl28/0mwmXWV2nsKZNoCpD58KEw",
+	"1Rki8QXNnVFDdk0VSChgS8hSchWuZJQAJ0y59mmfQXUg9a8IaCjGxdT+YsHSKFQVvlJqww7GF1RpYTLQ",
+	"r20JMGHysOh1ThoOTPkoXupT73ujFxIzdUN3tQ846tFBLUikHNanE7DtdB9mPOchXN8tb7pLwaAFfS3V",
+	"dveuMu11BJ1XhYEj8Gtltn/2a15mmZQj7CIB1nGngBT5addu0uIJle9VtztuYaVVdL1Uz12g8Y7uVJtT",
+	"9BhmhGqU8L/XdSU+6Kn0CzANiVph334mC3GDTTtjcM3f3rcYy02LD/ClaYRE8aSeenWf8TBvnrmneL4R",
+	"ugBO/LC4F3t3QGJCSYwb9OksaVGBWqS11FvvnNOO76tSS+lcJv6v0CyayaaDUD/0OoaaVcaJVoV0mRbK",
+	"IopZEMqYkZ9Z9vuoil0l/M4RZ+w0R6U9desZZ5SUH30JfwqLyI/Tp47wEm539ZC3uw+lag230Ek1wOxR",
+	"Tvln3d76acf1MzmXv1pDUhK4qd6K7Psl9iOkTWC4MwdxNV8XT86Kg7gDowKxG2/NvvQ+zjWYsz2+rMOS",
+	"XeFGZXSxDNSe6bS3wUzf26B+SQUfjUdUmN8C9b9PDbZj+yPFMaHzya8Ggs1vbjOOK+GnEs9xFqlcWvX9",
+	"jWAJUek9MCJKzBpnywthms5HY/fzNeZUfeVcP9lnWGo5J8FUpyigjML6PTazrpFlctBHrhZhmzeqbbCh",
+	"L2pd5VfbqrgxzY+Nf5rCAi8JS7lKXOSCwqzGMd9PnQJSAcUB/gCvUUKdaSa01qfT76nCvCFImx44mw+p",
+	"IQyCdXvHOOORf0b3tZgkO9Q8t7rK9nOPLQfK1leYeA02FB6Fk3+62TYnkcJwptKkSy3+UeA51NU1FUIV",
+	"xhg3OQMbXeqDxCWKFrnlrhv5ug51wNYwyU7csrJUD0eu8k8PDNsbgt6BvGbcE9mjWUtPyVoRU6OO2f44",
+	"JfPJiUnA2RyelQO1Lgxr3RyNwTypgLDzOK2x4Q5aNaY6SrsPdoqW0C+79yenHlxK1sZOnVZ2qhXZ3Ez2",
+	"H5spU/l6C8KZz/0zyTWoLleP8aW3wLTuTT8hJ+vmQ8/s4xZCTgUuH5mWZtkZheZn1yPMsYWONsmC0OXA",
+	"NjmulsPawVGtOahdHZMlp028NFXf3h6a2tG2r3emLsbR4pmpvj9Ar8zCBnm2OMqLi3Tb5ddZlxZvygVj",
+	"Vz2QLRv8F8a8CK3LlvRxkKwYzeccB3BpNPHVJ68kMUyOXTLDXXs14i+XCVb6U2jIqBITeqlVtJcxxJdJ",
+	"INc1E9c4aW6X8CtYrbtpTs9soDIHHK667guHfzNC++2lSCIi21wShVh0APj8/BcNcQXzjb+aQbYMSVpO",
+	"3nO23pOsnJvvkLwnUtlQ/5ZVNiXbAnd27TT8ukixZWKeAYTAL5tyIBMqILBGGJ/mki9bOsu8Sl3LeVeO",
+	"pwBQYfrSXPnI7cvWjMHDvnT1wX6CNiz7O/V2C3J04GRztIQ8qqX58ynmvK5Scln/rs2ZC8jUAzq9rPk0",
+	"GY2789s3qouX1RbS5nTMe1DKtuO7GWljhXf3xS2rWGRBL+16AdyoCuz6tYFU1+PHXBeFVw94ZSCfNOXQ",
+	"9OylGcC3lZKVS7oigamZr/P2nh+9UwV51+rtM35VcKU18BZOoRF3NnY2Vb29Ip0b9b5yHzoA+gkOTTrZ",
+	"HMkbZf566bYMJVRHjYxerMq01eUR9M/lIarlRNufCs1aa72aLcT5bGsbDn6Hgnwvt1Wffr9x4CZ31L4e",
+	"p5t4392+k+fdelY+UcfG+/RSbPOBsCje6I82t6U6apuHE7LuAI9OT3TLrNrGxo4xtYIdvste9cOya8Kg",
+	"DfzA5kDbFrB+1k5O38rtJWI4bHIE2IlPdtVVRx+zOdTygWXbWnbcUVtR88otQN6EZ8KJnt1vj7Lnkt+m",
+	"oEZWRrbJ2+2Mf24cvUHv9VRH7s3TLZWj6fSaaV+yLb07+4eGmiMaveoC40+m7VaBiv0d8nYQi5gNkd0J",
+	"nUY4lxbozR38Noueu8zqC14GLKXldDov1qbTcW509myrUW+5l5wv3K2yV1XfuVJkWw1Ob47QGllbjFc2",
+	"+o2o5FM+xg6GYL7EL9nCOoer4aQattqOJrZdMSi0PbZUNaqJIG1dPpqWR3XDVr66Unyji2Ncc/ubfTu2",
+	"7zdMV533/sgqY3sxxA5NFSZ1bNq55TkEXVsuu7b8KLqu/h8s6t7ScfMcqX/KuHqlbp/+3b378HzOYW5c",
+	"7NmsUNTUMA7j5y8K3hQZQ4nJF80N6D5W+ELth0/FxLpZ45pUZGDcXC1QQEDPG7Ew+qbqATPENgqCHIju",
+	"L9+8j09JYL5u8bAugtS4bTt6XBc2sAZsz+DP5uFPncKsu3I0J+0tb43zZe8h+jO/fDrFOLaE+B8s6jvE",
+	"LbFrL8fKf6whjEslkbMWsYi9blXWZ6SQx/DvL/7+8vDb5y8POlSxq2Wy106GjW4d78sycO7SR4sOfQus",
+	"tabmmc2llyWV1CP/m0LqM8f6dC59jLI1HUyV3Krj+9Z8ioMr67FVeafwYNFkypHKNBXWn83Y/2yu+L+4",
+	"/kfVJ5w26KjCrK0+RoLM+/hF6LJSwm8BbFCE2vZjsweZE0Vx4QaMlg3d/C50J+Lh6MWx7yvNVwGG7jdV",
+	"EXAPE7eft7gKS1A179yOXJhPsQwWjSngc4Ozmx2HoY7sxXRuEkerqt36HxUTXX6UW+eRH7t/+T5J1qVu",
+	"nUu20mRGLG5Dn6PKe3mRofLcr3BiCqNqtKUr7+k6ouzt6w5A11Aw7FrpXXGI8HJujV8CMW7UV3ZwETBj",
+	"KU44YHVwYkFmfj5fUSy8+nMdZO4Jnteokzr0RE++V/jLiuEhzPwT2xu0YpJ25a9I31iwbZxGO+QxWqiN",
+	"7FUEqNHJUSxwc3xU98xKze6lmxgRrEtqh3mXLEpjyJVA64oSmCvJOmHai2hh0LJ02pWRs33y+rSuVQgo",
+	"9OrJ4RnzWvTV79vw9QwQH1N3Y2//vFFD/UNvYHvCmT61kC8ZTxaYNiUXacrU1pRmrTNy+6Ve68Ab5Gm3",
+	"cghbZOJ8Y/rjg+nXhBXm65a4UQStAUMK8+wCT4TM8mNwNvdnwVWxyphL0hQiuhNHzGZ7aLOLZltJG7U0",
+	"JRzmxbvObLVtb/3ZYNNnS14Zzaxis4JgZRBa9DZqWdnjlzD6lszdA68im4OQhDaUVf/gXIIkQxFZAorN",
+	"MOUMGJKVEiQ0gF6cqAvEZyafRh3iGeMBNJSWXDvq+TWRwaI+6DRKleMi+JSNpxyuMY+1G9QSOJmZjJ6F",
+	"BeV7MYUZ44CEZEniwpxsZpEsyr4hm0TlJJp5bkxcOO6hz5dsCR3qbhYns52aDuUMIrx6C0J4H8yBKRXa",
+	"wanBFhU16O+6NUpCsZg3SkgdXQNzyCrzmdELY3mXXkigUY9tc+k1kH4cI0INHVsNdKH6fjDnLE3GiOuY",
+	"LwgRozYzjP6Als/Rggkp0DWRC3R6foJEmqimE/ShSGQ2g4kZRpghsDKAzUEgzpTMHCoy1XF1CxbpJCgB",
+	"UDnWeMtotFLpWCjTlbDRH8CZtzBaknbNGKKEf33ls749YogZX/XrtcYOnswnrv2mdvBUkmg/MXjkxnpD",
+	"qE9JtJwfHpTlZJaa0H8LDk3jqZF88XL+4qBH4791bSuZxJEfOYM0TiOdXMdgpw79JBTFJOBMQMB0XhyF",
+	"ChlWEouVFXwzKNLJvaNsuFUb5NaTbcKnzmeo932H56hxqH6TpOsN+CWIlPTBYujXpyqXsBh67ISGfCc7",
+	"kRt0KyUl2TVw5Myn2sW3YGcP0YxwISfFWpPfeMvqnHI2jbzqP5DW+aM88RFapDGmexxwiKcmTjjC9kYV",
+	"CQRkRgLD04hALDA1HgPIEmknZkav9FGMMKoLNb98+HDqWGqgBJy//Hb20+u/P39x+GmMzk1yD/S3v6I5",
+	"UDC7MLUJvRgnc0KR8afXxTz90CEfcMWXOJG+uOkjlaOKy3F1a0Qax5ivKoMjNe4EoROJzn95//HN8QV9",
+	"9/6DTTNmUpgVAJOsGUxVHTaARF6oiwwlKU+YAKEaaX898oc5lb/AZD4Z2yTlCWdKW7Y0edCBygtKYc4k",
+	"0W3//0gAIM+2vpi8/Gs3gVEa9w/hshKZPfNf1ixorDQRxA2ZcyKcVFUYoQusGK/xaN1ZGo6kqa64N3xV",
+	"v4gbft8gEZlIp50zgCTGI9Pxq8J0+VaaEQ2M45qTqDoIs641Z9jjNZx38r64zedtnttFqHxv7cIMO1Cx",
+	"GwBXDfHt/ZSJ5t3h/ZRxn/asMIf5JwPs81wEMT+8aHljuGwfrqSoAcdN3uZ677ZhC3OS28jCkd1LhEVx",
+	"Kb2wLuvVgNf6+3aIXQDMj9n5HDtB7aLvYfna0wWxAxjn7x3G85ycBc+9milBJ2isefJInvoNTLbkaq/C",
+	"sHNXrm/jkrEdyvT2lrErVVtzvmxU3QZo30E8vPf8ZWN199YqI1wtZJfWDN5NsWDmLYI+7qNsqORAzuZt",
+	"PCvjQ9wg29zecV1GpWzzBcnkVs4sF9cf/nHqrelwpNmqOpxtn7LRpY6++6HQZIsrogah55aozrS9scGl",
+	"gt00O0W9JE7HDBWe5PTdslRUk9fetKzKqKE/5LHd5SXiKaah/44sZrA2XgooJKFV48iUU4RnEjgiUqDE",
+	"SKUq1bQEHltNr1EFxirCVto0xzZtbINKuiWJQB9aDAmeUyYkCURDKrS8gXuXOrAhzBczNmpMAbKur7Jn",
+	"7FcFFELp65PbvbRt1MgUU6cmG/X3t8s8SDQvcFOPC0e7Rvvj5J5JGVe2Ce9xQ45uCqjY5KeowuyIUGqH",
+	"sDFUy253Swt1LuF05f/Oc+OOtyiW+ngZuruiw+u8ymQKS6jAWwIuh6RriuTK5u0sVbIb9ycS+ThfU7b7",
+	"WNNcZ0rsaDkROm11bEdpkU5ymPvcKnkv/91lvp/EeN4YnWlqAPoJmoRAJZkR4I6ZmOp8hmcYhZb9SUjj",
+	"9NS4UZ7Ry5X+xggrFaLJ8g+hb6yEg7CxpM0c3YIokA560jq/9fAWKcow8stNNqZYEyEvTnitFsbB6IA5",
+	"i7tG1LvlduVyxXPeOY9TMZR+ydmbAmdDHV6Tqm7DFLtci50mQU2zkqS6xP4U6Hq2UiGdsa1EyCqQXhmy",
+	"MtfuhMjNNUhnGTq1ALyNS3Imbm6hXSoCssGhrDn7XZz7ujPf8Xm/YfPeML5h8x+p5KvWrXBtmnMhe5BA",
+	"VNLCtiY2zju0LdAfi6QrTFw2sq6d8bT12TMLkIy9jK11cU3pRmyVqYIc3+Vgy/JzWezs8RB0nkjrfBBq",
+	"MuLOa1Q1AFbHSVVcpM8LjUOMSaU+Q9P7Jm87ziZqO9RM8dsky/WUYbvFvhfD1ysLcCpkM28b6E0Q7wgd",
+	"7ROlLqwtCDU+PrkOnMwp4yC0845eAJIcU6HTcdgnrPC7lXWoh5VNIlCaaCWBUiKUy2StKYy1rqZVeXZC",
+	"QxJgCWqRWNaAWGAaRnntbD2IsC4tOmGHsGWbzK6EyI6xWCXKkCAYR5qnNgA1c++Vrs8UkVUM73zvFYTa",
+	"G5sGv7YLV7DaMwmsEky4MHaOUG25oj2uXfTmJd8yyZDNH3qhzh72rkkICE+V4kL7Ibhd9BcPj1xyLk8q",
+	"pXmP67KiVKtgFkSRKGaaJzNEpKu9JTmZz4Grcl5mAKd/cYW8LmgRE5R2K00azrFYRquCX/lOODcPFySu",
+	"nZ0kQ+9N9ghtcQKsq7MfqacXqrtA/ajDRNR7zM2Yjx4y+kxqf0+EmwizAfwe2TiaeKlhh07PUitxYDfA",
+	"7DyOrvFK6OJnyRjBEpzCEBvw+wHfTTNaAFPXlPa/S4sZCE27MjLrZ7EQZE61j6NXw4fnPeN4uiWUdiy6",
+	"UMSLRCDyyhaGpAwB5URRquZVTjySq54yvxa7N3YVFrSuz+edv5xZBEVBHYcxoaPxaBrh4CoiQrof5tpL",
+	"fjzKyvaNxiOVelVtBuClLdmgkynl6TnIH6D+4oyp5uL3FEtZSrlYMNUW6rfVb+Aewk1/D5uWRG01acjE",
+	"F+Q9nKNMg1TkMlXWn1WUSII7mCnsCCdZe43+fA6yY88PpnE9mYobMBuvZQEnRXCrl7v95PJzLJiQSKib",
+	"ymX2REDDhBGq/Qr7ZIrE6JrxKNTXXkrJ7ymUxyvouEouiyPyO508Pzh4uXd4oOhgkk5TKtNXB4ev4G/T",
+	"8CV+Mf3mm5dezrJKPPCoX93ysrnVj5VZRSBIV8WZn+w9W765UsOHO9WXuXe2+4q79gHTXQvhXYrnLqi2",
+	"20Lx4Qe4wzbvyM3GDbvJPrVszQ52ZM1G7Hb9HzKGWKFb/buj3Era4QfBob7bOzzUHMre1BPBl69CWD6n",
+	"hxML78SsYnLYn1/hO+JYhcJQnQtpN2nktZKBp7Rn/f51VQAofOk/rN2EBt8W/e2yVOGhsdjlZUX8rzcs",
+	"VtfqmLQg6+LMB5Wk+sWtLO9AvjTfQvxQt518k3F5g/Nff5Rf+ansdue3kA4cnLdl8siSDG5h8igus8cl",
+	"VOjlvebs923uuRJgvouuOMf2Jo9zl24xY97GknhoFe/PVa/u7+HzhnCZM7AGXVEok1921DUwWX3JGJkY",
+	"kWdp8myMnqlS7ur/qgLkszGaTCaTgvdumqijZtc0rxFZzAEyHgkZTlcoTbJ/6sal/Hz6Y2155/pN3ZiK",
+	"q85OmrzYs6adi2gXZ96Z6t+MKvIFdcPJIiy+Q18JEwJ4TnEiFkyuq2EoXIdJ1qO1jGHWfjTuGK+W97jx",
+	"AbiLWob1VXcoZ+iHZHsy/lDIrJtn/JlhErEl8KasP4X0tbknfNZFp0/2Mek8lWqRb4yeHzz/Zk9Jnt99",
+	"OPjbqxcHrw4O/tWtCGhLKrOPAjyWPK8uxucz381VyRTma3JQUiCcaHHbF1KD00aHf0xlW77zvlrGAkjN",
+	"qX9cuUzvV46vLzOwOsnmeQ+3oOIcjbu1sfCgevvoJhv1vlQIDoDu/CED2XOg6tsWQkIOTMNW7eQZbMri",
+	"p5zIlRI6YgPgFAsSHFmk1wDpe0/9mtP1QkqdAHoKmAN3rc1fPzl+8D///DAaF4bQX6tj3BTsXjbebGQZ",
+	"vjHiIZNzP0tUOHo5OZx840oZq4+vRi8mB5ODUaGo0D5OyL45jVd/juwb3+iZVW6VcPRq9DPII91grC/v",
+	"GCRw0ZgqNG+yT1TSSr7SnVX1Wp3121WK1rM/N6H8Nt5V/RMnSURMSpb9fwvzsjGHvb6yA8cmuEpvVZnN",
+	"v/9V7cPLg8OmUTKw9lUj3fZFl7YvVNtvDg7Wt1WNipikd7CAQ799uhn/WcKT3z7dfLJGDPX80WfwSQ1h",
+	"Di2Vi/0A0z1SOLnyqn9UAeCEZl7uqVwAlWp7IUSpAK1RVjlwrk2yC7X5K4RVVpap/pteUEMDxtX9ekGC",
+	"BdLcz3QTSlwNgRIQiNgUG9OURJJQxFkEutbVBTVJFIRksf1Vl63O0iQgGxFi/T51m/+4SA8OXgSKw+p/",
+	"ARIm3llcUMwBZcXyTehzHWNTuXiN6Ukdaev2MrdcN+YrNAc5RiEELIQxEuoPAfLyClZqH5x8rrzjM7Hd",
+	"Wl211ioiV2DyBI7V/gSMChbBGAURYD5GQJUJbHxBrTHMrIAoaH5X9OJu4FeKlKejIqeSPIVxgR5qXM23",
+	"PAuuDWzzzWM/dZ/nVuk4lYtjCPTTvI2UD7qQ50E/st+GPNVvFep07NpLnLpOfoUsFQrFIBcsFC5vTe62",
+	"YXIktOD7iTGh3urJnJiaJt5TuWnfDQ4zDsKY6pjPp/rMht0gCtcIBwEIgSS7AsckiLrkAkwV61Ju4hgp",
+	"iBi32RQu6EKXkFFchUiBZkyxKOXywU0KMuV78MHYxA0Bu+CfwkxOk63oGJt/M5pZ0+0STFudMGNJwpyP",
+	"2XnKYCEDle/cVDoo1fbM7kzfC1bZlsWoTvUx/lJelQv0GKMYfyFxGpuqbuj5yyaWYLpfFiJEchzJHzqH",
+	"B143dh8X0kUk7LSpMPeNcYfXW2fgNFdLEGESN8DlalH4oKHCo8G/dV51pHfqg4L/rtlVPynl5cHLLm1f",
+	"9pNoVNsXXdq+8LDXGje1eVk0MzCkVsTjUTuDMW3uj71c0At6YhjFZ8spPqOMXBVrsao/LUxptxyGPqu7",
+	"9vNYKwNLzOVahRTiSDDlYkRoEKUlTmM2dnJBDU+zMEDo5C4OCOIphKqTXswzTVzPDHUpkTDGMli4pHqp",
+	"4BfUNbmC1TXjYRvL+mDP4/EyLAOIuyv0ro1RnAqpzgNTBF+IcSh00qsSqJu4VpqlE/AANWPswXPRGjQn",
+	"swx1iwiJNNpadK0itcJepwNyyfWKxz5BJzPEYiIVHjOOPutsFJ9tnCqmtavaBOq6R4BvpTy7WvO1ZmpB",
+	"I+bWFdY+9CwvpAk/XxygEK9EOzDrkNQg+V3fY8MNtskNtv6BkF9pP4P03D5rLrXrBcMxaVXOpHLxzwU7",
+	"ik9uU/gv6X53oGHZ3VPL8t998wTfx1NnFfJKAUfqczlE0/S3vt96EFSqeqIv2TMwfrQ2g6/ztjY5uZDJ",
+	"yWWZAKM6rEC1E013qE0fYmIYNEy3eXi+SjKPhtJfHnzbpe23pu13Xdp+d2daPYt8zeg84wB/QDM+/6S/",
+	"a4QrqtIc8l3QU65L+esWNi+Uw16BQqtoEWOde9HeQa6dQBJfATNahwuqy6469/cpuHJwNkczpitUqCiL",
+	"Mpy3KXqRWAkJ8fiCFuC8Nukx9fcYUzxX0mqO5t3Ix2zBQD8l+nnMNKEKC7ZTxUfbooUuVLQf4xmu12lC",
+	"Ib++H1yFjNUmRJLSMpkoX3736NLAZFEjTcRzQQvUg3oQzxgJhlKKpQSdztpZtBERF9RooVX8Fya0E5m5",
+	"PR0I7fETWp4aqknqtKiR+eVsZBr80VpcGisE1rucxAlwwWi/Xr8ajYa4XROknWWdEfL+sfaOsUvbm02Z",
+	"iPKOHEMEMjftjVFKBeTqMauHEk7r5WoAFANlkQogm9S5l5pwJzhqYBQ9kO2jWkSfDucgbxkzX7PYqFUG",
+	"vFzL9fZnNmfUHJq1yHWzdZo9Fn32uRIq6lRNvU6bBRLknpAccFw+9bzgDaGYrzyKI995m/JfYIq1vX+7",
+	"9wYLufeWhWRGqhm8C75qiY4uVEP8n4uL8M+XN3vqf8/d/z6Y/70q/e8vFxcT9a/D8Xc3f/3vf/33f/oh",
+	"fJpcMfXcradpA7JoBf8PLFzdIZ7c1LC0w7v8uXuXf216hK9MPNt392MXZqVCjZUZO3crKN6uduCJGrgD",
+	"A8vEqU3vVE6WwHvdkCb4o3uP92YP7kLeO4aZjtFl9H4kv3tGxsV0nzOXRKZBScW4ycGhcj2kArRBRz+X",
+	"bbxmfplm4e9IQMBBIj2208J+YNbu6optBCB08QzroJH3NiA5u+hYz6pa5GZboxabkUihzfiC7qFfXO8z",
+	"3fk81Wr68YSE33/58sXTQmeyyL+3vaErPW/zEV2Z6szO89Af0g+V+ypveoe8xmZYIwGdp2ED7D8KQ2sR",
+	"cmUBi3Jl5nTkTPs4IbphzerPM8O0tv1CqDs+44zJZ0pD9EwB+My4BmSd69SjWrkxTZKQFQ0WnFGW5t10",
+	"rZ/M3EsE0h4NLt1MeQxDYgss0BSAoiSdRkQstL32w4II+50IpNN+QKhX971x/cQJ0XnP9F/QifqLc3ej",
+	"+P9hhDoyb5x7jJUXxWXhe/4N/UWfGKYhUZKyOcdswbqjttEX1Y9/dTOfmHRLLTNnA/eYXSUaxREHHCpf",
+	"3uLM2cSGb20xLaZIlyMxFZCUOVztr8naXppSyx1/bWeN/2OynLT66X6or1Mytb+13W2wvdvcec0+rrn9",
+	"PZtnz3aKCX0DdK54xPPOhvm1b65z5c8Z7v2w8tfUKi5KrcGk9rJIbync4vrwpOrMqU0qnRYfsTkRRh2v",
+	"W2acTDJkCu5XSArFysuKT3ry4zdq8PUMuQzDhhy5PMgds+TS5N14st6b9UzZHEcjWy4zYtvYz4r1hDvg",
+	"xXpKm2TNw3j1NA+L876xiaXWsl5ntSpOsD2jVU33JNszp7MzRtuL993KkyjP5uZ9lh+ncZJZJov5CPNk",
+	"5ZIVUv616xSzjGUbPcXfuRjG9y7DWp9XucmqkHe9VR12abmPM8irjlJZ1HqLLc7lgeiPBCq3T5+DV9bt",
+	"uzltt6YmlYpOO2TbWA38OM+BSUObPuFJWTYyXKmjz76K8dr/M4tYvtn/UwW93pifbvYTzuYchNjkFftR",
+	"5EFKr8/emshBymyN1JyNFUvtEC1j6CA57Q3BnOwwNtGKRLiW19jcqDbTaT5VM29UN5zDn1O3sP78URFH",
+	"xh67sUXV5VdCw+6tC4GxXRT8/YjIuxEeYnptKtzKYoV0R0s2jylDHGaRzpVgBD09mBLzzDmVUtS6Qkq2",
+	"qOmkJg/c3MZV/miot+Ud05WecwnkVqnZTJOVP8miYaXLi6Y+YZf7dh2tbizJfP2UWtkCD42qcyynBhqo",
+	"arM7MSOa/dQVlFhnPcu6CKT7KA9+ltJCdO7vKZNYmDj5/G+TVj1hEQlILe6dcERFMJvbU22Q8jO8/qiB",
+	"3Z2Yf5vSWxnoR5yVoZACJscvkNeMX7VJ7O9ME9ElPYFL552rFKY4uFK81U3U8BC3JTPvJYrfLvAxn7zd",
+	"/Nq575Okw9GfnD72sz85fVqnb4sCrbtKrFw9zgop0NAlPgmxxPq026KHFApZO0e/m+Du3u5qJnf2T0nW",
+	"0ChQxohqDhD/Wd525o58kkdKjZ6NVyxw/09nPbvpHR4YpJwDlUaXXo0H9D5jTqEa0LfRO4aFcPvZsoZA",
+	"i7v0JemBnzpJVDN+vlafhTEBCfSXQjzSWMf3QPhX5y1filPVWpwmxFUoZxBXD39biLvOa/Rg9NQviwac",
+	"CJXCIS2Zrdu4z7Ftvu0x9rAD6XItJ8eNR7+zW8zy1yCAZIhm6ItGHBPaGYl04+EKG66w3njWMWTd3VGT",
+	"NcJUFt49cLOBm+VYlqRisY+FrYLX5M5lk4jpgEIaZh62rh6E/ksPgkIiAhUdvZqskZFOU7E4EqbC3FNG",
+	"ySeEZiERV9timRqjH5Idq1kHHHsiOJZczbdFsQQHV8o41QvLTq/mA5I9ASQTAab7WS4TV7ilFdsyLUKx",
+	"GwpwsFCqhNfuxxVSY1PgJu1klhXXWkYDnR1o7vJeTlfK55evCjV/dTSgGxHbaTDPUxyaZCU62bjJmY1m",
+	"gGXKQaApVm0YLensLM7TuU2b0lX9cR5g+rq4RQNhPAXCEERTRzNBKLxAJv+mILr+qo6aFYB5sFAWGxXC",
+	"pS540QHHXp+fqPHuBbc69/nlh6MerV0J3M4d3nx8NyD6nSN6VgqqWb1s5IlczjDRiVnPdQLFeak+1Z1g",
+	"90+MB8Pz/tEha48cb10VSYUEZoMqacA1uKmJw2sz/hTaO6dXG5D9KKRh64+wUxH4VkOCsk0fcq51R/q1",
+	"uf00DmyaNG1DVpln6BvfVfbAIRXgHaPlrvIAGp1E1yyA94HNQ9LAJ8tYO6cPrGOxrn/hxnOR5KppxAJs",
+	"XEK1R/AYhTpe7suq7RIvZo+7yyt8yFX4+Nh2Q6LC28CzIc3hE0tz2IO17i7hoRp6He/cIsvhpmLDkBfx",
+	"a8uL2AV7TcCs02xx0GHRbeY33aAYa6tf9EQ4ZYPJ+6P+QLr8Mw3RkkVZ/K1Q8gFlEgUmrtu99023BFz2",
+	"G61VoEzqm1TRCkt5qQyB7oiEtjWvTGkvyuQFlXylLdC28EFeCsGmo7EVwdQqmgwix3phdqmDx/FdoSra",
+	"tyjVD2fFIpUhu24zkS1SiVSTLLFNM3rqKhYUCcmSciaHC3paQ84SgparZCTACQvHZQSVfHVBvciJBRKM",
+	"UVvXlfAMoKxCjV2lBeiZuKAup5P6uR2Vz23n3rh8bKX/HpHod6JcM8s6JcPzbzvSkSxpIRsPDWzE27fm",
+	"7ArXpYdqUipJZGvMZP0v5xwHcGkIUNEHfEkIh3ANiaiteMj65AHlt0T5NCQtgs0Hk8JHJwRRLR3f1VO1",
+	"p/MxJ3Okx9+BOF6PtDYARbCEqCGm2n3LUQloGqut0tFYo/HoGnNTh1RHc4YwTZXKUXJskrp0qvCqJsts",
+	"SyKdGpON0GlY7OobKsw2VBNVv4VpBLxLVdeEA8RJOQDS7AyZ6QpvRLi6iZMGSOwQ/oqruqCrp+Tqp68r",
+	"c8mDfENvRKz7EZu3vKaxSWZiUODfLNXpgixyxqk0VkWckKwGtr43cmvnGsr+GYqE/YbNd0/bArKkVRwC",
+	"/fyPGdd/KOSWC2yfVq4oL8JzZqqqEYHOfnr94sWL71RkflO+S0FoAL0q+XaBkUUh8B1Ap2/v1kwQnaCx",
+	"KdlaSlzbT9vNc03kwsy0kDJBMcgFCxsmzD5uN6XUrmvm0UqES7CUYNlUaNx+2umsmWa/YU7TfDfbixFn",
+	"qdSWB4kJzWCwI/kB0F12Nb9NfBGoRZu0tt8jxbD9U5uo5n7XifdmdZW6aaoyNiucdnC5MuKmumLMhHTs",
+	"YQpqf64gabp3IxIT78EQKmEO/NYLd4dEnullDKbmjW/CkIr9MI2T9oS9xdIMx+/O0R+MGioCKlsvt+N3",
+	"52qAh/3yeXf+L0bhETvJ9kUKnZi8ESMUtQEtyjcmk7loQ4QfdYs7MCf0USm90RysQ0MN/U86UXvn5meQ",
+	"RHjVuflrFVrSufW5lry6tlZmY/2Pk+NbTnEt4Ys06OM1UbaRoYG0mZP3rCGRyRVOZs9Sd+r6SlynxFeX",
+	"v1vRZLB492YUi6n7UvRf7mzlsUeymKLPaoDPSiny2U3yuV0fkld62pEdpasGOpt4ML/cA24JMm+zxJA5",
+	"RbhQCk0FJnVDI9V1wKGngUPt3Ol8d7zpfOBMTwir1hq7doRTLBlQ6kmg1DVJWoLA/kkS2PCyU10HHHpk",
+	"OBTpdznwXYjkbqwNGNUb2/Wu5XI374Bl94ZlfQSrHWDY+YBfTw2/uopYO8GuO5SzBuS6P+SK2Hw/YFRy",
+	"FrVnCC3jxxs2f2173SOW7L4cT74uPaxHGXsOqshnldIiNjc+RIa8OpXnGTB6a4zuiby7Q9p7Qz+dsdIi",
+	"n8O5AeHuCuFsrUJz/apI3/pF/CuxbvD2lGwX37VrgoWt76kd+VZ8HBMSOkOQBUe5FV6RKGp05iNlzwsi",
+	"IRY+L4fMbIQ5VwY/z/V9WyF1j8htb+w3Nv8M0oNKxcrBrf4Ht4pTLf6qpqh3Ed1A7Np9dVMrbueuZyS8",
+	"Xc8Lezrr3He+aq7Jp+E+jlQUu1lVg1NFr6KUc0cUfBrapDkoJpRx6+BlKisljEuBcMQBhytkYchT5Nh4",
+	"uqY40OOzH46PcrgftANPGdSdOILdYQBlS8nTZpSqZbLZAp1mIIMFmnEWq5x3CruxQa16nhE043geN3t9",
+	"Ocy5s6QjarIzmz/qbjDNLm1wN2zE3vEuCu+6VM+dMVI11pFiUdSWifQhYOftVLsur+7MzOLD0+N1O6lu",
+	"j/zOQmSoYn0H7JxCIHdVtFpcObqZMY4w+qwmwWGM7DyfUcDiWB0zfIEgVXOsJxkN4H3QTM8+LAR/1snH",
+	"m9jkoaN3wkmM+erW0dvO0x+9Ty2AD0NgGRD1vhBVQMBoeBeoms3UH1nPMyAHdH3C6Kqe/c3poJzizIRp",
+	"2MZNLzb9+WG/8TWIQ6BX58RLLmnGvgra3P8zK9Z/s//nFaHhjfnppi0X7okd4s7sm+8ckN27/Epo2G+C",
+	"28VTt2cnEmIfpirVnTsa+wYbZ5Vq1SVgIkSfohkq25Z9lf1lNPb8vmSR9/dgNvf+LsA/Tir4jujHuaZM",
+	"GWt5vf3AbDZTG2PtBven7Xc4ZPLaq76PjQI7myCOoug8wste6YTfYiGBb1aqoJ9tpPsMfddwnk5Fr6oy",
+	"H/C8T2t2N1xwqMywFavbLYvKzfV+JmUzkW/IpkzvJ8uo7qjcyUBYtyZDNMkKTbIFFU1fdi9d9CgrvQHp",
+	"3mGV6acrY/SWAAaG8mRv6mS+nyY6s1VzBTD9veTPNucsTZAAqZIrCa1v3JAhnP5shh9YwvDs6PDsGPjT",
+	"g+BPzQLJHXIuVfJNWEc3P+c6dU283An906THxhIulWEFZTin8m8qtfhYG1xyE0w2JRjlmc5ZbTIEh52Y",
+	"XQbywO269zk25ffOWBRNcXB1i0VL3+isP0+NEStEfk+j1aAzGjj9vfLztXHjc6LTAirbBQedWEsz9gws",
+	"XTc8BJtRXbgaGRr6K1g1+epVmPTZ3Qb7DiwaBtF34J4D99yae7ZFrB9zllimqc9cWC6qWCq3vywgKqcU",
+	"v4KVC+Hw8tjuDPUO49sHfjrw04GfDvx0S36aisW+qxa/r2uNtAimMw5iYQspm1gSU4Q+MgGRPvVDXoq+",
+	"GGDahZ2mYuHcJE9MDZTBDvqgyHMguY1IrlfFxg1MDXedJWwQRAZBZBBEBkFkS66Ythg4zlKvaQNJLK46",
+	"scR0MEX0IXAd78rjPj04owPXvG+u2bnxj3QpBib75Jhst8LLqsWmwufGdYufMrsduOEgQw7sbQfsrUuy",
+	"5E0Z2/CmHt7UAz8c+OHXxg9Vj3C62oAtIkKR7Y1iFnZnk+d2yoFbDtxy4JYDt/xquKVMxXrzp49Tmr4d",
+	"GaSaZTBmDgT29Ahsba2RjR9ng+PVw9I4vWVL+MA2YwyDkDHwwEfLA1c02Cd0DqJFUXWiv+eeU0vMdTpZ",
+	"gTgEQJZ5Tjw16rLotbqiATKBrsjM2Il9rmhg5hzkkttjP0Mg6MAg1jOIlK7LTPHRtthUWHL9B4FpyE4x",
+	"EP0DIfoOUd4f80YPJM67ANHATIZ47d2HXw8vtoE33xtvDiLAvJkdv1afEaYIOGcc/eViZLz2Z5hEEF6M",
+	"dLYgW3nsr4gYnp1B6vLTara7Lr5QT/VEUgYPeH4raXtbMtncfkJfk5R5X6kwGpOrn4FMeUmw8ZbTYTFy",
+	"80/QySz7Q0ku1GYEVlV2Iv1ljEKmpJwvq4biWhmF6bl+UgA+6czcLJAg94TkgOPyvWVi90avRlNCTZ2E",
+	"av1E3yU1Hi207KKnfv927w0Wcu8tC8mMQFgaVqms9iSJzQFICVwN8X8uLsI/X97sqf89d//7YP73qvS/",
+	"v1xcTNS/Dsff3fz1v//13//ph3BgJV9DBvCAUcEiWOezgpFYQBS5y1XhNCYUeK45NTVAEiYAEcUcOEvn",
+	"C4RRylU5XSxRgCmaAmIJUKNVxWjK2bUAjkxxESlXe2KBOXxGQUQayvQVL2sXs/raruGpPoz6PQ9+5gDy",
+	"A4mBpbLXuwVLbyDDoUdg44AlhGWe9KZQRfSBsosHWV2lzlp2R/qGiFUd9kZp4VwnRcoJPmJzsfaGN23f",
+	"sPlAk+2t37D5TyyK2HXHxm8IhU7hRBK+yH1YAvXLGGuK2A+lau7vMbyeGClcdyDDN2z+BJ2fFEHp8uUd",
+	"G//MIRkIdRDB71EETyJMuzzWM1E7I3mVNhsni8w/gVF0zdLIFeMDm2rrglYMGGN0TaTpVhhLMiSuSKIl",
+	"+evFyjRiqbygZjib/DBe88A/Vct5fHyHqNP4XV3tCh/Ur69GZs9r5YXHBfoFmsYKQ12iEGH95wrWnKJJ",
+	"6lP9KX1rto67cKp4cAp/jZ0PmmF+JY+QW5aCHLNrV2U2lzM1fFNk2gqgErGZ5aO6uDiERtOJhf3V7Bea",
+	"snBVYJCqzf/7f/8/AsUgcYglRn8REktCZ0zZGoIoDSF0epFsEPvunaAPCyJyJqt0p8ZgDFzp41RPA5RI",
+	"INCqOgOU2h3VeAnc/IqF1a4Y1QmtZ/1aw5adsuQxal67s7zCJmzRVXPOh6Xu/VnVz/CoVsb3rgvWEJwC",
+	"j5ug+yiAP2Cl0EPksX3r7fbmui49YWeZVHVAUsXVOTZbdZrpxp4eY/7B25Rrivs2PAbvT15R5xGm3ayu",
+	"ru029HLu5htopTOtuD17+HQyvAFUO+2xkz0AnHmyLPdwiLAKzNhTY/mkiDYDovaUe7SuCPqV8wMLV3co",
+	"lt7UyLcDIj83iPy1Ed7Lg++6tP3u6yTSbc0QijbuyATxwHT+69uqBT4A48BXLuOtw+AYJCeBaMTiU+Wt",
+	"prTeqsszgVwHBDRMGKFyrHQxEhS7Q+7bFCsVDKOZFok/E+jsh6PXShVPpSpg8XOqQwpZ5PTvKLvhtKdL",
+	"FOU/CPXLFKQNHRDpbEYCAlQquHCgS2NawdBCMLmgZ4zZ8YlAFFQjzFeFHiGGmNFCjyYCfWtabE2jHTE5",
+	"iTCpyGsdL5Un9XhZh9iJ2qomrMbiypRakQyphhrfgijVda7UhzZ8OFUj7x4Zvi4Z4MGc8/ZvSjVWy3Hv",
+	"7BE5vNoeFuKIxf6CCXkFK9EJecQCJek0IgFS3VSVJoGEKTmSAHDtuyl5KrTXd6ysHUQKdEXZNb1UPYS2",
+	"WrRh2vkvvziAhsvma8OlK1j1RCNV5yuEGbGuvpoPCbFQP/vxikiHVTiVC8bJHxBeajxcj1m/wmpAqq8O",
+	"qfS5K3CS1INWHxy3qWCVUFebxp1GWeY0dYihB7lneeaxn+RKcEgYl/uC4kQsmOx045jAoawzyjoXJZcx",
+	"YlGo3lIzwoVs4wFunPMMhgct0VTBHUSbPvgmId4PibhqxLN/ELjWSKRbNeOMhPjYtHi4uKIAHNCjL3rM",
+	"nTdEO36YZq0I8rNt8nAxREM4oEhfFFlgHl5jDuuxxLUU7ZjyixvwISOLA3LAl774QhIchhyE2AlbOTk9",
+	"sqM9ZGzJoBzQpS+6JDi4wvMO3MU1bEWX06zRw0UWC+OAKr1RhauTl6sOuOJatiNL3uoBY4sFckCXvugi",
+	"MN0nlEiCJePrcSZv2oo050fvTgotH/Dj+eidmiwDdkCgTRDIeUu1447EfA5SrMUcdSBfA9IMuNIXV1Lr",
+	"m9+OJ6rVGizRTv4PGUUUgAN++PDD+J80YoHaNO1kYNqJLEeM8TloUNu+N417o4RCiPd6ahzdLkIYCAeU",
+	"0ChhcaCKFO33SFHfr5CEzZwvk+omUIxlsLBRxUhABIHUifwSbnJkojlZAnUJ2FWfPNFqK1oZ/7pNUOsu",
+	"UMpA9zj98trwpOTpLZaB+ftG6fKVz0pzCipbsEtjwfVC+b2JZaAc5wSLtauLMhu7SKSG0kDny8AOs+kt",
+	"1N9z+1bTOO0qyf3gm9WAxLVkSx1QGWg7Jv9Id4HIP9IBjwc83jkel4JvCpd6wyV7d/j30OLIzPpPJMSP",
+	"+hbP4keyP03ynOxPkzMnbwylxuUMOZ2QziXpx1NWri5dZ4PmDGz6bt386aIjDxYgpNmg/00hfeiJzPsF",
+	"WX3bpe23DzIgazM6oqLyw+4IK4QIJHSnrGPTfiCtgbQG0monrXotqXbS+mmrylADaQ2kdR+ktSFxKEWe",
+	"rrXemTx+dj0GAhkI5CETyIYUEZM5x7JFVfRGVRu1rUyqpZRSpSxXVW/YEngezy9NRE0IQhJq8szZwL8P",
+	"SrFuEjSpX1w6OVFrH+AEB0Suxkhn4A+RkIzjOVxQlZhySiIiVzoxaJCkSG0qlvbXsRqLopgt7bAxW2ol",
+	"V6bHuqCqo5AsMQ2YXBQKB+gceXXgkU6YKUz6Ug4xJnrxea+G2gBFHvLW7vHDZyFdUnX09K9p2AvNQcrp",
+	"Sm8GJjYwsY2YmLeUYjtNnm5bxnC42Aea+Iok3yTlc+hWaTS72/RFa1Q1hcxwkwuqgoH0x1nBTIQWLApR",
+	"iCWeoB9AOfePUSGlNEpFiqNoZQe0V79qfUFPUz7XKSK0HSpkYCp7aZh1uyXL3TpSkRdDF8ugywV8qhc/",
+	"EPpA6I+f0DloibX7TXhmOwzSab4Xg3Q6EOjOpNOe9Hj+lVDjQAsDLWxACyzpQwosGShhoIRHSQnXRAaL",
+	"HrRg2g9SWrYVg5A2kOPOyDGldcN5+WCPVAZelRWMxViSILd/sJma2FTq+cwyLIHvF/jz5IKafkpb8XvK",
+	"eBqjJZOAZowjuSDCWUjyVjGjRDKeFVJTVo3P9sfvFZJ/LmpouDJZzDlWxX+URoYyiewTUFk/umhHPrql",
+	"DzftQNqPX0FSLHO3gT70CiAp1skIGJ2ReWpw5xZ0owVIPCrS4iA7UJQWJhu4wcANHjM3MHS7PrzgtWn3",
+	"oKmhc8zKj0scpVj26XISJ8AFo/16/Qqra8ZDcbuUamcZYmNv3RVVob99rlZiIo15UIC+P4S61gRIfQGq",
+	"/19ZPHDB2L5L02TWrd9NasJHSINmx0SPHh/VlvbpcA7ylinvNYtjIuVjuhmfmLu4IcH2sraFwPlGwkUz",
+	"zmKEqUmubV7BGIWQRGwFYeYcOEFvGLuyz17wjcOKGXn1WCbdLjqZVT8ssJJ+s7HLlevGKGQoUTVVWmPz",
+	"DU/ZpujWQ5R0b7u8671WcL0ZbvOd3eZrdM5fFXUMBeaeWIG5W6aN1Eca6UAZA2U8acrYSL5cECEZX3Wr",
+	"4R0wruwYHIwKUlRKEntejWWpstDxPoTJX+xan6zm1GzDmT2FobzxoyHf/T85LG928Fi0aKaIDNcJvjuN",
+	"Pfz7t2vrM1je9yNvoMavkho5i6JqMHOVJJMIB7CGJrXKxipl2ohTx0e6SVXJ16wJFgjrMsxthFx9XFpK",
+	"PnOreMzU/Hgl4KdJiM6s0SflqEiThHEJYckoYqZdf+9lBrVHYgThZAm8R4dzY2Dq0cNk570TA+QxzHR6",
+	"+/uSeZ8YESrPmHWUh5GQPA1kqpIIOBJUZT+VZVJZwcEVNBetUuexmutx0NyvsNIg3XKhOCzxr7DSiYWf",
+	"pL5+K3P6ERKEziPYkxxTYV1AAxZPXU4KJZuF4RgFC0znuo6/DdDN8DdTflzBak9juk6iof/2p6vIDe0P",
+	"H9tvy8Vc7UERddd7ln9tMt3t+H29POwCw+EDpcP+946rQZ1nMPT6w2B912jTuIcUfVRoOuZkuEUx6Yd5",
+	"7wzJkm/jHlkjA+nLROOiQT8srKLcgIumLFytlX+eBCremsLt63rUP1yByavhes0BS7DaJ4XmhHZluLk+",
+	"6jHj+B1YgB+ZoPRVCzSZ90KFTMxrQUeIaKpQzwiK4AsRUkWV9KScdCCcgXAeF+Fs9hIQ7dXILD2JHrRV",
+	"FbzE0/UmsDvgVKqDceTO0dzFL+4TOmPdXHhMB6Q6IB2BrK+arCpf5mbTrnU9s+OcqHmfLAEUd2FwprnX",
+	"aEF1JGHazfvFtS3jf8EG340Gzt2UTxb/3Q4MuH9buJ8AxQlpC4I9v8bzOfDRlsdspV8DxwOvVuX2MEmn",
+	"ESkmFEkYi9r26pSxaBN5Tb8+VOeeDxZd1diWK73lKvmMReuo8CvWueqDLZ/z/pJFaQzrjvsfutUODv22",
+	"T88A+nTOkEOEV/sxCIHnrad4phq+te36HqPu/M4WK+9CubrDa1OR+uS4cw9VFJzegbxZ2IrHiSUaLdZE",
+	"wFUw4rYymq3bbQUgwiZGQekbBEgba4v0KtACMJdTwHLUMQ3aOlXSwZMytDlUKHMMIbFMm9U6P4NElqkI",
+	"J9nrjuV0OwbKUKlWCxVQIjYndD/BQiinMdNBMjQDGSz0i5nHxskDc6OrFTg2/8iOWk/T8GzQCHVu4N+I",
+	"kYnO/OgMYibvghuZ5Tzia6uOhebN335lmTablZY3fpE46nLY6mrr0/6MhHnzu1CNNGHGHGSujDJOu+M8",
+	"s54uA6Tp5GkxPItan5Q29f8bAA==",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
@@ -10,10 +10,15 @@ import (
 
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/opensvc/om3/v3/core/actionplan"
 	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/maintenance"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/nodesinfo"
+	nspolicy "github.com/opensvc/om3/v3/core/nspolicy"
 	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/util/pg"
+	sysreport "github.com/opensvc/om3/v3/util/sysreport"
 )
 
 const (
@@ -36,6 +41,36 @@ func (e ArrayListKind) Valid() bool {
 	}
 }
 
+// Defines values for AuditRecordItemKind.
+const (
+	AuditRecordItemKindAuditRecordItem AuditRecordItemKind = "AuditRecordItem"
+)
+
+// Valid indicates whether the value is a known member of the AuditRecordItemKind enum.
+func (e AuditRecordItemKind) Valid() bool {
+	switch e {
+	case AuditRecordItemKindAuditRecordItem:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AuditRecordListKind.
+const (
+	AuditRecordListKindAuditRecordList AuditRecordListKind = "AuditRecordList"
+)
+
+// Valid indicates whether the value is a known member of the AuditRecordListKind enum.
+func (e AuditRecordListKind) Valid() bool {
+	switch e {
+	case AuditRecordListKindAuditRecordList:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for AuthInfoMethods.
 const (
 	Basic   AuthInfoMethods = "basic"
@@ -90,6 +125,21 @@ func (e CapabilityListKind) Valid() bool {
 	}
 }
 
+// Defines values for ConfigRevisionListKind.
+const (
+	ConfigRevisionListKindConfigRevisionList ConfigRevisionListKind = "ConfigRevisionList"
+)
+
+// Valid indicates whether the value is a known member of the ConfigRevisionListKind enum.
+func (e ConfigRevisionListKind) Valid() bool {
+	switch e {
+	case ConfigRevisionListKindConfigRevisionList:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for DataKeyListKind.
 const (
 	DataKeyListKindDataKeyList DataKeyListKind = "DataKeyList"
@@ -315,6 +365,21 @@ func (e KeywordListKind) Valid() bool {
 	}
 }
 
+// Defines values for NamespaceUsageListKind.
+const (
+	NamespaceUsageListKindNamespaceUsageList NamespaceUsageListKind = "NamespaceUsageList"
+)
+
+// Valid indicates whether the value is a known member of the NamespaceUsageListKind enum.
+func (e NamespaceUsageListKind) Valid() bool {
+	switch e {
+	case NamespaceUsageListKindNamespaceUsageList:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for NetworkIPListKind.
 const (
 	NetworkIPListKindNetworkIPList NetworkIPListKind = "NetworkIPList"
@@ -431,19 +496,19 @@ func (e ObjectListKind) Valid() bool {
 
 // Defines values for Orchestrate.
 const (
-	Ha    Orchestrate = "ha"
-	No    Orchestrate = "no"
-	Start Orchestrate = "start"
+	OrchestrateHa    Orchestrate = "ha"
+	OrchestrateNo    Orchestrate = "no"
+	OrchestrateStart Orchestrate = "start"
 )
 
 // Valid indicates whether the value is a known member of the Orchestrate enum.
 func (e Orchestrate) Valid() bool {
 	switch e {
-	case Ha:
+	case OrchestrateHa:
 		return true
-	case No:
+	case OrchestrateNo:
 		return true
-	case Start:
+	case OrchestrateStart:
 		return true
 	default:
 		return false
@@ -855,6 +920,21 @@ func (e Status) Valid() bool {
 	}
 }
 
+// Defines values for SysreportSnapshotListKind.
+const (
+	SysreportSnapshotListKindSysreportSnapshotList SysreportSnapshotListKind = "SysreportSnapshotList"
+)
+
+// Valid indicates whether the value is a known member of the SysreportSnapshotListKind enum.
+func (e SysreportSnapshotListKind) Valid() bool {
+	switch e {
+	case SysreportSnapshotListKindSysreportSnapshotList:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Topology.
 const (
 	Failover Topology = "failover"
@@ -930,6 +1010,30 @@ func (e PostDaemonAuditParamsLevel) Valid() bool {
 	}
 }
 
+// Defines values for GetInstancePlanParamsAction.
+const (
+	GetInstancePlanParamsActionProvision   GetInstancePlanParamsAction = "provision"
+	GetInstancePlanParamsActionStart       GetInstancePlanParamsAction = "start"
+	GetInstancePlanParamsActionStop        GetInstancePlanParamsAction = "stop"
+	GetInstancePlanParamsActionUnprovision GetInstancePlanParamsAction = "unprovision"
+)
+
+// Valid indicates whether the value is a known member of the GetInstancePlanParamsAction enum.
+func (e GetInstancePlanParamsAction) Valid() bool {
+	switch e {
+	case GetInstancePlanParamsActionProvision:
+		return true
+	case GetInstancePlanParamsActionStart:
+		return true
+	case GetInstancePlanParamsActionStop:
+		return true
+	case GetInstancePlanParamsActionUnprovision:
+		return true
+	default:
+		return false
+	}
+}
+
 // ArbitratorStatus defines model for ArbitratorStatus.
 type ArbitratorStatus struct {
 	// Status Represents a resource, instance or object status, e.g., 'up', 'down', 'warn', ....
@@ -956,12 +1060,75 @@ type ArrayList struct {
 // ArrayListKind defines model for ArrayList.Kind.
 type ArrayListKind string
 
+// AuditRecord defines model for AuditRecord.
+type AuditRecord struct {
+	// Address the request source address
+	Address string    `json:"address"`
+	At      time.Time `json:"at"`
+
+	// Duration the request duration in nanoseconds
+	Duration int64    `json:"duration"`
+	Grants   []string `json:"grants"`
+	Issuer   *string  `json:"issuer,omitempty"`
+	Method   string   `json:"method"`
+
+	// Node the node serving the request
+	Node string `json:"node"`
+
+	// Object the object path targeted by the request, if any
+	Object    *string `json:"object,omitempty"`
+	RequestId string  `json:"request_id"`
+	Route     string  `json:"route"`
+	Status    int     `json:"status"`
+
+	// Strategy the authentication strategy
+	Strategy string `json:"strategy"`
+
+	// Target the node name targeted by the request, if any
+	Target *string `json:"target,omitempty"`
+	Uri    string  `json:"uri"`
+	User   string  `json:"user"`
+}
+
+// AuditRecordItem defines model for AuditRecordItem.
+type AuditRecordItem struct {
+	Data AuditRecord         `json:"data"`
+	Kind AuditRecordItemKind `json:"kind"`
+	Meta NodeMeta            `json:"meta"`
+}
+
+// AuditRecordItemKind defines model for AuditRecordItem.Kind.
+type AuditRecordItemKind string
+
+// AuditRecordItems defines model for AuditRecordItems.
+type AuditRecordItems = []AuditRecordItem
+
+// AuditRecordList defines model for AuditRecordList.
+type AuditRecordList struct {
+	Items AuditRecordItems    `json:"items"`
+	Kind  AuditRecordListKind `json:"kind"`
+}
+
+// AuditRecordListKind defines model for AuditRecordList.Kind.
+type AuditRecordListKind string
+
 // AuthAccessToken defines model for AuthAccessToken.
 type AuthAccessToken struct {
 	AccessExpiredAt time.Time `json:"access_expired_at"`
 	AccessToken     string    `json:"access_token"`
 }
 
+// AuthDecision defines model for AuthDecision.
+type AuthDecision struct {
+	Allowed bool `json:"allowed"`
+
+	// Grant the grant allowing the verb
+	Grant  *string `json:"grant,omitempty"`
+	Path   string  `json:"path"`
+	Reason string  `json:"reason"`
+	Verb   string  `json:"verb"`
+}
+
 // AuthInfo defines model for AuthInfo.
 type AuthInfo struct {
 	Methods []AuthInfoMethods `json:"methods"`
@@ -1023,6 +1190,29 @@ type Committed struct {
 	IsChanged bool `json:"is_changed"`
 }
 
+// ConfigRevision defines model for ConfigRevision.
+type ConfigRevision struct {
+	Author    string    `json:"author"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        int       `json:"id"`
+	Node      string    `json:"node"`
+	Ops       []string  `json:"ops"`
+	Size      int       `json:"size"`
+}
+
+// ConfigRevisionItems defines model for ConfigRevisionItems.
+type ConfigRevisionItems = []ConfigRevision
+
+// ConfigRevisionList defines model for ConfigRevisionList.
+type ConfigRevisionList struct {
+	Items ConfigRevisionItems    `json:"items"`
+	Kind  ConfigRevisionListKind `json:"kind"`
+}
+
+// ConfigRevisionListKind defines model for ConfigRevisionList.Kind.
+type ConfigRevisionListKind string
+
 // DNSRecord defines model for DNSRecord.
 type DNSRecord struct {
 	Class string `json:"class"`
@@ -1324,6 +1514,9 @@ type InstanceMeta struct {
 // InstanceMonitor defines model for InstanceMonitor.
 type InstanceMonitor = instance.Monitor
 
+// InstancePlan defines model for InstancePlan.
+type InstancePlan = actionplan.T
+
 // InstanceStatus defines model for InstanceStatus.
 type InstanceStatus = instance.Status
 
@@ -1393,6 +1586,21 @@ type LogControlBody = LogControl
 // LogList responseLogList is a list of sse
 type LogList = openapi_types.File
 
+// MaintenanceWindow defines model for MaintenanceWindow.
+type MaintenanceWindow = maintenance.Window
+
+// NamespaceUsage defines model for NamespaceUsage.
+type NamespaceUsage = nspolicy.Report
+
+// NamespaceUsageList defines model for NamespaceUsageList.
+type NamespaceUsageList struct {
+	Items []NamespaceUsage       `json:"items"`
+	Kind  NamespaceUsageListKind `json:"kind"`
+}
+
+// NamespaceUsageListKind defines model for NamespaceUsageList.Kind.
+type NamespaceUsageListKind string
+
 // Network defines model for Network.
 type Network struct {
 	Errors  *[]string `json:"errors,omitempty"`
@@ -1463,6 +1671,8 @@ type NodeConfig struct {
 	Hooks                  []NodeConfigHook     `json:"hooks"`
 	Labels                 map[string]string    `json:"labels"`
 	MaintenanceGracePeriod time.Duration        `json:"maintenance_grace_period"`
+	MaintenanceMode        string               `json:"maintenance_mode"`
+	MaintenanceWindow      string               `json:"maintenance_window"`
 	MaxParallel            int                  `json:"max_parallel"`
 	MinAvailMemPct         int                  `json:"min_avail_mem_pct"`
 	MinAvailSwapPct        int                  `json:"min_avail_swap_pct"`
@@ -1553,14 +1763,15 @@ type NodeMonitor struct {
 
 // NodeStatus defines model for NodeStatus.
 type NodeStatus struct {
-	Agent        string                      `json:"agent"`
-	API          string                      `json:"api"`
-	Arbitrators  map[string]ArbitratorStatus `json:"arbitrators"`
-	Compat       uint64                      `json:"compat"`
-	FrozenAt     time.Time                   `json:"frozen_at"`
-	Gen          map[string]uint64           `json:"gen"`
-	IsLeader     bool                        `json:"is_leader"`
-	IsOverloaded bool                        `json:"is_overloaded"`
+	Agent             string                      `json:"agent"`
+	API               string                      `json:"api"`
+	Arbitrators       map[string]ArbitratorStatus `json:"arbitrators"`
+	Compat            uint64                      `json:"compat"`
+	FrozenAt          time.Time                   `json:"frozen_at"`
+	Gen               map[string]uint64           `json:"gen"`
+	IsLeader          bool                        `json:"is_leader"`
+	IsOverloaded      bool                        `json:"is_overloaded"`
+	MaintenanceWindow *MaintenanceWindow          `json:"maintenance_window,omitempty"`
 }
 
 // NodesInfo defines model for NodesInfo.
@@ -1856,6 +2067,12 @@ type PostNodeDRBDConfigRequest struct {
 	Data         []byte             `json:"data"`
 }
 
+// PostObjectActionMigrate defines model for PostObjectActionMigrate.
+type PostObjectActionMigrate struct {
+	// Destination The node to live migrate the instance to.
+	Destination string `json:"destination"`
+}
+
 // PostObjectActionRestart defines model for PostObjectActionRestart.
 type PostObjectActionRestart struct {
 	Force *bool `json:"force,omitempty"`
@@ -1863,6 +2080,8 @@ type PostObjectActionRestart struct {
 
 // PostObjectActionSwitch defines model for PostObjectActionSwitch.
 type PostObjectActionSwitch struct {
+	// BlueGreen Prewarm and verify the destination instance before stopping the source instance.
+	BlueGreen   *bool    `json:"blue_green,omitempty"`
 	Destination []string `json:"destination"`
 	Live        bool     `json:"live"`
 }
@@ -1875,6 +2094,17 @@ type PostRelayMessage struct {
 	Nodename    string `json:"nodename"`
 }
 
+// Pressure the pressure stall information of the object cgroup, reported on the
+// cgroup v2 hosts with PSI support. The instance status reports the
+// averages rounded to the whole percent, and only if not all zero.
+type Pressure = pg.Pressure
+
+// PressureLine defines model for PressureLine.
+type PressureLine = pg.PressureLine
+
+// PressureStat defines model for PressureStat.
+type PressureStat = pg.PressureStat
+
 // Priority Lower priority are orchestrated first.
 type Priority = int
 
@@ -2005,6 +2235,9 @@ type Resource struct {
 	Status  *ResourceStatus  `json:"status,omitempty"`
 }
 
+// ResourceActionTimeout defines model for ResourceActionTimeout.
+type ResourceActionTimeout = resource.ActionTimeout
+
 // ResourceConfig defines model for ResourceConfig.
 type ResourceConfig = instance.ResourceConfig
 
@@ -2018,6 +2251,9 @@ type ResourceFile struct {
 // ResourceFiles defines model for ResourceFiles.
 type ResourceFiles = []ResourceFile
 
+// ResourceImageStatus defines model for ResourceImageStatus.
+type ResourceImageStatus = resource.ImageStatus
+
 // ResourceInfoItem defines model for ResourceInfoItem.
 type ResourceInfoItem struct {
 	Key    string `json:"key"`
@@ -2229,6 +2465,18 @@ type SubsetConfig = instance.SubsetConfig
 // SubsetsConfig defines model for SubsetsConfig.
 type SubsetsConfig = []SubsetConfig
 
+// SysreportSnapshot defines model for SysreportSnapshot.
+type SysreportSnapshot = sysreport.Snapshot
+
+// SysreportSnapshotList defines model for SysreportSnapshotList.
+type SysreportSnapshotList struct {
+	Items []SysreportSnapshot       `json:"items"`
+	Kind  SysreportSnapshotListKind `json:"kind"`
+}
+
+// SysreportSnapshotListKind defines model for SysreportSnapshotList.Kind.
+type SysreportSnapshotListKind string
+
 // Topology object topology
 type Topology string
 
@@ -2287,9 +2535,15 @@ type EventCache = bool
 // EventFilter defines model for EventFilter.
 type EventFilter = []string
 
+// EventLastEventID defines model for EventLastEventID.
+type EventLastEventID = uint64
+
 // EventReplay defines model for EventReplay.
 type EventReplay = bool
 
+// EventSince defines model for EventSince.
+type EventSince = uint64
+
 // Limit defines model for Limit.
 type Limit = int64
 
@@ -2357,6 +2611,9 @@ type InPathNamespace = string
 // the node that received the request.
 type InPathNodeName = string
 
+// InPathRev The configuration revision identifier.
+type InPathRev = int
+
 // InQueryAllSlaves Act on all encap instances, and don't act on the host instance if not asked for explicitely.
 type InQueryAllSlaves = bool
 
@@ -2516,6 +2773,17 @@ type GetArrayParams struct {
 	Name *InQueryArrayName `form:"name,omitempty" json:"name,omitempty"`
 }
 
+// GetAuthCanIParams defines parameters for GetAuthCanI.
+type GetAuthCanIParams struct {
+	// Verb the verb to evaluate: get, decode, set, set_key, an object or
+	// instance action name like start, or console, clear, enable,
+	// disable.
+	Verb string `form:"verb" json:"verb"`
+
+	// Path the object path
+	Path string `form:"path" json:"path"`
+}
+
 // PostAuthRefreshParams defines parameters for PostAuthRefresh.
 type PostAuthRefreshParams struct {
 	// Role list of api role
@@ -2605,6 +2873,12 @@ type GetInstancesParams struct {
 	Node *NodeOptional `form:"node,omitempty" json:"node,omitempty"`
 }
 
+// GetNamespaceUsageParams defines parameters for GetNamespaceUsage.
+type GetNamespaceUsageParams struct {
+	// Namespace namespace
+	Namespace *InQueryNamespaceOptional `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
 // GetNetworksParams defines parameters for GetNetworks.
 type GetNetworksParams struct {
 	// Name the name of a cluster backend network
@@ -2725,6 +2999,36 @@ type PostDaemonAuditParams struct {
 // PostDaemonAuditParamsLevel defines parameters for PostDaemonAudit.
 type PostDaemonAuditParamsLevel string
 
+// GetDaemonAuditLogParams defines parameters for GetDaemonAuditLog.
+type GetDaemonAuditLogParams struct {
+	// Since select the records more recent than this duration ago or this RFC3339 date
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until select the records older than this duration ago or this RFC3339 date
+	Until *string `form:"until,omitempty" json:"until,omitempty"`
+
+	// User select the records of this user
+	User *string `form:"user,omitempty" json:"user,omitempty"`
+
+	// Method select the records with this http method
+	Method *string `form:"method,omitempty" json:"method,omitempty"`
+
+	// Path select the records targeting this object path
+	Path *string `form:"path,omitempty" json:"path,omitempty"`
+
+	// Target select the records targeting this node name
+	Target *string `form:"target,omitempty" json:"target,omitempty"`
+
+	// Route select the records with a route containing this string
+	Route *string `form:"route,omitempty" json:"route,omitempty"`
+
+	// Failed select the records with a status code >= 400
+	Failed *bool `form:"failed,omitempty" json:"failed,omitempty"`
+
+	// Limit the maximum number of records returned, the most recent being kept
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetDaemonEventsParams defines parameters for GetDaemonEvents.
 type GetDaemonEventsParams struct {
 	// Duration max duration
@@ -2743,8 +3047,19 @@ type GetDaemonEventsParams struct {
 	// Cache deprecated alias of `replay`. Will be removed in a future version.
 	Cache *EventCache `form:"cache,omitempty" json:"cache,omitempty"`
 
+	// Since resume the event stream after the event with this id. The journaled
+	// events published after this id are sent before the live events.
+	// An EventGap event is sent first if some of these events were evicted
+	// from the journal.
+	Since *EventSince `form:"since,omitempty" json:"since,omitempty"`
+
 	// Selector selector
 	Selector *InQuerySelectorOptional `form:"selector,omitempty" json:"selector,omitempty"`
+
+	// LastEventID the id of the last event received by a reconnecting server-sent
+	// events client. It has the same effect as `since`, which takes
+	// precedence.
+	LastEventID *EventLastEventID `json:"Last-Event-ID,omitempty"`
 }
 
 // DeleteDaemonProcessParams defines parameters for DeleteDaemonProcess.
@@ -3065,6 +3380,21 @@ type GetInstanceLogsParams struct {
 	Lines *LogLines `form:"lines,omitempty" json:"lines,omitempty"`
 }
 
+// GetInstancePlanParams defines parameters for GetInstancePlan.
+type GetInstancePlanParams struct {
+	Action GetInstancePlanParamsAction `form:"action" json:"action"`
+	Force  *InQueryForce               `form:"force,omitempty" json:"force,omitempty"`
+
+	// Rid a resource selector expression
+	Rid    *InQueryRid    `form:"rid,omitempty" json:"rid,omitempty"`
+	Subset *InQuerySubset `form:"subset,omitempty" json:"subset,omitempty"`
+	Tag    *InQueryTag    `form:"tag,omitempty" json:"tag,omitempty"`
+	To     *InQueryTo     `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetInstancePlanParamsAction defines parameters for GetInstancePlan.
+type GetInstancePlanParamsAction string
+
 // GetInstanceResourceFileParams defines parameters for GetInstanceResourceFile.
 type GetInstanceResourceFileParams struct {
 	// Name The path of a file explicitely shared by a resource driver that the requester wants to receive the data of.
@@ -3234,6 +3564,9 @@ type PostDaemonLogControlJSONRequestBody = LogControlBody
 // PostNodeDRBDConfigJSONRequestBody defines body for PostNodeDRBDConfig for application/json ContentType.
 type PostNodeDRBDConfigJSONRequestBody = PostNodeDRBDConfigRequest
 
+// PostObjectActionMigrateJSONRequestBody defines body for PostObjectActionMigrate for application/json ContentType.
+type PostObjectActionMigrateJSONRequestBody = PostObjectActionMigrate
+
 // PostObjectActionRestartJSONRequestBody defines body for PostObjectActionRestart for application/json ContentType.
 type PostObjectActionRestartJSONRequestBody = PostObjectActionRestart
 
@@ -0,0 +1,269 @@
+// Package auditlog keeps a durable journal of the mutating daemon api
+// requests.
+//
+// Each record describes who called which route on which target, with
+// which result. The records are appended as json lines to a local journal
+// file, rotated by size, and optionally forwarded to a http endpoint.
+package auditlog
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	// Record describes a mutating api request.
+	Record struct {
+		At        time.Time     `json:"at"`
+		RequestID string        `json:"request_id"`
+		Node      string        `json:"node"`
+		User      string        `json:"user"`
+		Strategy  string        `json:"strategy"`
+		Issuer    string        `json:"issuer,omitempty"`
+		Grants    []string      `json:"grants"`
+		Address   string        `json:"address"`
+		Method    string        `json:"method"`
+		Route     string        `json:"route"`
+		URI       string        `json:"uri"`
+		Object    string        `json:"object,omitempty"`
+		Target    string        `json:"target,omitempty"`
+		Status    int           `json:"status"`
+		Duration  time.Duration `json:"duration"`
+	}
+
+	Records []Record
+
+	// Filter selects the records returned by Read. The zero value of
+	// each field disables the associated filtering.
+	Filter struct {
+		Since  time.Time
+		Until  time.Time
+		User   string
+		Method string
+		Object string
+		Target string
+
+		// Route selects the records with a route containing this string.
+		Route string
+
+		// Failed selects the records with a status code >= 400.
+		Failed bool
+
+		// Limit is the maximum number of records returned, the most
+		// recent being kept.
+		Limit int
+	}
+
+	journal struct {
+		sync.Mutex
+		w       *lumberjack.Logger
+		forward chan forwardRequest
+		log     *plog.Logger
+	}
+
+	forwardRequest struct {
+		url     string
+		timeout time.Duration
+		b       []byte
+	}
+)
+
+const (
+	fileName = "audit.log"
+
+	// forwardQueueSize is the number of records waiting to be forwarded
+	// beyond which new records are not forwarded.
+	forwardQueueSize = 1000
+)
+
+var (
+	std = &journal{
+		log: plog.NewDefaultLogger().Attr("pkg", "daemon/auditlog").WithPrefix("daemon: auditlog: "),
+	}
+)
+
+// File returns the path of the current journal file. The rotated files
+// are in the same directory.
+func File() string {
+	return filepath.Join(rawconfig.Paths.Log, fileName)
+}
+
+// Append writes the record to the journal, rotating the journal file if
+// config.MaxSize is reached, and queues the record for forwarding if
+// config.ForwardURL is set.
+func Append(config cluster.ConfigAudit, r Record) error {
+	return std.append(config, r)
+}
+
+func (t *journal) append(config cluster.ConfigAudit, r Record) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	t.Lock()
+	defer t.Unlock()
+	if filename := File(); t.w == nil || t.w.Filename != filename {
+		if t.w != nil {
+			_ = t.w.Close()
+		}
+		t.w = &lumberjack.Logger{
+			Filename: filename,
+		}
+	}
+	if config.MaxSize > 0 {
+		// lumberjack sizes are in megabytes
+		t.w.MaxSize = int((config.MaxSize + 1024*1024 - 1) / (1024 * 1024))
+	}
+	t.w.MaxBackups = config.MaxBackups
+	if _, err := t.w.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	if config.ForwardURL != "" {
+		t.queue(forwardRequest{url: config.ForwardURL, timeout: config.ForwardTimeout, b: b})
+	}
+	return nil
+}
+
+// queue hands the record to the forwarder goroutine, started on first use.
+// The record is dropped if the forwarder is lagging.
+func (t *journal) queue(req forwardRequest) {
+	if t.forward == nil {
+		t.forward = make(chan forwardRequest, forwardQueueSize)
+		go t.forwarder(t.forward)
+	}
+	select {
+	case t.forward <- req:
+	default:
+		t.log.Warnf("forward queue is full: drop record")
+	}
+}
+
+func (t *journal) forwarder(c <-chan forwardRequest) {
+	for req := range c {
+		if err := forward(req); err != nil {
+			t.log.Warnf("forward to %s: %s", req.url, err)
+		}
+	}
+}
+
+func forward(req forwardRequest) error {
+	timeout := req.timeout
+	if timeout == 0 {
+		timeout = 5 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, req.url, bytes.NewReader(req.b))
+	if err != nil {
+		return err
+	}
+	r.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(r)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// files returns the journal files, from the oldest to the current.
+//
+// The rotated files are named audit-<timestamp>.log, which sort before
+// audit.log.
+func files() ([]string, error) {
+	ext := filepath.Ext(fileName)
+	prefix := strings.TrimSuffix(fileName, ext)
+	l, err := filepath.Glob(filepath.Join(rawconfig.Paths.Log, prefix+"*"+ext))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(l)
+	return l, nil
+}
+
+// Read returns the journal records selected by filter, ordered from the
+// oldest to the most recent.
+func Read(filter Filter) (Records, error) {
+	l, err := files()
+	if err != nil {
+		return nil, err
+	}
+	records := make(Records, 0)
+	for _, name := range l {
+		more, err := readFile(name, filter)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, more...)
+	}
+	if filter.Limit > 0 && len(records) > filter.Limit {
+		records = records[len(records)-filter.Limit:]
+	}
+	return records, nil
+}
+
+func readFile(name string, filter Filter) (Records, error) {
+	records := make(Records, 0)
+	f, err := os.Open(name)
+	if os.IsNotExist(err) {
+		return records, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var r Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			// skip a record truncated by a crash
+			continue
+		}
+		if filter.Match(r) {
+			records = append(records, r)
+		}
+	}
+	return records, scanner.Err()
+}
+
+// Match returns true if the record is selected by the filter.
+func (t Filter) Match(r Record) bool {
+	switch {
+	case !t.Since.IsZero() && r.At.Before(t.Since):
+		return false
+	case !t.Until.IsZero() && r.At.After(t.Until):
+		return false
+	case t.User != "" && r.User != t.User:
+		return false
+	case t.Method != "" && !strings.EqualFold(r.Method, t.Method):
+		return false
+	case t.Object != "" && r.Object != t.Object:
+		return false
+	case t.Target != "" && r.Target != t.Target:
+		return false
+	case t.Route != "" && !strings.Contains(r.Route, t.Route):
+		return false
+	case t.Failed && r.Status < 400:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+package auditlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/testhelper"
+)
+
+func TestAppendAndRead(t *testing.T) {
+	testhelper.Setup(t)
+	now := time.Now()
+	records := Records{
+		{At: now.Add(-2 * time.Hour), User: "root", Method: "POST", Route: "/api/object/path/:namespace/:kind/:name/action/start", Object: "svc1", Status: 200},
+		{At: now.Add(-time.Hour), User: "alice", Method: "PATCH", Route: "/api/object/path/:namespace/:kind/:name/config", Object: "svc2", Status: 403},
+		{At: now, User: "root", Method: "DELETE", Route: "/api/object/path/:namespace/:kind/:name/data/key", Object: "svc1", Status: 200},
+	}
+	for _, r := range records {
+		require.NoError(t, Append(cluster.ConfigAudit{}, r))
+	}
+
+	t.Run("no filter", func(t *testing.T) {
+		l, err := Read(Filter{})
+		require.NoError(t, err)
+		require.Len(t, l, 3)
+		require.Equal(t, "POST", l[0].Method)
+	})
+
+	t.Run("user and object filters", func(t *testing.T) {
+		l, err := Read(Filter{User: "root", Object: "svc1"})
+		require.NoError(t, err)
+		require.Len(t, l, 2)
+	})
+
+	t.Run("failed filter", func(t *testing.T) {
+		l, err := Read(Filter{Failed: true})
+		require.NoError(t, err)
+		require.Len(t, l, 1)
+		require.Equal(t, "alice", l[0].User)
+	})
+
+	t.Run("since and limit", func(t *testing.T) {
+		l, err := Read(Filter{Since: now.Add(-90 * time.Minute)})
+		require.NoError(t, err)
+		require.Len(t, l, 2)
+
+		l, err = Read(Filter{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, l, 1)
+		require.Equal(t, "DELETE", l[0].Method)
+	})
+
+	t.Run("route filter", func(t *testing.T) {
+		l, err := Read(Filter{Route: "/config"})
+		require.NoError(t, err)
+		require.Len(t, l, 1)
+		require.Equal(t, "PATCH", l[0].Method)
+	})
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/opensvc/om3/v3/daemon/netmon"
 	"github.com/opensvc/om3/v3/daemon/nmon"
 	"github.com/opensvc/om3/v3/daemon/pgmetrics"
+	"github.com/opensvc/om3/v3/daemon/remotemon"
 	"github.com/opensvc/om3/v3/daemon/runner"
 	"github.com/opensvc/om3/v3/daemon/scheduler"
 	"github.com/opensvc/om3/v3/util/converters"
@@ -140,7 +141,10 @@ func (t *T) Start(ctx context.Context) error {
 	bus.SetDefaultSubscriptionQueueSize(defaultSubscriptionQueueSize)
 	bus.SetDrainChanDuration(3 * daemonenv.DrainChanDuration)
 	bus.SetPanicOnFullQueue(10 * time.Second)
+	journal := msgbus.NewJournal(msgbus.DefaultJournalSize)
+	bus.SetRecorder(journal)
 	t.ctx = pubsub.ContextWithBus(t.ctx, bus)
+	t.ctx = msgbus.ContextWithJournal(t.ctx, journal)
 	t.wg.Add(1)
 	bus.Start(t.ctx)
 	bus.EnableBufferPublication(20000)
@@ -247,6 +251,7 @@ func (t *T) Start(ctx context.Context) error {
 		hook.NewManager(daemonenv.DrainChanDuration, qsSmall),
 		dns.NewManager(daemonenv.DrainChanDuration, qsMedium),
 		pgmetrics.New(qsMedium),
+		remotemon.New(qsSmall),
 		discover.NewManager(daemonenv.DrainChanDuration, qsHuge).
 			WithOmonSubQS(qsMedium).
 			WithImonStarter(imonFactory),
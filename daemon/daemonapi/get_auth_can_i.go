@@ -0,0 +1,34 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+// GetAuthCanI explains if the authenticated user grants allow a verb on an
+// object.
+func (a *DaemonAPI) GetAuthCanI(ctx echo.Context, params api.GetAuthCanIParams) error {
+	p, err := naming.ParsePath(params.Path)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "path: %s", err)
+	}
+	if params.Verb == "" {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "verb: empty")
+	}
+	decision := customRoles().Explain(grantsFromContext(ctx), params.Verb, p.Kind.String(), p.Namespace)
+	data := api.AuthDecision{
+		Allowed: decision.Allowed,
+		Path:    p.String(),
+		Reason:  decision.Reason,
+		Verb:    params.Verb,
+	}
+	if decision.Grant != "" {
+		grant := decision.Grant.String()
+		data.Grant = &grant
+	}
+	return ctx.JSON(http.StatusOK, data)
+}
@@ -0,0 +1,108 @@
+package daemonapi
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/daemon/auditlog"
+	"github.com/opensvc/om3/v3/util/converters"
+)
+
+func (a *DaemonAPI) GetDaemonAuditLog(ctx echo.Context, nodename api.InPathNodeName, params api.GetDaemonAuditLogParams) error {
+	if v, err := assertRoot(ctx); !v {
+		return err
+	}
+	nodename = a.parseNodename(nodename)
+	if a.localhost == nodename {
+		return a.getLocalDaemonAuditLog(ctx, params)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.GetDaemonAuditLog(ctx.Request().Context(), nodename, &params)
+	})
+}
+
+func (a *DaemonAPI) getLocalDaemonAuditLog(ctx echo.Context, params api.GetDaemonAuditLogParams) error {
+	var (
+		filter auditlog.Filter
+		err    error
+	)
+	if params.Since != nil {
+		if filter.Since, err = parseAuditTime(*params.Since); err != nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "since: %s", err)
+		}
+	}
+	if params.Until != nil {
+		if filter.Until, err = parseAuditTime(*params.Until); err != nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "until: %s", err)
+		}
+	}
+	if params.User != nil {
+		filter.User = *params.User
+	}
+	if params.Method != nil {
+		filter.Method = *params.Method
+	}
+	if params.Path != nil {
+		filter.Object = *params.Path
+	}
+	if params.Target != nil {
+		filter.Target = *params.Target
+	}
+	if params.Route != nil {
+		filter.Route = *params.Route
+	}
+	if params.Failed != nil {
+		filter.Failed = *params.Failed
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	records, err := auditlog.Read(filter)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Read audit journal", "%s", err)
+	}
+	items := make(api.AuditRecordItems, len(records))
+	for i, r := range records {
+		items[i] = api.AuditRecordItem{
+			Kind: "AuditRecordItem",
+			Meta: api.NodeMeta{
+				Node: a.localhost,
+			},
+			Data: api.AuditRecord{
+				At:        r.At,
+				RequestId: r.RequestID,
+				Node:      r.Node,
+				User:      r.User,
+				Strategy:  r.Strategy,
+				Issuer:    &r.Issuer,
+				Grants:    append([]string{}, r.Grants...),
+				Address:   r.Address,
+				Method:    r.Method,
+				Route:     r.Route,
+				Uri:       r.URI,
+				Object:    &r.Object,
+				Target:    &r.Target,
+				Status:    r.Status,
+				Duration:  int64(r.Duration),
+			},
+		}
+	}
+	return ctx.JSON(http.StatusOK, api.AuditRecordList{Kind: "AuditRecordList", Items: items})
+}
+
+// parseAuditTime parses s as a RFC3339 date, or as a duration before now.
+func parseAuditTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	d, err := converters.ParseDuration(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s is neither a RFC3339 date nor a duration", s)
+	}
+	return time.Now().Add(-d), nil
+}
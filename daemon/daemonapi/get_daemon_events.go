@@ -156,6 +156,11 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		// hasSelector is true when param.Selector is defined and not ""
 		hasSelector bool
 
+		// hasStatusSelector is true when param.Selector contains status
+		// expressions, so the selection must be refreshed on object status
+		// updates.
+		hasStatusSelector bool
+
 		// pathL list of all cluster object paths
 		pathL naming.Paths
 
@@ -177,6 +182,20 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		// dataFiltersByKind is a map of DataFilters indexed on event kind.
 		dataFiltersByKind = make(map[string]DataFilters)
 
+		// subFilters are the filters added to the bus subscription, used
+		// to select the journaled events of a resumed stream.
+		subFilters []Filter
+
+		// resume is true when the stream must resume after the event id
+		// since, from params.Since or params.LastEventID.
+		resume bool
+		since  uint64
+
+		// lastID is the id of the last event read from the bus or the
+		// journal. It is the id of the events not published on the bus,
+		// like the replayed events.
+		lastID uint64
+
 		evCtx  = ctx.Request().Context()
 		cancel context.CancelFunc
 	)
@@ -239,8 +258,60 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		return true
 	}
 
+	// matchSubFilters returns true if the journaled message i would have
+	// been routed to the bus subscription.
+	matchSubFilters := func(i any) bool {
+		msg, ok := i.(pubsub.Messager)
+		if !ok {
+			return false
+		}
+		if len(subFilters) == 0 {
+			return true
+		}
+		labels := msg.GetLabels()
+		for _, filter := range subFilters {
+			if filter.Kind != nil && reflect.TypeOf(filter.Kind) != reflect.TypeOf(i) {
+				continue
+			}
+			matched := true
+			for _, label := range filter.Labels {
+				if v, ok := labels[label[0]]; !ok || v != label[1] {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				return true
+			}
+		}
+		return false
+	}
+
+	// eventID returns the id of the event from message i: the journal id
+	// of a published message, the last read id otherwise.
+	eventID := func(i any) uint64 {
+		if a.Journal == nil {
+			return eventCount
+		}
+		if id, ok := a.Journal.ID(i); ok {
+			lastID = id
+		}
+		return lastID
+	}
+
 	if params.Selector != nil && *params.Selector != "" {
 		hasSelector = true
+		hasStatusSelector = objectselector.HasStatusExpression(*params.Selector)
+	}
+	if params.Since != nil {
+		resume = true
+		since = *params.Since
+	} else if params.LastEventID != nil {
+		resume = true
+		since = *params.LastEventID
+	}
+	if resume && a.Journal == nil {
+		return JSONProblemf(ctx, http.StatusServiceUnavailable, "Resume event stream", "the event journal is not available")
 	}
 	if params.Limit != nil {
 		limit = uint64(*params.Limit)
@@ -296,12 +367,16 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 			continue
 		}
 		sub.AddFilter(filter.Kind, filter.Labels...)
+		subFilters = append(subFilters, filter)
 	}
 	if hasSelector && len(requestedFilterByFilterIdentifier) == 0 {
 		// no filters => all events must be forwarded, add ObjectCreated &
 		// ObjectDeleted to requestedFilterByFilterIdentifier to simulate client has asked for them
 		requestedFilterByFilterIdentifier["ObjectCreated:"] = nil
 		requestedFilterByFilterIdentifier["ObjectDeleted:{node="+a.localhost+"}"] = nil
+		if hasStatusSelector {
+			requestedFilterByFilterIdentifier["ObjectStatusUpdated:"] = nil
+		}
 	}
 
 	if hasSelector {
@@ -314,12 +389,23 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		if !needForwardEvent("ObjectCreated", createdMsg) {
 			log.Tracef("add hidden filtering: ObjectCreated")
 			sub.AddFilter(&msgbus.ObjectCreated{})
+			subFilters = append(subFilters, Filter{Kind: &msgbus.ObjectCreated{}})
 		}
 		deleteMsg := &msgbus.ObjectDeleted{}
 		deleteMsg.AddLabels(a.LabelLocalhost)
 		if !needForwardEvent("ObjectDeleted", deleteMsg) {
 			log.Tracef("add hidden filtering: ObjectDeleted,node=%s", a.localhost)
 			sub.AddFilter(&msgbus.ObjectDeleted{}, a.LabelLocalhost)
+			subFilters = append(subFilters, Filter{Kind: &msgbus.ObjectDeleted{}, Labels: []pubsub.Label{a.LabelLocalhost}})
+		}
+		if hasStatusSelector {
+			statusMsg := &msgbus.ObjectStatusUpdated{}
+			statusMsg.AddLabels(a.LabelLocalhost)
+			if !needForwardEvent("ObjectStatusUpdated", statusMsg) {
+				log.Tracef("add hidden filtering: ObjectStatusUpdated,node=%s", a.localhost)
+				sub.AddFilter(&msgbus.ObjectStatusUpdated{}, a.LabelLocalhost)
+				subFilters = append(subFilters, Filter{Kind: &msgbus.ObjectStatusUpdated{}, Labels: []pubsub.Label{a.LabelLocalhost}})
+			}
 		}
 	}
 	sub.Start()
@@ -328,6 +414,11 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 			log.Tracef("sub.Stop: %s", err)
 		}
 	}()
+	if a.Journal != nil {
+		// the subscription is started, so the messages published after
+		// lastID are either journaled or queued in sub.C
+		_, lastID = a.Journal.Bounds()
+	}
 	if hasSelector {
 		pathL = object.StatusData.GetPaths()
 		pathM = pathL.StrMap()
@@ -336,6 +427,7 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 			objectselector.WithPaths(pathL),
 			objectselector.WithLocal(true),
 			objectselector.WithConfigFilterDisabled(),
+			objectselector.WithStatus(objectselector.DaemonData),
 		)
 		if err := selector.CheckFilters(); err != nil {
 			return JSONProblemf(ctx, http.StatusBadRequest,
@@ -357,8 +449,8 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 
 	sseWriter := sseevent.NewWriter(w)
 
-	doEvent := func(i any) error {
-		ev := event.ToEvent(i, eventCount)
+	doEvent := func(i any, id uint64) error {
+		ev := event.ToEvent(i, id)
 		if ev != nil {
 			if dataFilter, ok := dataFiltersByKind[ev.Kind]; ok {
 				v := make(map[string]any)
@@ -379,6 +471,154 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		return nil
 	}
 
+	// selectMessage applies the authorization and the object selection to
+	// the message i, and updates the selection on object creation or
+	// deletion. It returns true if i must be forwarded to the response
+	// event stream.
+	selectMessage := func(i any) (bool, error) {
+		if ev, ok := i.(pubsub.Messager); ok {
+			if !isAllowed(ev) {
+				return false, nil
+			}
+		}
+		if !hasSelector {
+			return true, nil
+		}
+		switch ev := i.(type) {
+		case *msgbus.ObjectCreated:
+			s := ev.Path.String()
+			if !pathM.Has(s) {
+				pathL = pathL.Merge([]naming.Path{ev.Path})
+				pathM[s] = nil
+				selector.SetPaths(pathL)
+				if selected, err := getSelectedMap(); err != nil {
+					log.Errorf("can't filter on object created")
+					return false, err
+				} else if selected.Has(s) {
+					log.Tracef("add created object %s to selection", s)
+					pathSelected[s] = nil
+				}
+			}
+			if !needForwardEvent("ObjectCreated", ev) {
+				// not required on response stream
+				return false, nil
+			}
+			if !isSelected(ev) {
+				// message is not for selected path
+				return false, nil
+			}
+			// message will be forwarded
+		case *msgbus.ObjectDeleted:
+			notAnymoreSelected := false
+			if ev.GetLabels()["node"] == a.localhost {
+				s := ev.Path.String()
+				if pathSelected.Has(s) {
+					notAnymoreSelected = true
+					log.Tracef("remove deleted object %s from selection", s)
+					delete(pathSelected, s)
+				}
+				if _, ok := pathM[s]; ok {
+					delete(pathM, s)
+					// TODO implement naming.Paths.Drop(p naming.Path)
+					newPathL := make(naming.Paths, 0)
+					for _, p := range pathL {
+						if p.Equal(ev.Path) {
+							continue
+						}
+						newPathL = append(newPathL, p)
+					}
+					pathL = newPathL
+				}
+			}
+			if !needForwardEvent("ObjectDeleted", ev) {
+				// not required on response stream
+				return false, nil
+			}
+			if notAnymoreSelected {
+				// message from a previously selected path, that will
+				// be now discarted, we have to send this last message
+			} else if !isSelected(ev) {
+				// message is not for selected path
+				return false, nil
+			}
+			// message will be forwarded
+		case *msgbus.ObjectStatusUpdated:
+			wasSelected := false
+			if hasStatusSelector && ev.Node == a.localhost {
+				// the object states changed, so the status expressions
+				// may select a different set of paths
+				wasSelected = pathSelected.Has(ev.Path.String())
+				selector.SetPaths(pathL)
+				if selected, err := getSelectedMap(); err != nil {
+					log.Errorf("can't filter on object status updated")
+					return false, err
+				} else {
+					pathSelected = selected
+				}
+			}
+			if hasStatusSelector && !needForwardEvent("ObjectStatusUpdated", ev) {
+				// not required on response stream
+				return false, nil
+			}
+			if wasSelected {
+				// message from a previously selected path, that will
+				// be now discarded, we have to send this last message
+			} else if !isSelected(ev) {
+				// message is not for selected path
+				return false, nil
+			}
+			// message will be forwarded
+		case pubsub.Messager:
+			if !isSelected(ev) {
+				// message is not for selected path
+				return false, nil
+			}
+			// message will be forwarded
+		}
+		return true, nil
+	}
+
+	// resumedID is the id of the last journaled message sent to the
+	// response event stream. The messages read from sub.C with a lower or
+	// equal id have already been sent.
+	var resumedID uint64
+
+	if resume {
+		entries, gap := a.Journal.Since(since)
+		if gap {
+			first, _ := a.Journal.Bounds()
+			log.Infof("resume after evicted event id %d, oldest journaled id is %d", since, first)
+			if err := doEvent(&msgbus.EventGap{Since: since, First: first}, since); err != nil {
+				log.Tracef("do event failed on gap: %s", err)
+				return nil
+			}
+		}
+		for _, entry := range entries {
+			if entry.ID > lastID {
+				// published after the subscription start, so also queued
+				// in sub.C
+				break
+			}
+			resumedID = entry.ID
+			if !matchSubFilters(entry.Data) {
+				continue
+			}
+			if forward, err := selectMessage(entry.Data); err != nil {
+				return err
+			} else if !forward {
+				continue
+			}
+			if err := doEvent(entry.Data, entry.ID); err != nil {
+				log.Tracef("do event failed on %v: %s", entry.Data, err)
+				return nil
+			}
+			if limit > 0 && eventCount >= limit {
+				log.Tracef("reach event count limit")
+				return nil
+			}
+		}
+	}
+
 	// defines replay from the primary replay option, fallback to the deprecated
 	// cache value if any.
 	var replay bool
@@ -419,7 +659,7 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 					// unexpected event type, skip it
 					continue
 				}
-				if err := doEvent(anyE); err != nil {
+				if err := doEvent(anyE, eventID(anyE)); err != nil {
 					log.Tracef("do event failed on %v: %s", anyE, err)
 					return nil
 				}
@@ -437,80 +677,17 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		case <-evCtx.Done():
 			return nil
 		case i := <-sub.C:
-			if ev, ok := i.(pubsub.Messager); ok {
-				if !isAllowed(ev) {
-					continue
-				}
+			id := eventID(i)
+			if resumedID > 0 && id <= resumedID {
+				// already sent from the journal
+				continue
 			}
-			if hasSelector {
-				switch ev := i.(type) {
-				case *msgbus.ObjectCreated:
-					s := ev.Path.String()
-					if !pathM.Has(s) {
-						pathL = pathL.Merge([]naming.Path{ev.Path})
-						pathM[s] = nil
-						selector.SetPaths(pathL)
-						if selected, err := getSelectedMap(); err != nil {
-							log.Errorf("can't filter on object created")
-							return err
-						} else if selected.Has(s) {
-							log.Tracef("add created object %s to selection", s)
-							pathSelected[s] = nil
-						}
-					}
-					if !needForwardEvent("ObjectCreated", ev) {
-						// not required on response stream
-						continue
-					}
-					if !isSelected(ev) {
-						// message is not for selected path
-						continue
-					}
-					// message will be forwarded
-				case *msgbus.ObjectDeleted:
-					notAnymoreSelected := false
-					if ev.GetLabels()["node"] == a.localhost {
-						s := ev.Path.String()
-						if pathSelected.Has(s) {
-							notAnymoreSelected = true
-							log.Tracef("remove deleted object %s from selection", s)
-							delete(pathSelected, s)
-						}
-						if _, ok := pathM[s]; ok {
-							delete(pathM, s)
-							// TODO implement naming.Paths.Drop(p naming.Path)
-							newPathL := make(naming.Paths, 0)
-							for _, p := range pathL {
-								if p.Equal(ev.Path) {
-									continue
-								}
-								newPathL = append(newPathL, p)
-							}
-							pathL = newPathL
-						}
-					}
-					if !needForwardEvent("ObjectDeleted", ev) {
-						// not required on response stream
-						continue
-					}
-					if notAnymoreSelected {
-						// message from a previously selected path, that will
-						// be now discarted, we have to send this last message
-					} else if !isSelected(ev) {
-						// message is not for selected path
-						continue
-					}
-					// message will be forwarded
-				case pubsub.Messager:
-					if !isSelected(ev) {
-						// message is not for selected path
-						continue
-					}
-					// message will be forwarded
-				}
+			if forward, err := selectMessage(i); err != nil {
+				return err
+			} else if !forward {
+				continue
 			}
-
-			if err := doEvent(i); err != nil {
+			if err := doEvent(i, id); err != nil {
 				log.Warnf("doEvent error for %v: %s", i, err)
 				return nil
 			}
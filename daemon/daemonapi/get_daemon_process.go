@@ -42,6 +42,7 @@ func (a *DaemonAPI) getLocalDaemonProcess(ctx echo.Context, params api.GetDaemon
 			*params.Selector,
 			objectselector.WithLocal(true),
 			objectselector.WithConfigFilterDisabled(),
+			objectselector.WithStatus(objectselector.DaemonData),
 			objectselector.WithPaths(object.StatusData.GetPaths()))
 		paths, err = selector.Expand()
 		if err != nil {
@@ -0,0 +1,70 @@
+package daemonapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+func (a *DaemonAPI) GetInstancePlan(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string, params api.GetInstancePlanParams) error {
+	if v, err := assertGuest(ctx, namespace); !v {
+		return err
+	}
+	nodename = a.parseNodename(nodename)
+	if a.localhost == nodename {
+		return a.getLocalInstancePlan(ctx, namespace, kind, name, params)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.GetInstancePlan(ctx.Request().Context(), nodename, namespace, kind, name, &params)
+	})
+}
+
+func (a *DaemonAPI) getLocalInstancePlan(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.GetInstancePlanParams) error {
+	if !params.Action.Valid() {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "unsupported action: %s", params.Action)
+	}
+	path, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	if !path.Exists() {
+		return JSONProblemf(ctx, http.StatusNotFound, "No local instance", "")
+	}
+	o, err := object.NewActor(path, object.WithVolatile(true))
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "New object", "%s", err)
+	}
+	plan, err := o.Plan(instancePlanContext(ctx.Request().Context(), params), string(params.Action))
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Plan", "%s", err)
+	}
+	return ctx.JSON(http.StatusOK, plan)
+}
+
+// instancePlanContext returns a copy of ctx with the resource selection,
+// barrier and force options of the plan request.
+func instancePlanContext(ctx context.Context, params api.GetInstancePlanParams) context.Context {
+	if params.Force != nil {
+		ctx = actioncontext.WithForce(ctx, *params.Force)
+	}
+	if params.Rid != nil {
+		ctx = actioncontext.WithRID(ctx, *params.Rid)
+	}
+	if params.Subset != nil {
+		ctx = actioncontext.WithSubset(ctx, *params.Subset)
+	}
+	if params.Tag != nil {
+		ctx = actioncontext.WithTag(ctx, *params.Tag)
+	}
+	if params.To != nil {
+		ctx = actioncontext.WithTo(ctx, *params.To)
+	}
+	return ctx
+}
@@ -0,0 +1,47 @@
+package daemonapi
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/nspolicy"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+// GetNamespaceUsage returns the usage and the quotas of the namespaces the
+// user has a guest role on.
+func (a *DaemonAPI) GetNamespaceUsage(ctx echo.Context, params api.GetNamespaceUsageParams) error {
+	grants := grantsFromContext(ctx)
+	namespaces := make(map[string]any)
+	if params.Namespace != nil {
+		namespaces[*params.Namespace] = nil
+	} else {
+		for _, e := range instance.ConfigData.GetAll() {
+			namespaces[e.Path.Namespace] = nil
+		}
+	}
+	l := make([]string, 0, len(namespaces))
+	for namespace := range namespaces {
+		if !hasRoleGuestOn(grants, namespace) {
+			continue
+		}
+		l = append(l, namespace)
+	}
+	sort.Strings(l)
+	items := make([]nspolicy.Report, 0, len(l))
+	for _, namespace := range l {
+		policy, err := loadNamespacePolicy(namespace)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Namespace policy", "%s", err)
+		}
+		items = append(items, nspolicy.Report{
+			Namespace: namespace,
+			Policy:    policy,
+			Usage:     namespaceUsage(namespace),
+		})
+	}
+	return ctx.JSON(http.StatusOK, api.NamespaceUsageList{Kind: "NamespaceUsageList", Items: items})
+}
@@ -45,6 +45,8 @@ func (a *DaemonAPI) GetNodes(ctx echo.Context, params api.GetNodesParams) error
 				Hooks:                  make([]api.NodeConfigHook, len(config.Value.Hooks)),
 				Labels:                 make(map[string]string),
 				MaintenanceGracePeriod: config.Value.MaintenanceGracePeriod,
+				MaintenanceMode:        config.Value.MaintenanceMode.String(),
+				MaintenanceWindow:      config.Value.MaintenanceWindow,
 				MaxParallel:            config.Value.MaxParallel,
 				MinAvailMemPct:         config.Value.MinAvailMemPct,
 				MinAvailSwapPct:        config.Value.MinAvailSwapPct,
@@ -83,6 +85,8 @@ func (a *DaemonAPI) GetNodes(ctx echo.Context, params api.GetNodesParams) error
 				Gen:          make(map[string]uint64),
 				IsLeader:     status.IsLeader,
 				IsOverloaded: status.IsOverloaded,
+
+				MaintenanceWindow: status.MaintenanceWindow.DeepCopy(),
 			}
 			for k, v := range status.Arbitrators {
 				d.Data.Status.Arbitrators[k] = api.ArbitratorStatus{
@@ -0,0 +1,35 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/daemon/api"
+	"github.com/opensvc/om3/v3/util/sysreport"
+)
+
+func (a *DaemonAPI) GetNodeSysreportSnapshots(ctx echo.Context, nodename string) error {
+	if v, err := assertRoot(ctx); !v {
+		return err
+	}
+	nodename = a.parseNodename(nodename)
+	if a.localhost == nodename {
+		return a.getLocalSysreportSnapshots(ctx)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.GetNodeSysreportSnapshots(ctx.Request().Context(), nodename)
+	})
+}
+
+func (a *DaemonAPI) getLocalSysreportSnapshots(ctx echo.Context) error {
+	snapshots, err := sysreport.Snapshots()
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Load sysreport snapshots", "%s", err)
+	}
+	return ctx.JSON(http.StatusOK, api.SysreportSnapshotList{
+		Kind:  "SysreportSnapshotList",
+		Items: snapshots,
+	})
+}
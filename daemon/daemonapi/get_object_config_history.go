@@ -0,0 +1,98 @@
+package daemonapi
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/confighistory"
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/api"
+)
+
+func (a *DaemonAPI) GetObjectConfigHistory(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
+	if v, err := assertGuest(ctx, namespace); !v {
+		return err
+	}
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "invalid path: %s", err)
+	}
+	nodename := a.configHistoryNode(p)
+	if nodename == "" {
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "object not found: %s", p)
+	} else if nodename == a.localhost {
+		revs, err := confighistory.List(p)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "List config revisions", "%s", err)
+		}
+		items := make(api.ConfigRevisionItems, len(revs))
+		for i, rev := range revs {
+			items[i] = api.ConfigRevision{
+				Author:    rev.Author,
+				Checksum:  rev.Checksum,
+				CreatedAt: rev.CreatedAt,
+				Id:        rev.ID,
+				Node:      rev.Node,
+				Ops:       rev.Ops,
+				Size:      rev.Size,
+			}
+		}
+		return ctx.JSON(http.StatusOK, api.ConfigRevisionList{
+			Kind:  api.ConfigRevisionListKindConfigRevisionList,
+			Items: items,
+		})
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.GetObjectConfigHistory(ctx.Request().Context(), namespace, kind, name)
+	})
+}
+
+func (a *DaemonAPI) GetObjectConfigHistoryFile(ctx echo.Context, namespace string, kind naming.Kind, name string, rev int) error {
+	if v, err := assertGuest(ctx, namespace); !v {
+		return err
+	}
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "invalid path: %s", err)
+	}
+	nodename := a.configHistoryNode(p)
+	if nodename == "" {
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "object not found: %s", p)
+	} else if nodename == a.localhost {
+		_, b, err := confighistory.Get(p, rev)
+		if errors.Is(err, confighistory.ErrNotFound) {
+			return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s: %s", p, err)
+		} else if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Get config revision", "%s", err)
+		}
+		return ctx.Blob(http.StatusOK, "application/octet-stream", b)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.GetObjectConfigHistoryFile(ctx.Request().Context(), namespace, kind, name, rev)
+	})
+}
+
+// configHistoryNode returns the node serving the config history of the
+// object p: the local node if it has an instance config, else the first
+// sorted node with an instance config, or "" if none. The revisions are
+// numbered per node, so the list, file and rollback requests must be served
+// by the same node.
+func (a *DaemonAPI) configHistoryNode(p naming.Path) string {
+	if instance.ConfigData.GetByPathAndNode(p, a.localhost) != nil {
+		return a.localhost
+	}
+	nodenames := make([]string, 0)
+	for nodename := range instance.ConfigData.GetByPath(p) {
+		nodenames = append(nodenames, nodename)
+	}
+	if len(nodenames) == 0 {
+		return ""
+	}
+	slices.Sort(nodenames)
+	return nodenames[0]
+}
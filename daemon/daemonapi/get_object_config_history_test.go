@@ -0,0 +1,30 @@
+package daemonapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+)
+
+func TestConfigHistoryNode(t *testing.T) {
+	p := naming.Path{Namespace: "test", Kind: naming.KindSvc, Name: "confighistory"}
+	defer func() {
+		for _, nodename := range []string{"n1", "n2", "n3"} {
+			instance.ConfigData.Unset(p, nodename)
+		}
+	}()
+	a := &DaemonAPI{localhost: "n2"}
+	require.Equal(t, "", a.configHistoryNode(p))
+
+	instance.ConfigData.Set(p, "n3", &instance.Config{})
+	instance.ConfigData.Set(p, "n1", &instance.Config{})
+	for range 10 {
+		require.Equal(t, "n1", a.configHistoryNode(p))
+	}
+
+	instance.ConfigData.Set(p, "n2", &instance.Config{})
+	require.Equal(t, "n2", a.configHistoryNode(p))
+}
@@ -19,6 +19,7 @@ func (a *DaemonAPI) GetObjectPaths(ctx echo.Context, params api.GetObjectPathsPa
 		params.Path,
 		objectselector.WithPaths(paths),
 		objectselector.WithLocal(true),
+		objectselector.WithStatus(objectselector.DaemonData),
 	)
 	matchedPaths, err := selection.Expand()
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/opensvc/om3/v3/daemon/daemonctx"
 	"github.com/opensvc/om3/v3/daemon/daemondata"
 	"github.com/opensvc/om3/v3/daemon/daemonenv"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
 	"github.com/opensvc/om3/v3/daemon/rbac"
 	"github.com/opensvc/om3/v3/util/auditstate"
 	"github.com/opensvc/om3/v3/util/hostname"
@@ -30,6 +31,9 @@ type (
 		Daemondata    *daemondata.T
 		JWTcreator    JWTCreater
 
+		// Journal is the event journal used to resume event streams.
+		Journal *msgbus.Journal
+
 		LabelLocalhost pubsub.Label
 
 		SubQS pubsub.QueueSizer
@@ -54,6 +58,7 @@ func New(ctx context.Context) *DaemonAPI {
 		AuditRegistry:  daemonctx.AuditRegistry(ctx),
 		Bus:            pubsub.BusFromContext(ctx),
 		JWTcreator:     daemonauth.JWTCreatorFromContext(ctx),
+		Journal:        msgbus.JournalFromContext(ctx),
 		LabelLocalhost: pubsub.Label{"node", localhost},
 		localhost:      localhost,
 		SubQS:          SubQS(ctx),
@@ -79,6 +79,7 @@ func (m *Meta) expandPath() error {
 			*m.Path,
 			objectselector.WithPaths(paths),
 			objectselector.WithLocal(true),
+			objectselector.WithStatus(objectselector.DaemonData),
 		)
 		matchedPaths, err := selection.ExpandRelaxed()
 		if err != nil {
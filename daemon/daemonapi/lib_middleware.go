@@ -2,9 +2,11 @@ package daemonapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/allenai/go-swaggerui"
 	"github.com/google/uuid"
@@ -15,9 +17,13 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/shaj13/go-guardian/v2/auth"
 
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/auditlog"
 	"github.com/opensvc/om3/v3/daemon/daemonauth"
 	"github.com/opensvc/om3/v3/daemon/daemonctx"
 	"github.com/opensvc/om3/v3/daemon/rbac"
+	"github.com/opensvc/om3/v3/util/hostname"
 	"github.com/opensvc/om3/v3/util/plog"
 )
 
@@ -30,6 +36,12 @@ type (
 var (
 	LogLevel = zerolog.InfoLevel
 
+	// auditSkipRoutes are the mutating routes not recorded in the audit
+	// journal, because they are internal and frequent.
+	auditSkipRoutes = map[string]any{
+		"/api/relay/message": nil,
+	}
+
 	// logRequestLevelPerPath defines logRequestMiddleWare log level per path.
 	// The default value is LevelInfo
 	logRequestLevelPerPrefix = map[string]zerolog.Level{
@@ -264,6 +276,68 @@ func LogRequestMiddleWare(parent context.Context) echo.MiddlewareFunc {
 	}
 }
 
+// AuditMiddleware appends a record to the audit journal for every
+// mutating request, whatever its authentication and authorization
+// result.
+func AuditMiddleware(parent context.Context) echo.MiddlewareFunc {
+	log := logWithFamilyAndAddr(parent)
+	localhost := hostname.Hostname()
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			r := c.Request()
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				return next(c)
+			}
+			if _, ok := auditSkipRoutes[c.Path()]; ok {
+				return next(c)
+			}
+			begin := time.Now()
+			err := next(c)
+			record := auditlog.Record{
+				At:        begin,
+				RequestID: uuidFromContext(c),
+				Node:      localhost,
+				Address:   r.RemoteAddr,
+				Method:    r.Method,
+				Route:     c.Path(),
+				URI:       r.URL.RequestURI(),
+				Target:    c.Param("nodename"),
+				Status:    c.Response().Status,
+				Duration:  time.Since(begin),
+			}
+			if err != nil && !c.Response().Committed {
+				var he *echo.HTTPError
+				if errors.As(err, &he) {
+					record.Status = he.Code
+				} else {
+					record.Status = http.StatusInternalServerError
+				}
+			}
+			if user, ok := c.Get("user").(auth.Info); ok {
+				extensions := user.GetExtensions()
+				record.User = user.GetUserName()
+				record.Strategy = extensions.Get("strategy")
+				record.Issuer = extensions.Get("iss")
+				record.Grants = extensions.Values("grant")
+			}
+			if namespace, kind, name := c.Param("namespace"), c.Param("kind"), c.Param("name"); kind != "" && name != "" {
+				if p, err := naming.NewPathFromStrings(namespace, kind, name); err == nil {
+					record.Object = p.String()
+				}
+			}
+			var config cluster.ConfigAudit
+			if cluster.ConfigData.IsSet() {
+				config = cluster.ConfigData.Get().Audit
+			}
+			if err := auditlog.Append(config, record); err != nil {
+				log.Errorf("audit: %s", err)
+			}
+			return err
+		}
+	}
+}
+
 func UIMiddleware(_ context.Context, prefix string, specURL string) echo.MiddlewareFunc {
 	uiHandler := http.StripPrefix(prefix, swaggerui.Handler(specURL))
 	//uiHandler := swaggerui.Handler(specURL)
@@ -0,0 +1,154 @@
+package daemonapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/nodeselector"
+	"github.com/opensvc/om3/v3/core/nspolicy"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/topology"
+)
+
+// loadNamespacePolicy returns the quotas and policies of the namespace,
+// as defined in its nscfg object.
+func loadNamespacePolicy(namespace string) (nspolicy.Policy, error) {
+	p := naming.Path{Namespace: namespace, Kind: naming.KindNscfg, Name: "namespace"}
+	o, err := object.NewNscfg(p, object.WithVolatile(true))
+	if err != nil {
+		return nspolicy.Policy{}, fmt.Errorf("new nscfg %s: %w", p, err)
+	}
+	policy, err := o.Policy()
+	if err != nil {
+		return policy, fmt.Errorf("%s: %w", p, err)
+	}
+	return policy, nil
+}
+
+// namespacePolicy returns the quotas and policies of the namespace, with
+// the allowed node selectors expanded to node names.
+func namespacePolicy(namespace string) (nspolicy.Policy, error) {
+	policy, err := loadNamespacePolicy(namespace)
+	if err != nil {
+		return policy, err
+	}
+	if len(policy.AllowedNodes) > 0 {
+		nodenames, err := nodeselector.New(strings.Join(policy.AllowedNodes, " ")).Expand()
+		if err != nil {
+			return policy, fmt.Errorf("namespace %s: expand allowed nodes: %w", namespace, err)
+		}
+		if len(nodenames) == 0 {
+			// keep a non-empty list, so no node is allowed
+			nodenames = []string{""}
+		}
+		policy.AllowedNodes = nodenames
+	}
+	return policy, nil
+}
+
+// namespaceUsageObject returns the contribution to its namespace usage of
+// the object p, from the instance config data. It returns nil if the object
+// has no instance config.
+func namespaceUsageObject(p naming.Path) *nspolicy.Object {
+	for _, cfg := range instance.ConfigData.GetByPath(p) {
+		if cfg == nil {
+			continue
+		}
+		o := nspolicy.Object{
+			Path:     p,
+			PoolSize: make(map[string]int64),
+		}
+		if cfg.ActorConfig != nil && cfg.Topology == topology.Flex && cfg.Flex != nil {
+			o.FlexInstances = cfg.Flex.Target
+		}
+		if cfg.VolConfig != nil && cfg.VolConfig.Pool != "" {
+			o.PoolSize[cfg.VolConfig.Pool] += cfg.VolConfig.Size
+		}
+		return &o
+	}
+	return nil
+}
+
+// namespaceUsage returns the usage of the namespace accounted by the quotas,
+// from the instance config data.
+func namespaceUsage(namespace string) nspolicy.Usage {
+	usage := nspolicy.NewUsage()
+	done := make(map[naming.Path]any)
+	for _, e := range instance.ConfigData.GetAll() {
+		if e.Path.Namespace != namespace {
+			continue
+		}
+		if _, ok := done[e.Path]; ok {
+			continue
+		}
+		done[e.Path] = nil
+		if o := namespaceUsageObject(e.Path); o != nil {
+			usage.Add(*o)
+		}
+	}
+	return usage
+}
+
+// volExists returns true if the vol object p has instance configs.
+func volExists(p naming.Path) bool {
+	return len(instance.ConfigData.GetByPath(p)) > 0
+}
+
+// checkNamespacePolicy verifies the candidate config of the object o,
+// replacing its current config if any, is allowed by the namespace quotas
+// and policies. The returned error wraps nspolicy.ErrViolation if the
+// candidate is denied.
+func checkNamespacePolicy(o any) error {
+	c, ok := o.(object.Core)
+	if !ok {
+		return nil
+	}
+	p := c.Path()
+	if p.Kind == naming.KindNscfg {
+		return nil
+	}
+	policy, err := namespacePolicy(p.Namespace)
+	if err != nil {
+		return err
+	}
+	if policy.IsZero() {
+		return nil
+	}
+	candidate, err := object.NewNamespacePolicyObject(o, volExists)
+	if err != nil {
+		return err
+	}
+	return policy.Check(namespaceUsage(p.Namespace), namespaceUsageObject(p), candidate)
+}
+
+// verifyNamespacePolicy verifies the existing object p is allowed by the
+// namespace quotas and policies, before its provisioning.
+func verifyNamespacePolicy(p naming.Path) error {
+	if p.Kind == naming.KindNscfg {
+		return nil
+	}
+	policy, err := namespacePolicy(p.Namespace)
+	if err != nil {
+		return err
+	}
+	if policy.IsZero() {
+		return nil
+	}
+	o, err := object.New(p, object.WithVolatile(true))
+	if err != nil {
+		return err
+	}
+	obj, err := object.NewNamespacePolicyObject(o, volExists)
+	if err != nil {
+		return err
+	}
+	// account the volumes to allocate by the provisioning
+	usage := namespaceUsage(p.Namespace)
+	if current := namespaceUsageObject(p); current != nil {
+		usage.Del(*current)
+	}
+	usage.Add(obj)
+	return policy.Verify(usage, obj)
+}
@@ -1,16 +1,22 @@
 package daemonapi
 
 import (
+	"errors"
 	"io"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/opensvc/om3/v3/core/confighistory"
 	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/nspolicy"
 	"github.com/opensvc/om3/v3/core/object"
 )
 
-func (a *DaemonAPI) writeObjectConfigFile(ctx echo.Context, p naming.Path, body []byte) error {
+// writeObjectConfigFile validates and commits body as the object p
+// configuration file. The ops describe the change in the object
+// configuration history.
+func (a *DaemonAPI) writeObjectConfigFile(ctx echo.Context, p naming.Path, body []byte, ops ...string) error {
 	o, err := object.New(p, object.WithConfigData(body))
 	if err != nil {
 		return JSONProblemf(ctx, http.StatusInternalServerError, "New object", "%s", err)
@@ -23,10 +29,18 @@ func (a *DaemonAPI) writeObjectConfigFile(ctx echo.Context, p naming.Path, body
 	if alerts.HasError() {
 		return JSONProblemf(ctx, http.StatusBadRequest, "Validate config", "%s", err)
 	}
+	if err := checkNamespacePolicy(o); errors.Is(err, nspolicy.ErrViolation) {
+		return JSONProblemf(ctx, http.StatusForbidden, "Namespace policy", "%s", err)
+	} else if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Namespace policy", "%s", err)
+	}
 	// Use the non-validating commit func as we already validate to emit an explicit error
 	if err := configurer.Config().RecommitInvalid(); err != nil {
 		return JSONProblemf(ctx, http.StatusInternalServerError, "Commit", "%s", err)
 	}
+	if err := confighistory.Annotate(p, userFromContext(ctx).GetUserName(), ops); err != nil {
+		LogHandler(ctx, "writeObjectConfigFile").Warnf("%s: configuration history annotate: %s", p, err)
+	}
 	return ctx.NoContent(http.StatusNoContent)
 }
 
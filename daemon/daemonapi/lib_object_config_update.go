@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/labstack/echo/v4"
+	"github.com/opensvc/om3/v3/core/confighistory"
 	"github.com/opensvc/om3/v3/core/keyop"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/object"
@@ -33,10 +34,19 @@ func configUpdate(ctx echo.Context, log *plog.Logger, p naming.Path, deletes []s
 		log.Tracef("configuration validation has errors for object %s", p)
 		return false, fmt.Errorf("configuration validation has errors for object %s", p)
 	}
+	if err := checkNamespacePolicy(oc); err != nil {
+		return false, fmt.Errorf("configuration of object %s: %w", p, err)
+	}
 	changed := oc.Config().Changed()
 	if err := oc.Config().CommitInvalid(); err != nil {
 		log.Errorf("configuration commit is invalid for object %s: %s", p, err)
 		return false, fmt.Errorf("configuration commit is invalid for object %s: %w", p, err)
 	}
+	if changed {
+		ops := confighistory.Ops(deletes, unsets, sets)
+		if err := confighistory.Annotate(p, userFromContext(ctx).GetUserName(), ops); err != nil {
+			log.Warnf("configuration history annotate for object %s: %s", p, err)
+		}
+	}
 	return changed, nil
 }
@@ -2,15 +2,18 @@ package daemonapi
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/anmitsu/go-shlex"
 	"github.com/labstack/echo/v4"
 
+	"github.com/opensvc/om3/v3/core/cluster"
 	"github.com/opensvc/om3/v3/core/datarecv"
 	"github.com/opensvc/om3/v3/core/keyop"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/xconfig"
 	"github.com/opensvc/om3/v3/daemon/rbac"
 	"github.com/opensvc/om3/v3/util/key"
 )
@@ -31,6 +34,12 @@ func configRbac(ctx echo.Context, p naming.Path, body []byte) error {
 		return nil
 	}
 
+	if p.Kind == naming.KindNscfg {
+		if err := nscfgPolicyRbac(p, cfg); err != nil {
+			return err
+		}
+	}
+
 	// Iterate through all sections in the config
 	for _, section := range cfg.SectionStrings() {
 		// Get all keys in this section
@@ -57,9 +66,40 @@ func configRbac(ctx echo.Context, p naming.Path, body []byte) error {
 	return nil
 }
 
+// nscfgPolicyKeywords are the nscfg keywords defining the namespace quotas
+// and policies. Only the root grant can change them, so the namespace admins
+// can't lift the restrictions of their own namespace.
+var nscfgPolicyKeywords = []string{
+	"quota_objects",
+	"quota_flex_instances",
+	"quota_pool_size",
+	"allowed_drivers",
+	"denied_drivers",
+	"allowed_nodes",
+}
+
+// nscfgPolicyRbac returns an error if the candidate config of the nscfg
+// object p changes a namespace quota or policy keyword value.
+func nscfgPolicyRbac(p naming.Path, candidate *xconfig.T) error {
+	o, err := object.NewConfigurer(p, object.WithVolatile(true))
+	if err != nil {
+		return fmt.Errorf("new configurer %s: %w", p, err)
+	}
+	current := o.Config()
+	for _, option := range nscfgPolicyKeywords {
+		k := key.New("DEFAULT", option)
+		currentValue, _ := current.Eval(k)
+		candidateValue, _ := candidate.Eval(k)
+		if fmt.Sprint(currentValue) != fmt.Sprint(candidateValue) {
+			return fmt.Errorf("denied: %s: namespace quotas and policies require the root grant", k)
+		}
+	}
+	return nil
+}
+
 // assertGuest asserts that the authenticated user has is either granted the "guest", "operator" or "admin" role on the namespace or is granted the "root" role.
 func assertGuest(ctx echo.Context, namespace string) (bool, error) {
-	return assertGrant(ctx,
+	return assertNamespaceGrant(ctx, namespace,
 		rbac.NewGrant(rbac.RoleGuest, namespace),
 		rbac.NewGrant(rbac.RoleOperator, namespace),
 		rbac.NewGrant(rbac.RoleAdmin, namespace),
@@ -73,7 +113,7 @@ func assertGuest(ctx echo.Context, namespace string) (bool, error) {
 
 // assertOperator asserts that the authenticated user has is either granted the "operator" or "admin" role on the namespace or is granted the "root" role.
 func assertOperator(ctx echo.Context, namespace string) (bool, error) {
-	return assertGrant(ctx,
+	return assertNamespaceGrant(ctx, namespace,
 		rbac.NewGrant(rbac.RoleOperator, namespace),
 		rbac.NewGrant(rbac.RoleAdmin, namespace),
 		rbac.NewGrant(rbac.RoleOperator, ""),
@@ -84,7 +124,7 @@ func assertOperator(ctx echo.Context, namespace string) (bool, error) {
 
 // assertAdmin asserts that the authenticated user has is either granted the "admin" role on the namespace or is granted the "root" role.
 func assertAdmin(ctx echo.Context, namespace string) (bool, error) {
-	return assertGrant(ctx,
+	return assertNamespaceGrant(ctx, namespace,
 		rbac.NewGrant(rbac.RoleAdmin, namespace),
 		rbac.NewGrant(rbac.RoleAdmin, ""),
 		rbac.GrantRoot,
@@ -110,6 +150,99 @@ func assertGrant(ctx echo.Context, grants ...rbac.Grant) (bool, error) {
 	return true, nil
 }
 
+// assertNamespaceGrant asserts that the authenticated user is granted one of
+// grants, or is granted a custom role allowing the request verb on the
+// request object kind in the namespace.
+func assertNamespaceGrant(ctx echo.Context, namespace string, grants ...rbac.Grant) (bool, error) {
+	userGrants := grantsFromContext(ctx)
+	if userGrants.HasGrant(grants...) {
+		return true, nil
+	}
+	if kind := requestKind(ctx); kind != "" {
+		if _, ok := customRoles().Allow(userGrants, requestVerb(ctx), kind, namespace); ok {
+			return true, nil
+		}
+	}
+	return false, JSONForbiddenMissingGrant(ctx, grants...)
+}
+
+// customRoles returns the custom roles defined in the cluster config.
+func customRoles() rbac.CustomRoles {
+	if !cluster.ConfigData.IsSet() {
+		return nil
+	}
+	return cluster.ConfigData.Get().Roles
+}
+
+// splitObjectRoute splits an object or instance route path like
+// /api/object/path/:namespace/:kind/:name/action/start into its kind segment
+// (":kind" or a literal kind) and the sub path after the name segment
+// ("action/start"). ok is false for other routes.
+func splitObjectRoute(path string) (kind, sub string, ok bool) {
+	const marker = "/path/:namespace/"
+	i := strings.Index(path, marker)
+	if i < 0 {
+		return "", "", false
+	}
+	l := strings.SplitN(path[i+len(marker):], "/", 3)
+	if len(l) < 2 || l[1] != ":name" {
+		return "", "", false
+	}
+	if len(l) == 3 {
+		sub = l[2]
+	}
+	return l[0], sub, true
+}
+
+// requestKind returns the object kind of an object or instance route
+// request, or "" for other routes.
+func requestKind(ctx echo.Context) string {
+	kind, _, ok := splitObjectRoute(ctx.Path())
+	switch {
+	case !ok:
+		return ""
+	case kind == ":kind":
+		return ctx.Param("kind")
+	default:
+		// route dedicated to a kind, like /api/object/path/:namespace/svc/:name/enable
+		return kind
+	}
+}
+
+// requestVerb returns the custom role verb of an object or instance route
+// request:
+//
+//	.../:name/action/<action>  <action>, with the '/' replaced by '_'
+//	GET .../:name/data/keys    get
+//	GET .../:name/data*        decode
+//	... .../:name/data*        set_key
+//	GET .../:name/*            get
+//	... .../:name/config*      set
+//	... .../:name/<other>      <other>, with the '/' replaced by '_'
+func requestVerb(ctx echo.Context) string {
+	_, sub, ok := splitObjectRoute(ctx.Path())
+	if !ok || sub == "" {
+		return ""
+	}
+	isGet := ctx.Request().Method == http.MethodGet
+	switch {
+	case strings.HasPrefix(sub, "action/"):
+		return strings.ReplaceAll(strings.TrimPrefix(sub, "action/"), "/", "_")
+	case sub == "data/keys" && isGet:
+		return rbac.VerbGet
+	case strings.HasPrefix(sub, "data") && isGet:
+		return rbac.VerbDecode
+	case strings.HasPrefix(sub, "data"):
+		return rbac.VerbSetKey
+	case isGet:
+		return rbac.VerbGet
+	case strings.HasPrefix(sub, "config"):
+		return rbac.VerbSet
+	default:
+		return strings.ReplaceAll(sub, "/", "_")
+	}
+}
+
 func assertRole(ctx echo.Context, roles ...rbac.Role) (bool, error) {
 	if !grantsFromContext(ctx).HasRole(roles...) {
 		return false, JSONForbiddenMissingRole(ctx, roles...)
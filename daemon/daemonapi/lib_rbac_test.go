@@ -0,0 +1,116 @@
+package daemonapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/xconfig"
+	"github.com/opensvc/om3/v3/daemon/rbac"
+	"github.com/opensvc/om3/v3/testhelper"
+)
+
+// apiRoutes returns the echo route paths of the api.yaml paths.
+func apiRoutes(t *testing.T) []string {
+	t.Helper()
+	b, err := os.ReadFile("../api/api.yaml")
+	require.NoError(t, err)
+	var l []string
+	for _, m := range regexp.MustCompile(`(?m)^  (/api/\S+):$`).FindAllStringSubmatch(string(b), -1) {
+		l = append(l, regexp.MustCompile(`\{([^}]+)\}`).ReplaceAllString(m[1], ":$1"))
+	}
+	require.NotEmpty(t, l)
+	return l
+}
+
+func newRouteContext(method, path string, params map[string]string) echo.Context {
+	e := echo.New()
+	ctx := e.NewContext(httptest.NewRequest(method, "/", nil), httptest.NewRecorder())
+	ctx.SetPath(path)
+	var names, values []string
+	for k, v := range params {
+		names = append(names, k)
+		values = append(values, v)
+	}
+	ctx.SetParamNames(names...)
+	ctx.SetParamValues(values...)
+	return ctx
+}
+
+func TestRequestKindOnApiRoutes(t *testing.T) {
+	params := map[string]string{
+		"namespace": "ns1",
+		"kind":      "vol",
+		"name":      "foo",
+		"nodename":  "n1",
+	}
+	for _, route := range apiRoutes(t) {
+		t.Run(route, func(t *testing.T) {
+			ctx := newRouteContext(http.MethodPost, route, params)
+			kind := requestKind(ctx)
+			switch {
+			case strings.Contains(route, "/path/:namespace/:kind/:name"):
+				require.Equal(t, "vol", kind)
+			case strings.Contains(route, "/path/:namespace/svc/:name"):
+				require.Equal(t, "svc", kind)
+			default:
+				require.Equal(t, "", kind)
+			}
+		})
+	}
+}
+
+func TestRequestVerb(t *testing.T) {
+	cases := []struct {
+		method   string
+		path     string
+		expected string
+	}{
+		{http.MethodPost, "/api/object/path/:namespace/:kind/:name/action/start", "start"},
+		{http.MethodPost, "/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/push/resource/info", "push_resource_info"},
+		{http.MethodPost, "/api/node/name/:nodename/instance/path/:namespace/:kind/:name/action/pg/update", "pg_update"},
+		{http.MethodGet, "/api/object/path/:namespace/:kind/:name/data/keys", rbac.VerbGet},
+		{http.MethodGet, "/api/object/path/:namespace/:kind/:name/data/key", rbac.VerbDecode},
+		{http.MethodPut, "/api/object/path/:namespace/:kind/:name/data/key", rbac.VerbSetKey},
+		{http.MethodPatch, "/api/object/path/:namespace/:kind/:name/data", rbac.VerbSetKey},
+		{http.MethodGet, "/api/object/path/:namespace/:kind/:name/config", rbac.VerbGet},
+		{http.MethodPatch, "/api/object/path/:namespace/:kind/:name/config", rbac.VerbSet},
+		{http.MethodPut, "/api/object/path/:namespace/:kind/:name/config/file", rbac.VerbSet},
+		{http.MethodPost, "/api/object/path/:namespace/svc/:name/enable", "enable"},
+		{http.MethodPost, "/api/node/name/:nodename/instance/path/:namespace/:kind/:name/clear", "clear"},
+		{http.MethodGet, "/api/object/path/:namespace/:kind/:name", ""},
+		{http.MethodPost, "/api/node/name/:nodename/daemon/hb/name/:name/action/restart", ""},
+		{http.MethodPost, "/api/node/name/:nodename/daemon/listener/name/:name/log/control", ""},
+	}
+	for _, c := range cases {
+		t.Run(c.method+" "+c.path, func(t *testing.T) {
+			ctx := newRouteContext(c.method, c.path, nil)
+			require.Equal(t, c.expected, requestVerb(ctx))
+		})
+	}
+}
+
+func TestNscfgPolicyRbac(t *testing.T) {
+	testhelper.Setup(t)
+	p := naming.Path{Namespace: "ns1", Kind: naming.KindNscfg, Name: "namespace"}
+	require.NoError(t, os.MkdirAll(filepath.Dir(p.ConfigFile()), 0o755))
+	require.NoError(t, os.WriteFile(p.ConfigFile(), []byte("[DEFAULT]\nquota_objects = svc=2\n"), 0o600))
+
+	candidate := func(s string) *xconfig.T {
+		o, err := object.New(p, object.WithConfigData([]byte(s)), object.WithVolatile(true))
+		require.NoError(t, err)
+		return o.(object.Configurer).Config()
+	}
+	require.NoError(t, nscfgPolicyRbac(p, candidate("[DEFAULT]\nquota_objects = svc=2\n")))
+	require.Error(t, nscfgPolicyRbac(p, candidate("[DEFAULT]\n")))
+	require.Error(t, nscfgPolicyRbac(p, candidate("[DEFAULT]\nquota_objects = svc=2\nallowed_nodes = n1\n")))
+}
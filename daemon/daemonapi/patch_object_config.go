@@ -1,6 +1,7 @@
 package daemonapi
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -8,6 +9,7 @@ import (
 	"github.com/opensvc/om3/v3/core/instance"
 	"github.com/opensvc/om3/v3/core/keyop"
 	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/nspolicy"
 	"github.com/opensvc/om3/v3/daemon/api"
 	"github.com/opensvc/om3/v3/util/key"
 )
@@ -53,7 +55,9 @@ func (a *DaemonAPI) PatchObjectConfig(ctx echo.Context, namespace string, kind n
 
 	if _, ok := instanceConfigData[a.localhost]; ok {
 		changed, err := configUpdate(ctx, log, p, deletes, unsets, sets)
-		if err != nil {
+		if errors.Is(err, nspolicy.ErrViolation) {
+			return JSONProblemf(ctx, http.StatusForbidden, "Namespace policy", "%s", err)
+		} else if err != nil {
 			return JSONProblemf(ctx, http.StatusInternalServerError, "Update config", "%s", err)
 		}
 		return ctx.JSON(http.StatusOK, api.Committed{IsChanged: changed})
@@ -1,6 +1,7 @@
 package daemonapi
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/opensvc/om3/v3/core/client"
 	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/nspolicy"
 	"github.com/opensvc/om3/v3/daemon/api"
 )
 
@@ -33,6 +35,11 @@ func (a *DaemonAPI) postLocalInstanceActionProvision(ctx echo.Context, namespace
 		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
 	}
 	log = naming.LogWithPath(log, p)
+	if err := verifyNamespacePolicy(p); errors.Is(err, nspolicy.ErrViolation) {
+		return JSONProblemf(ctx, http.StatusForbidden, "Namespace policy", "%s", err)
+	} else if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Namespace policy", "%s", err)
+	}
 	args := []string{p.String(), "instance", "provision"}
 	if params.Leader != nil && *params.Leader {
 		args = append(args, "--leader")
@@ -1,6 +1,7 @@
 package daemonapi
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -8,12 +9,20 @@ import (
 	"github.com/opensvc/om3/v3/core/client"
 	"github.com/opensvc/om3/v3/core/instance"
 	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/nspolicy"
 )
 
 func (a *DaemonAPI) PostObjectActionProvision(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
 	if v, err := assertAdmin(ctx, namespace); !v {
 		return err
 	}
+	if p, err := naming.NewPath(namespace, kind, name); err == nil {
+		if err := verifyNamespacePolicy(p); errors.Is(err, nspolicy.ErrViolation) {
+			return JSONProblemf(ctx, http.StatusForbidden, "Namespace policy", "%s", err)
+		} else if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Namespace policy", "%s", err)
+		}
+	}
 	return a.postObjectAction(ctx, namespace, kind, name, instance.MonitorGlobalExpectProvisioned, func(c *client.T) (*http.Response, error) {
 		return c.PostObjectActionProvision(ctx.Request().Context(), namespace, kind, name)
 	})
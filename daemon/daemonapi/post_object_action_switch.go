@@ -40,6 +40,7 @@ func (a *DaemonAPI) PostObjectActionSwitch(eCtx echo.Context, namespace string,
 			GlobalExpectOptions: instance.MonitorGlobalExpectOptionsPlacedAt{
 				Destination: payload.Destination,
 				Live:        payload.Live,
+				BlueGreen:   payload.BlueGreen != nil && *payload.BlueGreen,
 			},
 			CandidateOrchestrationID: uuid.New(),
 		}
@@ -38,5 +38,5 @@ func (a *DaemonAPI) PostObjectConfigFile(ctx echo.Context, namespace string, kin
 
 	log.Tracef("%s: rbac passed", p)
 
-	return a.writeObjectConfigFile(ctx, p, body)
+	return a.writeObjectConfigFile(ctx, p, body, "create config file")
 }
@@ -0,0 +1,44 @@
+package daemonapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/v3/core/client"
+	"github.com/opensvc/om3/v3/core/confighistory"
+	"github.com/opensvc/om3/v3/core/naming"
+)
+
+func (a *DaemonAPI) PostObjectConfigHistoryRollback(ctx echo.Context, namespace string, kind naming.Kind, name string, rev int) error {
+	if v, err := assertAdmin(ctx, namespace); !v {
+		return err
+	}
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "invalid path: %s", err)
+	}
+	nodename := a.configHistoryNode(p)
+	if nodename == "" {
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "object not found: %s", p)
+	} else if nodename == a.localhost {
+		_, body, err := confighistory.Get(p, rev)
+		if errors.Is(err, confighistory.ErrNotFound) {
+			return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s: %s", p, err)
+		} else if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Get config revision", "%s", err)
+		}
+
+		// The revision may contain keywords the user is not allowed to set.
+		if err := configRbac(ctx, p, body); err != nil {
+			return JSONProblemf(ctx, http.StatusForbidden, "Forbidden", "Config validation: %s", err)
+		}
+		LogHandler(ctx, "PostObjectConfigHistoryRollback").Infof("%s: rollback config to revision %d", p, rev)
+		return a.writeObjectConfigFile(ctx, p, body, fmt.Sprintf("rollback to revision %d", rev))
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostObjectConfigHistoryRollback(ctx.Request().Context(), namespace, kind, name, rev)
+	})
+}
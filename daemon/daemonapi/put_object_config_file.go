@@ -33,5 +33,5 @@ func (a *DaemonAPI) PutObjectConfigFile(ctx echo.Context, namespace string, kind
 		return JSONProblemf(ctx, http.StatusForbidden, "Forbidden", "Config validation: %s", err)
 	}
 
-	return a.writeObjectConfigFile(ctx, p, body)
+	return a.writeObjectConfigFile(ctx, p, body, "replace config file")
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/opensvc/om3/v3/core/cluster"
 	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/core/status"
 	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
 	"github.com/opensvc/om3/v3/daemon/draincommand"
 	"github.com/opensvc/om3/v3/daemon/msgbus"
@@ -46,6 +48,10 @@ type (
 		// The zone data is obtained by merging all map values.
 		state map[stateKey]Zone
 
+		// avail stores the instance avail status of each state key, so the
+		// embedded server can answer only with the records of instances up.
+		avail map[stateKey]status.T
+
 		// score stores the node.Stats.Score values, to use as weight in SRV records
 		score map[string]int
 
@@ -73,17 +79,32 @@ type (
 		status daemonsubsystem.Dns
 
 		localhost string
+
+		// server is the running embedded dns server, or nil
+		server *server
+
+		// updater is the running RFC2136 dynamic updates client, or nil
+		updater *updater
+
+		// isLeader is true when the local node is the cluster leader
+		isLeader bool
 	}
 
 	cmdGet struct {
 		errC
 		Name string
 		Type string
-		resp chan Zone
+
+		// Healthy filters out the records of instances not avail up
+		Healthy bool
+		resp    chan Zone
 	}
 	cmdGetZone struct {
 		errC
-		resp chan Zone
+
+		// Healthy filters out the records of instances not avail up
+		Healthy bool
+		resp    chan Zone
 	}
 
 	errC draincommand.ErrC
@@ -102,6 +123,7 @@ func NewManager(d time.Duration, subQS pubsub.QueueSizer) *Manager {
 		cmdC:          make(chan any),
 		drainDuration: d,
 		state:         make(map[stateKey]Zone),
+		avail:         make(map[stateKey]status.T),
 		score:         make(map[string]int),
 		subQS:         subQS,
 
@@ -129,6 +151,17 @@ func (t *Manager) Start(parent context.Context) error {
 		return err
 	}
 
+	if err := t.startServer(); err != nil {
+		t.log.Errorf("%s", err)
+	}
+
+	if nodeStatus := node.StatusData.GetByNode(t.localhost); nodeStatus != nil {
+		t.isLeader = nodeStatus.IsLeader
+	}
+	if err := t.startUpdater(); err != nil {
+		t.log.Errorf("%s", err)
+	}
+
 	t.wg.Add(1)
 	go func() {
 		defer func() {
@@ -166,6 +199,7 @@ func (t *Manager) startSubscriptions() {
 	sub.AddFilter(&msgbus.InstanceStatusDeleted{})
 	sub.AddFilter(&msgbus.ClusterConfigUpdated{})
 	sub.AddFilter(&msgbus.NodeStatsUpdated{})
+	sub.AddFilter(&msgbus.NodeStatusUpdated{}, pubsub.Label{"node", t.localhost})
 	sub.Start()
 	t.sub = sub
 }
@@ -198,6 +232,8 @@ func (t *Manager) worker() {
 				t.onClusterConfigUpdated(c)
 			case *msgbus.NodeStatsUpdated:
 				t.onNodeStatsUpdated(c)
+			case *msgbus.NodeStatusUpdated:
+				t.onNodeStatusUpdated(c)
 			}
 		case i := <-t.cmdC:
 			switch c := i.(type) {
@@ -8,6 +8,7 @@ import (
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/resource"
 	"github.com/opensvc/om3/v3/core/resourceid"
+	"github.com/opensvc/om3/v3/core/status"
 	"github.com/opensvc/om3/v3/daemon/msgbus"
 	"github.com/opensvc/om3/v3/util/pubsub"
 )
@@ -42,6 +43,11 @@ func (t *Manager) onNodeStatsUpdated(c *msgbus.NodeStatsUpdated) {
 	t.score[c.Node] = c.Value.Score
 }
 
+func (t *Manager) onNodeStatusUpdated(c *msgbus.NodeStatusUpdated) {
+	t.isLeader = c.Value.IsLeader
+	t.setUpdaterLeader(t.isLeader)
+}
+
 func (t *Manager) onClusterConfigUpdated(c *msgbus.ClusterConfigUpdated) {
 	t.clusterConfig = c.Value
 	change, err := t.sockChown()
@@ -65,9 +71,12 @@ func (t *Manager) onClusterConfigUpdated(c *msgbus.ClusterConfigUpdated) {
 	if change {
 		t.publishSubsystemDnsUpdated()
 	}
+	t.restartServerIfChanged()
+	t.restartUpdaterIfChanged()
 }
 
 func (t *Manager) pubDeleted(record Record, p naming.Path, node string) {
+	t.setUpdaterDirty()
 	t.publisher.Pub(&msgbus.ZoneRecordDeleted{
 		Path:    p,
 		Node:    node,
@@ -79,6 +88,7 @@ func (t *Manager) pubDeleted(record Record, p naming.Path, node string) {
 }
 
 func (t *Manager) pubUpdated(record Record, p naming.Path, node string) {
+	t.setUpdaterDirty()
 	t.publisher.Pub(&msgbus.ZoneRecordUpdated{
 		Path:    p,
 		Node:    node,
@@ -97,10 +107,12 @@ func (t *Manager) onInstanceStatusDeleted(c *msgbus.InstanceStatusDeleted) {
 		}
 		delete(t.state, key)
 	}
+	delete(t.avail, key)
 }
 
 func (t *Manager) onInstanceStatusUpdated(c *msgbus.InstanceStatusUpdated) {
 	key := t.stateKey(c.Path, c.Node)
+	t.avail[key] = c.Value.Avail
 	name := naming.NewFQDN(c.Path, t.clusterConfig.Name).String() + "."
 	nameOnNode := fmt.Sprintf("%s.%s.%s.%s.node.%s.", c.Path.Name, c.Path.Namespace, c.Path.Kind, c.Node, t.clusterConfig.Name)
 	records := make(Zone, 0)
@@ -287,7 +299,7 @@ func (t *Manager) onInstanceStatusUpdated(c *msgbus.InstanceStatusUpdated) {
 
 func (t *Manager) onCmdGet(c cmdGet) {
 	zone := make(Zone, 0)
-	for _, record := range t.zone() {
+	for _, record := range t.zone(c.Healthy) {
 		if record.Name != c.Name {
 			continue
 		}
@@ -302,10 +314,12 @@ func (t *Manager) onCmdGet(c cmdGet) {
 
 func (t *Manager) onCmdGetZone(c cmdGetZone) {
 	c.errC <- nil
-	c.resp <- t.zone()
+	c.resp <- t.zone(c.Healthy)
 }
 
-func (t *Manager) zone() Zone {
+// zone returns the cluster zone records. If healthy is true, the records
+// of the instances not avail up are excluded.
+func (t *Manager) zone(healthy bool) Zone {
 	zone := make(Zone, 0)
 	zoneName := t.clusterConfig.Name + "."
 	for i, dns := range t.clusterConfig.DNS {
@@ -335,7 +349,10 @@ func (t *Manager) zone() Zone {
 			},
 		)
 	}
-	for _, records := range t.state {
+	for key, records := range t.state {
+		if healthy && t.avail[key] != status.Up {
+			continue
+		}
 		zone = append(zone, records...)
 	}
 	return zone
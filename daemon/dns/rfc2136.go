@@ -0,0 +1,964 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/core/naming"
+)
+
+type (
+	// updater is the RFC2136 dynamic updates client pushing the cluster
+	// zone records to an external authoritative dns server.
+	//
+	// The records are renamed from the cluster zone into the update zone,
+	// so the web.ns1.svc.<cluster name>. record is pushed as
+	// web.ns1.svc.<update zone>.
+	//
+	// Only the cluster leader node pushes updates. The updater computes the
+	// differences between the records it pushed last and the current
+	// cluster zone, so the records changes done by the manager worker only
+	// need to mark the updater dirty.
+	//
+	// The external zone can be shared with other writers, so each pushed
+	// name also holds a TXT record with the cluster owner marker. A name is
+	// claimed only if it is not in use on the external server, and the
+	// updater only ever deletes records from the names holding its marker.
+	updater struct {
+		*Manager
+		ctx    context.Context
+		cancel context.CancelFunc
+		config cluster.ConfigDNS
+		zone   string
+		server string
+
+		// clusterZone is the name of the cluster zone served by the
+		// cluster dns servers.
+		clusterZone string
+
+		// owner is the content of the TXT record marking the names owned
+		// by this cluster in the update zone.
+		owner string
+
+		// pushed is the set of records known to be present on the external
+		// server, indexed by recordKey. It is nil until the first successful
+		// reconciliation.
+		pushed map[string]Record
+
+		// foreign is the set of names in use on the external server but not
+		// owned by this cluster, as seen by the last zone transfer.
+		foreign map[string]bool
+
+		dirtyC chan bool
+		leader atomic.Bool
+
+		// resync is set to force a zone transfer on next reconcile
+		resync atomic.Bool
+	}
+
+	// updateOp is a record addition or deletion in a dynamic update request
+	updateOp struct {
+		Record
+		Delete bool
+
+		// Claim requires the record name to be not in use on the external
+		// server before the update.
+		Claim bool
+	}
+
+	// tsigKey is a TSIG key, with its name and algorithm in the canonical
+	// wire format.
+	tsigKey struct {
+		name      []byte
+		algorithm []byte
+		secret    []byte
+		newHash   func() hash.Hash
+	}
+
+	// tsigRecord is the content of a TSIG record
+	tsigRecord struct {
+		name       []byte
+		algorithm  []byte
+		timeBytes  []byte
+		fudge      uint16
+		mac        []byte
+		originalID uint16
+		error      uint16
+		other      []byte
+	}
+
+	// tsigVerifier verifies the signature of the responses to a signed
+	// request.
+	tsigVerifier struct {
+		key tsigKey
+
+		// prevMAC is the request mac, then the mac of the last signed
+		// response.
+		prevMAC []byte
+
+		// unsigned are the unsigned responses received since the last
+		// signed response.
+		unsigned [][]byte
+
+		// first is true until a signed response is verified
+		first bool
+	}
+)
+
+const (
+	opCodeUpdate = dnsmessage.OpCode(5)
+
+	// classNONE is the class used to delete a resource record from a
+	// resource record set in a dynamic update request (RFC2136 2.5.4).
+	classNONE = dnsmessage.Class(254)
+
+	typeTSIG = dnsmessage.Type(250)
+
+	// ownerPrefix prefixes the cluster id in the content of the TXT
+	// records marking the names owned by a cluster in the update zone.
+	ownerPrefix = "opensvc-owner="
+
+	// tsigFudge is the allowed time skew of the signed requests, in seconds
+	tsigFudge = 300
+
+	// tsigMaxUnsigned is the maximum number of consecutive unsigned
+	// messages in a signed zone transfer response (RFC8945 5.3.1).
+	tsigMaxUnsigned = 99
+
+	// updateDebounce is the delay to coalesce the records changes in a
+	// single dynamic update request.
+	updateDebounce = time.Second
+
+	// updateMaxOps is the maximum number of updates per request
+	updateMaxOps = 200
+
+	updateTimeout = 10 * time.Second
+)
+
+var (
+	updateCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "opensvc",
+			Subsystem: "dns",
+			Name:      "update_requests_total",
+		}, []string{"result"})
+
+	updateRecordCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "opensvc",
+			Subsystem: "dns",
+			Name:      "update_records_total",
+		}, []string{"op"})
+)
+
+// startUpdater starts the dynamic updates client if the cluster dns
+// configuration has an update server set.
+func (t *Manager) startUpdater() error {
+	config := *t.clusterConfig.DNSServer.DeepCopy()
+	if config.UpdateServer == "" {
+		return nil
+	}
+	zone := config.UpdateZone
+	if zone == "" {
+		zone = t.clusterConfig.Name
+	}
+	zone = strings.ToLower(strings.TrimSuffix(zone, ".") + ".")
+	server := config.UpdateServer
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "53")
+	}
+	if config.UpdateTSIGKeyName != "" {
+		if _, err := tsigHash(config.UpdateTSIGAlgorithm); err != nil {
+			return fmt.Errorf("updater: %w", err)
+		}
+		if _, err := base64.StdEncoding.DecodeString(config.UpdateTSIGSecret()); err != nil {
+			return fmt.Errorf("updater: decode tsig secret: %w", err)
+		}
+	}
+	ctx, cancel := context.WithCancel(t.ctx)
+	u := &updater{
+		Manager: t,
+		ctx:     ctx,
+		cancel:  cancel,
+		config:  config,
+		zone:    zone,
+		server:  server,
+		dirtyC:  make(chan bool, 1),
+
+		clusterZone: strings.ToLower(t.clusterConfig.Name + "."),
+		owner:       ownerPrefix + t.clusterConfig.ID,
+	}
+	u.leader.Store(t.isLeader)
+	t.updater = u
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		u.run()
+	}()
+	t.log.Infof("updater: push zone %s records to %s", zone, server)
+	return nil
+}
+
+// stopUpdater stops the dynamic updates client, if started.
+func (t *Manager) stopUpdater() {
+	if t.updater == nil {
+		return
+	}
+	t.log.Infof("updater: stop")
+	t.updater.cancel()
+	t.updater = nil
+}
+
+// restartUpdaterIfChanged restarts the dynamic updates client when its
+// configuration has changed.
+func (t *Manager) restartUpdaterIfChanged() {
+	config := t.clusterConfig.DNSServer
+	if t.updater == nil {
+		if config.UpdateServer == "" {
+			return
+		}
+	} else {
+		running := t.updater.config
+		if running.UpdateServer == config.UpdateServer &&
+			running.UpdateZone == config.UpdateZone &&
+			running.UpdateTSIGKeyName == config.UpdateTSIGKeyName &&
+			running.UpdateTSIGAlgorithm == config.UpdateTSIGAlgorithm &&
+			running.UpdateTSIGSecret() == config.UpdateTSIGSecret() &&
+			running.UpdateReconcileInterval == config.UpdateReconcileInterval {
+			return
+		}
+	}
+	t.stopUpdater()
+	if err := t.startUpdater(); err != nil {
+		t.log.Errorf("%s", err)
+	}
+}
+
+// setUpdaterDirty notifies the updater the cluster zone has changed.
+func (t *Manager) setUpdaterDirty() {
+	if t.updater == nil {
+		return
+	}
+	select {
+	case t.updater.dirtyC <- true:
+	default:
+	}
+}
+
+// setUpdaterLeader notifies the updater the local node leadership has
+// changed. A node becoming leader does a full reconciliation.
+func (t *Manager) setUpdaterLeader(v bool) {
+	if t.updater == nil {
+		return
+	}
+	if t.updater.leader.Swap(v) != v && v {
+		t.updater.resync.Store(true)
+		t.setUpdaterDirty()
+	}
+}
+
+func (t *updater) run() {
+	interval := t.config.UpdateReconcileInterval
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var debounceC <-chan time.Time
+
+	t.reconcile()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+			t.resync.Store(true)
+			t.reconcile()
+		case <-t.dirtyC:
+			if debounceC == nil {
+				debounceC = time.After(updateDebounce)
+			}
+		case <-debounceC:
+			debounceC = nil
+			t.reconcile()
+		}
+	}
+}
+
+// reconcile pushes the differences between the records known present on
+// the external server and the current cluster zone records. If the
+// external server content is unknown, it is first fetched with a zone
+// transfer.
+func (t *updater) reconcile() {
+	if !t.leader.Load() {
+		return
+	}
+	if t.resync.Swap(false) {
+		t.pushed = nil
+	}
+	transferred := false
+	if t.pushed == nil {
+		remote, foreign, err := t.transfer()
+		if err != nil {
+			t.log.Warnf("updater: %s: axfr %s: %s", t.server, t.zone, err)
+			return
+		}
+		t.pushed = remote
+		t.foreign = foreign
+		transferred = true
+	}
+	desired := make(map[string]Record)
+	for _, record := range t.getUpdaterZone() {
+		record = t.toUpdateZone(record)
+		if !t.isPushed(record) {
+			continue
+		}
+		if t.foreign[strings.ToLower(record.Name)] {
+			if transferred {
+				t.log.Warnf("updater: %s: skip record %s %s: name in use and not owned by this cluster", t.server, record.Name, record.Type)
+			}
+			continue
+		}
+		// normalize the record content, so it compares with the
+		// content of the records fetched from the external server.
+		rr, err := record.resource()
+		if err != nil {
+			continue
+		}
+		if record, ok := newRecordFromResource(rr); ok {
+			desired[recordKey(record)] = record
+			marker := t.ownerRecord(record)
+			desired[recordKey(marker)] = marker
+		}
+	}
+
+	// add the owner markers first and delete them last, so a name holding
+	// records pushed by this cluster always holds its owner marker.
+	var claims, adds, deletes, releases []updateOp
+	for key, record := range t.pushed {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if record.Type == "TXT" {
+			releases = append(releases, updateOp{Record: record, Delete: true})
+		} else {
+			deletes = append(deletes, updateOp{Record: record, Delete: true})
+		}
+	}
+	for key, record := range desired {
+		if _, ok := t.pushed[key]; ok {
+			continue
+		}
+		if record.Type == "TXT" {
+			claims = append(claims, updateOp{Record: record, Claim: true})
+		} else {
+			adds = append(adds, updateOp{Record: record})
+		}
+	}
+	ops := append(append(append(claims, adds...), deletes...), releases...)
+	for len(ops) > 0 {
+		n := len(ops)
+		if n > updateMaxOps {
+			n = updateMaxOps
+		}
+		if err := t.update(ops[:n]); err != nil {
+			updateCount.WithLabelValues("error").Inc()
+			t.log.Warnf("updater: %s: update %s: %s", t.server, t.zone, err)
+			// the external server state is unknown, force a zone transfer
+			// on next reconcile.
+			t.pushed = nil
+			return
+		}
+		updateCount.WithLabelValues("ok").Inc()
+		for _, op := range ops[:n] {
+			if op.Delete {
+				updateRecordCount.WithLabelValues("delete").Inc()
+				t.log.Debugf("updater: deleted %s %s %s", op.Name, op.Type, op.Content)
+				delete(t.pushed, recordKey(op.Record))
+			} else {
+				updateRecordCount.WithLabelValues("add").Inc()
+				t.log.Debugf("updater: added %s %s %s", op.Name, op.Type, op.Content)
+				t.pushed[recordKey(op.Record)] = op.Record
+			}
+		}
+		ops = ops[n:]
+	}
+}
+
+// isPushed returns true if the record is an object record pushed by the
+// updater.
+//
+// The updater pushes the A, AAAA and SRV records in the update zone whose
+// name is like <name>.<namespace>.<kind>.<zone> or
+// <name>.<namespace>.<kind>.<node>.node.<zone>. The cluster nameservers
+// records are not pushed.
+func (t *updater) isPushed(record Record) bool {
+	switch record.Type {
+	case "A", "AAAA", "SRV":
+	default:
+		return false
+	}
+	name := strings.ToLower(record.Name)
+	if !strings.HasSuffix(name, "."+t.zone) {
+		return false
+	}
+	labels := strings.Split(strings.TrimSuffix(name, "."+t.zone), ".")
+	if len(labels) < 3 {
+		return false
+	}
+	last := labels[len(labels)-1]
+	if last == "node" {
+		return len(labels) >= 5
+	}
+	return naming.ParseKind(last) != naming.KindInvalid
+}
+
+// ownerRecord returns the TXT record marking the record name as owned by
+// this cluster.
+func (t *updater) ownerRecord(record Record) Record {
+	return Record{
+		Name:     strings.ToLower(record.Name),
+		Type:     "TXT",
+		TTL:      record.TTL,
+		Content:  t.owner,
+		DomainID: -1,
+	}
+}
+
+// toUpdateZone returns the record renamed from the cluster zone into the
+// update zone. The SRV record target is renamed too.
+func (t *updater) toUpdateZone(record Record) Record {
+	record.Name = t.toUpdateZoneName(record.Name)
+	if record.Type == "SRV" {
+		if l := strings.Fields(record.Content); len(l) == 4 {
+			l[3] = t.toUpdateZoneName(l[3])
+			record.Content = strings.Join(l, " ")
+		}
+	}
+	return record
+}
+
+func (t *updater) toUpdateZoneName(name string) string {
+	lname := strings.ToLower(name)
+	switch {
+	case lname == t.clusterZone:
+		return t.zone
+	case strings.HasSuffix(lname, "."+t.clusterZone):
+		return lname[:len(lname)-len(t.clusterZone)] + t.zone
+	default:
+		return name
+	}
+}
+
+func (t *updater) getUpdaterZone() Zone {
+	err := make(chan error, 1)
+	c := cmdGetZone{
+		errC: err,
+		resp: make(chan Zone),
+	}
+	select {
+	case t.cmdC <- c:
+	case <-t.ctx.Done():
+		return Zone{}
+	}
+	if <-err != nil {
+		return Zone{}
+	}
+	return <-c.resp
+}
+
+// update sends a dynamic update request adding and deleting records.
+func (t *updater) update(ops []updateOp) error {
+	zoneName, err := dnsmessage.NewName(t.zone)
+	if err != nil {
+		return err
+	}
+	msg := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:     uint16(rand.Intn(1 << 16)),
+			OpCode: opCodeUpdate,
+		},
+		Questions: []dnsmessage.Question{
+			{Name: zoneName, Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET},
+		},
+	}
+	for _, op := range ops {
+		rr, err := op.resource()
+		if err != nil {
+			t.log.Debugf("updater: skip record %s %s %s: %s", op.Name, op.Type, op.Content, err)
+			continue
+		}
+		if op.Claim {
+			// name is not in use prerequisite (RFC2136 2.4.5)
+			msg.Answers = append(msg.Answers, dnsmessage.Resource{
+				Header: dnsmessage.ResourceHeader{
+					Name:  rr.Header.Name,
+					Type:  dnsmessage.TypeALL,
+					Class: classNONE,
+				},
+				Body: &dnsmessage.UnknownResource{Type: dnsmessage.TypeALL},
+			})
+		}
+		if op.Delete {
+			rr.Header.Class = classNONE
+			rr.Header.TTL = 0
+		}
+		msg.Authorities = append(msg.Authorities, rr)
+	}
+	resp, err := t.exchange(msg)
+	if err != nil {
+		return err
+	}
+	if len(resp) == 0 {
+		return fmt.Errorf("no response")
+	}
+	if rcode := resp[0].Header.RCode; rcode != dnsmessage.RCodeSuccess {
+		return fmt.Errorf("server responded %s", rcode)
+	}
+	return nil
+}
+
+// transfer returns the records of the external zone owned by this
+// cluster, and the names in use but not owned by this cluster, fetched
+// with a zone transfer.
+//
+// A name is owned if its only owner marker is the cluster one. The owned
+// records are the A, AAAA and SRV records of the owned names, and their
+// owner markers.
+func (t *updater) transfer() (map[string]Record, map[string]bool, error) {
+	zoneName, err := dnsmessage.NewName(t.zone)
+	if err != nil {
+		return nil, nil, err
+	}
+	msg := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID: uint16(rand.Intn(1 << 16)),
+		},
+		Questions: []dnsmessage.Question{
+			{Name: zoneName, Type: dnsmessage.TypeAXFR, Class: dnsmessage.ClassINET},
+		},
+	}
+	responses, err := t.exchange(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	records := make([]Record, 0)
+	owners := make(map[string][]string)
+	for _, resp := range responses {
+		if rcode := resp.Header.RCode; rcode != dnsmessage.RCodeSuccess {
+			return nil, nil, fmt.Errorf("server responded %s", rcode)
+		}
+		for _, rr := range resp.Answers {
+			name := strings.ToLower(rr.Header.Name.String())
+			if !strings.HasSuffix(name, "."+t.zone) {
+				continue
+			}
+			if _, ok := owners[name]; !ok {
+				owners[name] = make([]string, 0)
+			}
+			record, ok := newRecordFromResource(rr)
+			if !ok {
+				continue
+			}
+			if record.Type == "TXT" && strings.HasPrefix(record.Content, ownerPrefix) {
+				owners[name] = append(owners[name], record.Content)
+			}
+			records = append(records, record)
+		}
+	}
+	foreign := make(map[string]bool)
+	for name, l := range owners {
+		if len(l) != 1 || l[0] != t.owner {
+			foreign[name] = true
+		}
+	}
+	m := make(map[string]Record)
+	for _, record := range records {
+		if foreign[record.Name] {
+			continue
+		}
+		if t.isPushed(record) || record.Content == t.owner {
+			m[recordKey(record)] = record
+		}
+	}
+	return m, foreign, nil
+}
+
+// exchange sends the signed request over tcp and returns the response
+// messages. A zone transfer response can span multiple messages, the last
+// one ending with the zone SOA record.
+func (t *updater) exchange(msg dnsmessage.Message) ([]dnsmessage.Message, error) {
+	b, err := msg.Pack()
+	if err != nil {
+		return nil, err
+	}
+	b, requestMAC, err := t.sign(b, msg.Header.ID)
+	if err != nil {
+		return nil, err
+	}
+	var verifier *tsigVerifier
+	if requestMAC != nil {
+		key, err := t.tsigKey()
+		if err != nil {
+			return nil, err
+		}
+		verifier = &tsigVerifier{key: key, prevMAC: requestMAC, first: true}
+	}
+	dialer := net.Dialer{Timeout: updateTimeout}
+	conn, err := dialer.DialContext(t.ctx, "tcp", t.server)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	if err := conn.SetDeadline(time.Now().Add(updateTimeout)); err != nil {
+		return nil, err
+	}
+	prefix := binary.BigEndian.AppendUint16(nil, uint16(len(b)))
+	if _, err := conn.Write(append(prefix, b...)); err != nil {
+		return nil, err
+	}
+	isAXFR := msg.Questions[0].Type == dnsmessage.TypeAXFR
+	l := make([]dnsmessage.Message, 0)
+	soaCount := 0
+	for {
+		var length uint16
+		if err := binary.Read(conn, binary.BigEndian, &length); err != nil {
+			return nil, err
+		}
+		buf := make([]byte, length)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return nil, err
+		}
+		var resp dnsmessage.Message
+		if err := resp.Unpack(buf); err != nil {
+			return nil, err
+		}
+		if resp.Header.ID != msg.Header.ID {
+			return nil, fmt.Errorf("response id %d mismatch request id %d", resp.Header.ID, msg.Header.ID)
+		}
+		if verifier != nil {
+			if err := verifier.verify(buf, time.Now()); err != nil {
+				return nil, err
+			}
+		}
+		l = append(l, resp)
+		if !isAXFR || resp.Header.RCode != dnsmessage.RCodeSuccess {
+			return l, nil
+		}
+		for _, rr := range resp.Answers {
+			if rr.Header.Type == dnsmessage.TypeSOA {
+				soaCount++
+			}
+		}
+		if soaCount >= 2 {
+			if verifier != nil {
+				if err := verifier.done(); err != nil {
+					return nil, err
+				}
+			}
+			return l, nil
+		}
+	}
+}
+
+// sign appends a TSIG record to the packed message b, as defined by
+// RFC8945, and returns the signed message and its mac. The message is
+// returned unchanged if no TSIG key is configured.
+func (t *updater) sign(b []byte, id uint16) ([]byte, []byte, error) {
+	if t.config.UpdateTSIGKeyName == "" {
+		return b, nil, nil
+	}
+	key, err := t.tsigKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	return key.sign(b, id, nil, time.Now())
+}
+
+// tsigKey returns the TSIG key of the updater configuration.
+func (t *updater) tsigKey() (tsigKey, error) {
+	var key tsigKey
+	var err error
+	if key.newHash, err = tsigHash(t.config.UpdateTSIGAlgorithm); err != nil {
+		return key, err
+	}
+	if key.secret, err = base64.StdEncoding.DecodeString(t.config.UpdateTSIGSecret()); err != nil {
+		return key, err
+	}
+	if key.name, err = canonicalName(t.config.UpdateTSIGKeyName); err != nil {
+		return key, err
+	}
+	algorithm := t.config.UpdateTSIGAlgorithm
+	if algorithm == "" {
+		algorithm = "hmac-sha256"
+	}
+	if key.algorithm, err = canonicalName(algorithm); err != nil {
+		return key, err
+	}
+	return key, nil
+}
+
+// sign appends a TSIG record to the packed message b. The request mac
+// is set when signing a response, as the response digest starts with it.
+func (k tsigKey) sign(b []byte, id uint16, requestMAC []byte, now time.Time) ([]byte, []byte, error) {
+	timeSigned := uint64(now.Unix())
+	timeBytes := []byte{
+		byte(timeSigned >> 40), byte(timeSigned >> 32), byte(timeSigned >> 24),
+		byte(timeSigned >> 16), byte(timeSigned >> 8), byte(timeSigned),
+	}
+
+	// digest the message and the TSIG variables
+	mac := hmac.New(k.newHash, k.secret)
+	if requestMAC != nil {
+		mac.Write(binary.BigEndian.AppendUint16(nil, uint16(len(requestMAC))))
+		mac.Write(requestMAC)
+	}
+	mac.Write(b)
+	k.writeVariables(mac, timeBytes, 0, nil)
+	sum := mac.Sum(nil)
+
+	rdata := append([]byte{}, k.algorithm...)
+	rdata = append(rdata, timeBytes...)
+	rdata = binary.BigEndian.AppendUint16(rdata, tsigFudge)
+	rdata = binary.BigEndian.AppendUint16(rdata, uint16(len(sum)))
+	rdata = append(rdata, sum...)
+	rdata = binary.BigEndian.AppendUint16(rdata, id)
+	rdata = binary.BigEndian.AppendUint16(rdata, 0) // error
+	rdata = binary.BigEndian.AppendUint16(rdata, 0) // other len
+
+	rr := append([]byte{}, k.name...)
+	rr = binary.BigEndian.AppendUint16(rr, uint16(typeTSIG))
+	rr = binary.BigEndian.AppendUint16(rr, uint16(dnsmessage.ClassANY))
+	rr = binary.BigEndian.AppendUint32(rr, 0)
+	rr = binary.BigEndian.AppendUint16(rr, uint16(len(rdata)))
+	rr = append(rr, rdata...)
+
+	signed := append([]byte{}, b...)
+	// increment the additional records count
+	arCount := binary.BigEndian.Uint16(signed[10:12])
+	binary.BigEndian.PutUint16(signed[10:12], arCount+1)
+	return append(signed, rr...), sum, nil
+}
+
+// writeVariables digests the TSIG variables of RFC8945 4.3.3.
+func (k tsigKey) writeVariables(w io.Writer, timeBytes []byte, tsigError uint16, other []byte) {
+	_, _ = w.Write(k.name)
+	_, _ = w.Write(binary.BigEndian.AppendUint16(nil, uint16(dnsmessage.ClassANY)))
+	_, _ = w.Write(binary.BigEndian.AppendUint32(nil, 0))
+	_, _ = w.Write(k.algorithm)
+	_, _ = w.Write(timeBytes)
+	_, _ = w.Write(binary.BigEndian.AppendUint16(nil, tsigFudge))
+	_, _ = w.Write(binary.BigEndian.AppendUint16(nil, tsigError))
+	_, _ = w.Write(binary.BigEndian.AppendUint16(nil, uint16(len(other))))
+	_, _ = w.Write(other)
+}
+
+// verify verifies the TSIG record of the packed response message b, as
+// defined by RFC8945 5.3. The messages of a zone transfer response are
+// verified in sequence: only the first message digest includes the TSIG
+// variables, and up to 99 unsigned messages can follow a signed one.
+func (v *tsigVerifier) verify(b []byte, now time.Time) error {
+	tsig, offset, ok, err := parseTSIG(b)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if v.first {
+			return fmt.Errorf("unsigned response")
+		}
+		if len(v.unsigned) >= tsigMaxUnsigned {
+			return fmt.Errorf("too many unsigned responses")
+		}
+		v.unsigned = append(v.unsigned, b)
+		return nil
+	}
+	if !bytes.Equal(tsig.name, v.key.name) || !bytes.Equal(tsig.algorithm, v.key.algorithm) {
+		return fmt.Errorf("response signed with an unexpected key")
+	}
+	if tsig.error != 0 {
+		return fmt.Errorf("response tsig error %d", tsig.error)
+	}
+	stripped := append([]byte{}, b[:offset]...)
+	binary.BigEndian.PutUint16(stripped[0:2], tsig.originalID)
+	arCount := binary.BigEndian.Uint16(stripped[10:12])
+	binary.BigEndian.PutUint16(stripped[10:12], arCount-1)
+
+	mac := hmac.New(v.key.newHash, v.key.secret)
+	mac.Write(binary.BigEndian.AppendUint16(nil, uint16(len(v.prevMAC))))
+	mac.Write(v.prevMAC)
+	for _, unsigned := range v.unsigned {
+		mac.Write(unsigned)
+	}
+	mac.Write(stripped)
+	if v.first {
+		v.key.writeVariables(mac, tsig.timeBytes, tsig.error, tsig.other)
+	} else {
+		mac.Write(tsig.timeBytes)
+		mac.Write(binary.BigEndian.AppendUint16(nil, tsig.fudge))
+	}
+	if !hmac.Equal(mac.Sum(nil), tsig.mac) {
+		return fmt.Errorf("bad response signature")
+	}
+	var timeSigned int64
+	for _, c := range tsig.timeBytes {
+		timeSigned = timeSigned<<8 | int64(c)
+	}
+	if skew := now.Unix() - timeSigned; skew > int64(tsig.fudge) || -skew > int64(tsig.fudge) {
+		return fmt.Errorf("response signed %ds away from the local time", skew)
+	}
+	v.prevMAC = tsig.mac
+	v.unsigned = nil
+	v.first = false
+	return nil
+}
+
+// done returns an error if the last verified message was not signed.
+func (v *tsigVerifier) done() error {
+	if len(v.unsigned) > 0 {
+		return fmt.Errorf("unsigned last response")
+	}
+	return nil
+}
+
+// parseTSIG returns the TSIG record ending the packed message b, and the
+// offset of the record in b.
+func parseTSIG(b []byte) (tsigRecord, int, bool, error) {
+	var tsig tsigRecord
+	var msg dnsmessage.Message
+	if err := msg.Unpack(b); err != nil {
+		return tsig, 0, false, err
+	}
+	if len(msg.Additionals) == 0 {
+		return tsig, 0, false, nil
+	}
+	rr := msg.Additionals[len(msg.Additionals)-1]
+	if rr.Header.Type != typeTSIG {
+		return tsig, 0, false, nil
+	}
+	body, ok := rr.Body.(*dnsmessage.UnknownResource)
+	if !ok {
+		return tsig, 0, false, fmt.Errorf("unexpected tsig record body")
+	}
+	name, err := canonicalName(rr.Header.Name.String())
+	if err != nil {
+		return tsig, 0, false, err
+	}
+	// the TSIG owner name is not compressed (RFC8945 4.2)
+	offset := len(b) - len(body.Data) - 10 - len(name)
+	if offset < 12 || !bytes.EqualFold(b[offset:offset+len(name)], name) {
+		return tsig, 0, false, fmt.Errorf("compressed tsig record name")
+	}
+	tsig.name = name
+	data := body.Data
+	i := 0
+	for i < len(data) && data[i] != 0 {
+		i += int(data[i]) + 1
+	}
+	i++
+	if i+10 > len(data) {
+		return tsig, 0, false, fmt.Errorf("short tsig record")
+	}
+	tsig.algorithm = bytes.ToLower(data[:i])
+	tsig.timeBytes = data[i : i+6]
+	tsig.fudge = binary.BigEndian.Uint16(data[i+6 : i+8])
+	macSize := int(binary.BigEndian.Uint16(data[i+8 : i+10]))
+	i += 10
+	if i+macSize+6 > len(data) {
+		return tsig, 0, false, fmt.Errorf("short tsig record")
+	}
+	tsig.mac = data[i : i+macSize]
+	i += macSize
+	tsig.originalID = binary.BigEndian.Uint16(data[i : i+2])
+	tsig.error = binary.BigEndian.Uint16(data[i+2 : i+4])
+	otherLen := int(binary.BigEndian.Uint16(data[i+4 : i+6]))
+	i += 6
+	if i+otherLen > len(data) {
+		return tsig, 0, false, fmt.Errorf("short tsig record")
+	}
+	tsig.other = data[i : i+otherLen]
+	return tsig, offset, true, nil
+}
+
+func tsigHash(algorithm string) (func() hash.Hash, error) {
+	switch algorithm {
+	case "hmac-sha1":
+		return sha1.New, nil
+	case "hmac-sha256", "":
+		return sha256.New, nil
+	case "hmac-sha512":
+		return sha512.New, nil
+	default:
+		return nil, fmt.Errorf("unsupported tsig algorithm %s", algorithm)
+	}
+}
+
+// canonicalName returns the uncompressed, lowercase wire format of a
+// domain name.
+func canonicalName(s string) ([]byte, error) {
+	s = strings.ToLower(strings.TrimSuffix(s, "."))
+	b := make([]byte, 0, len(s)+2)
+	if s != "" {
+		for _, label := range strings.Split(s, ".") {
+			if len(label) == 0 || len(label) > 63 {
+				return nil, fmt.Errorf("invalid name %s", s)
+			}
+			b = append(b, byte(len(label)))
+			b = append(b, label...)
+		}
+	}
+	return append(b, 0), nil
+}
+
+func recordKey(record Record) string {
+	return strings.ToLower(record.Name) + " " + record.Type + " " + record.Content
+}
+
+// newRecordFromResource converts a dns message resource to a record, using
+// the same content format as the zone records.
+func newRecordFromResource(rr dnsmessage.Resource) (Record, bool) {
+	record := Record{
+		Name:     strings.ToLower(rr.Header.Name.String()),
+		TTL:      int(rr.Header.TTL),
+		DomainID: -1,
+	}
+	switch body := rr.Body.(type) {
+	case *dnsmessage.AResource:
+		record.Type = "A"
+		record.Content = net.IP(body.A[:]).String()
+	case *dnsmessage.AAAAResource:
+		record.Type = "AAAA"
+		record.Content = net.IP(body.AAAA[:]).String()
+	case *dnsmessage.SRVResource:
+		record.Type = "SRV"
+		record.Content = fmt.Sprintf("%d %d %d %s", body.Priority, body.Weight, body.Port, strings.ToLower(body.Target.String()))
+	case *dnsmessage.TXTResource:
+		record.Type = "TXT"
+		record.Content = strings.Join(body.TXT, "")
+	default:
+		return record, false
+	}
+	return record, true
+}
@@ -0,0 +1,411 @@
+package dns
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+)
+
+func TestUpdaterIsPushed(t *testing.T) {
+	u := &updater{zone: "test.example.com."}
+	cases := map[string]struct {
+		record   Record
+		expected bool
+	}{
+		"svc A": {
+			record:   Record{Name: "web.ns1.svc.test.example.com.", Type: "A"},
+			expected: true,
+		},
+		"node affine A": {
+			record:   Record{Name: "web.ns1.svc.n1.node.test.example.com.", Type: "A"},
+			expected: true,
+		},
+		"SRV": {
+			record:   Record{Name: "_80._tcp.web.ns1.svc.test.example.com.", Type: "SRV"},
+			expected: true,
+		},
+		"nameserver A": {
+			record:   Record{Name: "ns1.test.example.com.", Type: "A"},
+			expected: false,
+		},
+		"apex A": {
+			record:   Record{Name: "test.example.com.", Type: "A"},
+			expected: false,
+		},
+		"TXT": {
+			record:   Record{Name: "web.ns1.svc.test.example.com.", Type: "TXT"},
+			expected: false,
+		},
+		"out of zone": {
+			record:   Record{Name: "web.ns1.svc.other.example.com.", Type: "A"},
+			expected: false,
+		},
+		"SOA": {
+			record:   Record{Name: "test.example.com.", Type: "SOA"},
+			expected: false,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, c.expected, u.isPushed(c.record))
+		})
+	}
+}
+
+func TestUpdaterSign(t *testing.T) {
+	secret := []byte("secret")
+	config := cluster.ConfigDNS{
+		UpdateTSIGKeyName:   "om3-update",
+		UpdateTSIGAlgorithm: "hmac-sha256",
+	}
+	config.SetUpdateTSIGSecret(base64.StdEncoding.EncodeToString(secret))
+	u := &updater{config: config}
+
+	msg := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: 42, OpCode: opCodeUpdate},
+		Questions: []dnsmessage.Question{
+			{Name: dnsmessage.MustNewName("test.example.com."), Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET},
+		},
+	}
+	b, err := msg.Pack()
+	require.NoError(t, err)
+	signed, requestMAC, err := u.sign(b, 42)
+	require.NoError(t, err)
+
+	var parsed dnsmessage.Message
+	require.NoError(t, parsed.Unpack(signed))
+	require.Len(t, parsed.Additionals, 1)
+	tsig := parsed.Additionals[0]
+	require.Equal(t, typeTSIG, tsig.Header.Type)
+	require.Equal(t, "om3-update.", tsig.Header.Name.String())
+
+	// verify the mac: the rdata starts with the algorithm name, followed by
+	// the time signed (6 bytes), the fudge (2 bytes), the mac size (2 bytes)
+	// and the mac.
+	rdata := tsig.Body.(*dnsmessage.UnknownResource).Data
+	algorithmName, err := canonicalName("hmac-sha256")
+	require.NoError(t, err)
+	require.Equal(t, algorithmName, rdata[:len(algorithmName)])
+	off := len(algorithmName)
+	timeBytes := rdata[off : off+6]
+	macSize := int(rdata[off+8])<<8 | int(rdata[off+9])
+	sum := rdata[off+10 : off+10+macSize]
+
+	keyName, err := canonicalName("om3-update")
+	require.NoError(t, err)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(b)
+	mac.Write(keyName)
+	mac.Write([]byte{0, 255, 0, 0, 0, 0})
+	mac.Write(algorithmName)
+	mac.Write(timeBytes)
+	mac.Write([]byte{tsigFudge >> 8, tsigFudge & 0xff, 0, 0, 0, 0})
+	require.Equal(t, mac.Sum(nil), sum)
+	require.Equal(t, sum, requestMAC)
+}
+
+func TestUpdaterToUpdateZone(t *testing.T) {
+	u := &updater{zone: "cluster1.example.com.", clusterZone: "cluster1."}
+	cases := map[string]struct {
+		record   Record
+		expected Record
+	}{
+		"A": {
+			record:   Record{Name: "web.ns1.svc.cluster1.", Type: "A", Content: "10.0.0.1"},
+			expected: Record{Name: "web.ns1.svc.cluster1.example.com.", Type: "A", Content: "10.0.0.1"},
+		},
+		"node affine A": {
+			record:   Record{Name: "web.ns1.svc.n1.node.cluster1.", Type: "A", Content: "10.0.0.1"},
+			expected: Record{Name: "web.ns1.svc.n1.node.cluster1.example.com.", Type: "A", Content: "10.0.0.1"},
+		},
+		"SRV": {
+			record:   Record{Name: "_80._tcp.web.ns1.svc.cluster1.", Type: "SRV", Content: "10 10 80 web.ns1.svc.n1.node.cluster1."},
+			expected: Record{Name: "_80._tcp.web.ns1.svc.cluster1.example.com.", Type: "SRV", Content: "10 10 80 web.ns1.svc.n1.node.cluster1.example.com."},
+		},
+		"out of cluster zone": {
+			record:   Record{Name: "www.example.com.", Type: "A", Content: "10.0.0.1"},
+			expected: Record{Name: "www.example.com.", Type: "A", Content: "10.0.0.1"},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			record := u.toUpdateZone(c.record)
+			require.Equal(t, c.expected, record)
+			require.True(t, u.isPushed(record) || name == "out of cluster zone")
+		})
+	}
+}
+
+func TestTSIGVerify(t *testing.T) {
+	config := cluster.ConfigDNS{
+		UpdateTSIGKeyName:   "om3-update",
+		UpdateTSIGAlgorithm: "hmac-sha256",
+	}
+	config.SetUpdateTSIGSecret(base64.StdEncoding.EncodeToString([]byte("secret")))
+	u := &updater{config: config}
+	key, err := u.tsigKey()
+	require.NoError(t, err)
+	otherConfig := config
+	otherConfig.SetUpdateTSIGSecret(base64.StdEncoding.EncodeToString([]byte("other")))
+	otherKey, err := (&updater{config: otherConfig}).tsigKey()
+	require.NoError(t, err)
+
+	now := time.Now()
+	requestMAC := []byte("request mac")
+	resp := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: 42, Response: true, OpCode: opCodeUpdate},
+		Questions: []dnsmessage.Question{
+			{Name: dnsmessage.MustNewName("test.example.com."), Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET},
+		},
+	}
+	b, err := resp.Pack()
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		sign func() []byte
+		ok   bool
+	}{
+		"signed": {
+			sign: func() []byte {
+				signed, _, err := key.sign(b, 42, requestMAC, now)
+				require.NoError(t, err)
+				return signed
+			},
+			ok: true,
+		},
+		"unsigned": {
+			sign: func() []byte { return b },
+		},
+		"other key": {
+			sign: func() []byte {
+				signed, _, err := otherKey.sign(b, 42, requestMAC, now)
+				require.NoError(t, err)
+				return signed
+			},
+		},
+		"other request": {
+			sign: func() []byte {
+				signed, _, err := key.sign(b, 42, []byte("other request mac"), now)
+				require.NoError(t, err)
+				return signed
+			},
+		},
+		"tampered": {
+			sign: func() []byte {
+				signed, _, err := key.sign(b, 42, requestMAC, now)
+				require.NoError(t, err)
+				signed[3] ^= 1
+				return signed
+			},
+		},
+		"expired": {
+			sign: func() []byte {
+				signed, _, err := key.sign(b, 42, requestMAC, now.Add(-time.Hour))
+				require.NoError(t, err)
+				return signed
+			},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &tsigVerifier{key: key, prevMAC: requestMAC, first: true}
+			err := v.verify(c.sign(), now)
+			if c.ok {
+				require.NoError(t, err)
+				require.NoError(t, v.done())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// fakeUpdateServer is a tcp dns server answering the zone transfers with
+// its records, and applying the dynamic updates to them.
+type fakeUpdateServer struct {
+	sync.Mutex
+	zone    string
+	records map[string]Record
+	updates int
+}
+
+func newFakeUpdateServer(t *testing.T, zone string, records ...Record) (*fakeUpdateServer, string) {
+	srv := &fakeUpdateServer{zone: zone, records: make(map[string]Record)}
+	srv.add(records...)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			srv.serve(conn)
+		}
+	}()
+	return srv, ln.Addr().String()
+}
+
+func (srv *fakeUpdateServer) add(records ...Record) {
+	srv.Lock()
+	defer srv.Unlock()
+	for _, record := range records {
+		record.DomainID = -1
+		srv.records[recordKey(record)] = record
+	}
+}
+
+func (srv *fakeUpdateServer) keys() []string {
+	srv.Lock()
+	defer srv.Unlock()
+	l := make([]string, 0, len(srv.records))
+	for key := range srv.records {
+		l = append(l, key)
+	}
+	sort.Strings(l)
+	return l
+}
+
+func (srv *fakeUpdateServer) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	var length uint16
+	if err := binary.Read(conn, binary.BigEndian, &length); err != nil {
+		return
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		return
+	}
+	var req dnsmessage.Message
+	if err := req.Unpack(buf); err != nil {
+		return
+	}
+	resp := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: req.Header.ID, Response: true, OpCode: req.Header.OpCode},
+		Questions: req.Questions,
+	}
+	srv.Lock()
+	if req.Header.OpCode == opCodeUpdate {
+		resp.Header.RCode = srv.update(req)
+	} else {
+		soa := dnsmessage.Resource{
+			Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(srv.zone), Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET},
+			Body: &dnsmessage.SOAResource{
+				NS:   dnsmessage.MustNewName("ns1." + srv.zone),
+				MBox: dnsmessage.MustNewName("contact." + srv.zone),
+			},
+		}
+		resp.Answers = append(resp.Answers, soa)
+		for _, record := range srv.records {
+			rr, _ := record.resource()
+			resp.Answers = append(resp.Answers, rr)
+		}
+		resp.Answers = append(resp.Answers, soa)
+	}
+	srv.Unlock()
+	b, err := resp.Pack()
+	if err != nil {
+		return
+	}
+	_, _ = conn.Write(append(binary.BigEndian.AppendUint16(nil, uint16(len(b))), b...))
+}
+
+func (srv *fakeUpdateServer) update(req dnsmessage.Message) dnsmessage.RCode {
+	srv.updates++
+	for _, rr := range req.Answers {
+		name := rr.Header.Name.String()
+		for _, record := range srv.records {
+			if record.Name == name {
+				// YXDOMAIN
+				return dnsmessage.RCode(6)
+			}
+		}
+	}
+	for _, rr := range req.Authorities {
+		record, ok := newRecordFromResource(rr)
+		if !ok {
+			return dnsmessage.RCodeFormatError
+		}
+		if rr.Header.Class == classNONE {
+			delete(srv.records, recordKey(record))
+		} else {
+			srv.records[recordKey(record)] = record
+		}
+	}
+	return dnsmessage.RCodeSuccess
+}
+
+func TestUpdaterReconcile(t *testing.T) {
+	m := newTestServer(t, cluster.ConfigDNS{}).Manager
+	m.clusterConfig.ID = "c1"
+	m.state[stateKey{path: "y/svc/x", node: "n1"}] = Zone{
+		{Name: "x.y.svc.test.", Type: "A", TTL: 60, Content: "10.0.1.3"},
+	}
+	srv, addr := newFakeUpdateServer(t, "test.example.com.",
+		// foreign records, unmarked or owned by another cluster
+		Record{Name: "x.y.svc.test.example.com.", Type: "A", TTL: 60, Content: "10.9.9.9"},
+		Record{Name: "www.prod.svc.test.example.com.", Type: "A", TTL: 60, Content: "10.9.9.8"},
+		Record{Name: "db.ns2.svc.test.example.com.", Type: "A", TTL: 60, Content: "10.9.9.7"},
+		Record{Name: "db.ns2.svc.test.example.com.", Type: "TXT", TTL: 60, Content: ownerPrefix + "c2"},
+		// stale records pushed by this cluster
+		Record{Name: "old.ns1.svc.test.example.com.", Type: "A", TTL: 60, Content: "10.0.1.9"},
+		Record{Name: "old.ns1.svc.test.example.com.", Type: "TXT", TTL: 60, Content: ownerPrefix + "c1"},
+	)
+	u := &updater{
+		Manager:     m,
+		ctx:         m.ctx,
+		zone:        "test.example.com.",
+		server:      addr,
+		dirtyC:      make(chan bool, 1),
+		clusterZone: "test.",
+		owner:       ownerPrefix + "c1",
+	}
+	u.leader.Store(true)
+
+	u.reconcile()
+	expected := []string{
+		"_80._tcp.up.ns1.svc.test.example.com. SRV 0 10 8080 up.ns1.svc.n1.node.test.example.com.",
+		"_80._tcp.up.ns1.svc.test.example.com. TXT opensvc-owner=c1",
+		"db.ns2.svc.test.example.com. A 10.9.9.7",
+		"db.ns2.svc.test.example.com. TXT opensvc-owner=c2",
+		"down.ns1.svc.test.example.com. A 10.0.1.2",
+		"down.ns1.svc.test.example.com. TXT opensvc-owner=c1",
+		"up.ns1.svc.test.example.com. A 10.0.1.1",
+		"up.ns1.svc.test.example.com. TXT opensvc-owner=c1",
+		"www.prod.svc.test.example.com. A 10.9.9.8",
+		"x.y.svc.test.example.com. A 10.9.9.9",
+	}
+	require.Equal(t, expected, srv.keys(), "foreign records must be left alone")
+	require.Equal(t, 1, srv.updates)
+
+	t.Run("no changes", func(t *testing.T) {
+		u.resync.Store(true)
+		u.reconcile()
+		require.Equal(t, expected, srv.keys())
+		require.Equal(t, 1, srv.updates)
+	})
+
+	t.Run("name claimed by another writer", func(t *testing.T) {
+		srv.add(Record{Name: "new.ns1.svc.test.example.com.", Type: "A", TTL: 60, Content: "10.9.9.6"})
+		m.state[stateKey{path: "ns1/svc/new", node: "n1"}] = Zone{
+			{Name: "new.ns1.svc.test.", Type: "A", TTL: 60, Content: "10.0.1.4"},
+		}
+		u.reconcile()
+		require.Nil(t, u.pushed, "the failed claim must force a zone transfer")
+		u.reconcile()
+		require.Equal(t, append(expected[:6:6], append([]string{"new.ns1.svc.test.example.com. A 10.9.9.6"}, expected[6:]...)...), srv.keys())
+	})
+}
@@ -0,0 +1,590 @@
+package dns
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+)
+
+type (
+	// server is the embedded authoritative dns server serving the cluster
+	// zone over udp and tcp. It uses a copy of the cluster dns configuration
+	// taken at start, and is restarted by the manager on configuration
+	// changes.
+	server struct {
+		*Manager
+		ctx       context.Context
+		cancel    context.CancelFunc
+		config    cluster.ConfigDNS
+		zoneName  string
+		axfrAllow []*net.IPNet
+	}
+)
+
+const (
+	// udpMinSize is the maximum udp message size a client not announcing
+	// an EDNS0 payload size can receive.
+	udpMinSize = 512
+
+	// udpMaxSize is the maximum udp message size we accept to send, whatever
+	// the EDNS0 payload size announced by the client.
+	udpMaxSize = 4096
+
+	// axfrChunkSize is the maximum number of records per AXFR response message.
+	axfrChunkSize = 100
+
+	tcpIdleTimeout = 10 * time.Second
+)
+
+var (
+	serverQueryCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "opensvc",
+			Subsystem: "dns",
+			Name:      "server_queries_total",
+		}, []string{"network", "qtype", "rcode"})
+)
+
+// startServer starts the embedded dns server listeners if the cluster dns
+// configuration has an address set. A listen error is not fatal to the
+// manager, which retries on the next cluster config change.
+func (t *Manager) startServer() error {
+	config := *t.clusterConfig.DNSServer.DeepCopy()
+	if config.Addr == "" {
+		return nil
+	}
+	srv := &server{
+		Manager:  t,
+		config:   config,
+		zoneName: t.clusterConfig.Name + ".",
+	}
+	for _, s := range config.AXFRAllow {
+		_, ipnet, err := net.ParseCIDR(s)
+		if err != nil {
+			t.log.Warnf("server: ignore invalid axfr_allow network %s: %s", s, err)
+			continue
+		}
+		srv.axfrAllow = append(srv.axfrAllow, ipnet)
+	}
+	addr := net.JoinHostPort(config.Addr, strconv.Itoa(config.Port))
+	udpConn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return fmt.Errorf("server: listen udp %s: %w", addr, err)
+	}
+	tcpListener, err := net.Listen("tcp", addr)
+	if err != nil {
+		_ = udpConn.Close()
+		return fmt.Errorf("server: listen tcp %s: %w", addr, err)
+	}
+	ctx, cancel := context.WithCancel(t.ctx)
+	srv.ctx = ctx
+	srv.cancel = cancel
+	t.server = srv
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		<-ctx.Done()
+		_ = udpConn.Close()
+		_ = tcpListener.Close()
+	}()
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		srv.serveUDP(udpConn)
+	}()
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		srv.serveTCP(tcpListener)
+	}()
+
+	t.log.Infof("server: listening on %s udp and tcp", addr)
+	return nil
+}
+
+// stopServer stops the embedded dns server listeners, if started.
+func (t *Manager) stopServer() {
+	if t.server == nil {
+		return
+	}
+	t.log.Infof("server: stop listening")
+	t.server.cancel()
+	t.server = nil
+}
+
+// restartServerIfChanged restarts the embedded dns server when its
+// configuration has changed.
+func (t *Manager) restartServerIfChanged() {
+	var running cluster.ConfigDNS
+	var zoneName string
+	if t.server != nil {
+		running = t.server.config
+		zoneName = t.server.zoneName
+	}
+	config := t.clusterConfig.DNSServer
+	switch {
+	case t.server == nil && config.Addr == "":
+		return
+	case running.Addr == config.Addr &&
+		running.Port == config.Port &&
+		running.HealthAware == config.HealthAware &&
+		strings.Join(running.AXFRAllow, " ") == strings.Join(config.AXFRAllow, " ") &&
+		zoneName == t.clusterConfig.Name+".":
+		return
+	}
+	t.stopServer()
+	if err := t.startServer(); err != nil {
+		t.log.Errorf("%s", err)
+	}
+}
+
+func (t *server) serveUDP(conn net.PacketConn) {
+	buf := make([]byte, udpMaxSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				t.log.Tracef("server: stop listening udp (%s)", err)
+				return
+			}
+			t.log.Warnf("server: udp read error: %s", err)
+			continue
+		}
+		b := t.handle("udp", addr, append([]byte{}, buf[:n]...))
+		if len(b) == 0 {
+			continue
+		}
+		if _, err := conn.WriteTo(b[0], addr); err != nil {
+			t.log.Debugf("server: udp write to %s: %s", addr, err)
+		}
+	}
+}
+
+func (t *server) serveTCP(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				t.log.Tracef("server: stop listening tcp (%s)", err)
+				return
+			}
+			t.log.Warnf("server: tcp accept error: %s", err)
+			continue
+		}
+		t.wg.Add(1)
+		go func(conn net.Conn) {
+			defer t.wg.Done()
+			t.serveTCPConn(conn)
+		}(conn)
+	}
+}
+
+func (t *server) serveTCPConn(conn net.Conn) {
+	done := make(chan bool)
+	defer func() {
+		close(done)
+		_ = conn.Close()
+	}()
+	go func() {
+		select {
+		case <-t.ctx.Done():
+			_ = conn.Close()
+		case <-done:
+		}
+	}()
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(tcpIdleTimeout)); err != nil {
+			return
+		}
+		var length uint16
+		if err := binary.Read(conn, binary.BigEndian, &length); err != nil {
+			return
+		}
+		msg := make([]byte, length)
+		if _, err := io.ReadFull(conn, msg); err != nil {
+			return
+		}
+		for _, b := range t.handle("tcp", conn.RemoteAddr(), msg) {
+			if err := conn.SetWriteDeadline(time.Now().Add(tcpIdleTimeout)); err != nil {
+				return
+			}
+			prefix := binary.BigEndian.AppendUint16(nil, uint16(len(b)))
+			if _, err := conn.Write(append(prefix, b...)); err != nil {
+				t.log.Debugf("server: tcp write to %s: %s", conn.RemoteAddr(), err)
+				return
+			}
+		}
+	}
+}
+
+// handle parses a dns request and returns the packed response messages.
+// Only AXFR requests can have more than one response message.
+func (t *server) handle(network string, addr net.Addr, b []byte) [][]byte {
+	var req dnsmessage.Message
+	if err := req.Unpack(b); err != nil {
+		t.log.Debugf("server: %s %s: unpack request: %s", network, addr, err)
+		return nil
+	}
+	if req.Header.Response {
+		return nil
+	}
+	resp := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:                 req.Header.ID,
+			Response:           true,
+			OpCode:             req.Header.OpCode,
+			Authoritative:      true,
+			RecursionDesired:   req.Header.RecursionDesired,
+			RecursionAvailable: false,
+		},
+		Questions: req.Questions,
+	}
+	log := func(rcode dnsmessage.RCode, qtype string, qname string, count int) {
+		serverQueryCount.WithLabelValues(network, qtype, rcode.String()).Inc()
+		t.log.Debugf("server: %s %s: %s %s: %s, %d records", network, addr, qtype, qname, rcode, count)
+	}
+	if req.Header.OpCode != 0 || len(req.Questions) != 1 {
+		resp.Header.RCode = dnsmessage.RCodeNotImplemented
+		log(resp.Header.RCode, "", "", 0)
+		return t.pack(network, &req, &resp)
+	}
+	q := req.Questions[0]
+	qname := strings.ToLower(q.Name.String())
+	qtype := strings.TrimPrefix(q.Type.String(), "Type")
+	zoneName := t.zoneName
+
+	if q.Class != dnsmessage.ClassINET && q.Class != dnsmessage.ClassANY {
+		resp.Header.RCode = dnsmessage.RCodeRefused
+		log(resp.Header.RCode, qtype, qname, 0)
+		return t.pack(network, &req, &resp)
+	}
+	if !isInZone(qname, zoneName) && !(isReverseName(qname) && t.hasName(qname)) {
+		// the reverse names are only served for the cluster addresses
+		resp.Header.RCode = dnsmessage.RCodeRefused
+		log(resp.Header.RCode, qtype, qname, 0)
+		return t.pack(network, &req, &resp)
+	}
+
+	if q.Type == dnsmessage.TypeAXFR {
+		return t.handleAXFR(network, addr, &req, &resp, log)
+	}
+
+	if q.Type == dnsmessage.TypeALL {
+		qtype = "ANY"
+	}
+	zone := t.getServerRecords(qtype, qname)
+	for _, record := range zone {
+		rr, err := record.resource()
+		if err != nil {
+			t.log.Debugf("server: skip record %s %s %s: %s", record.Name, record.Type, record.Content, err)
+			continue
+		}
+		resp.Answers = append(resp.Answers, rr)
+	}
+	if len(resp.Answers) == 0 {
+		if !t.hasName(qname) {
+			resp.Header.RCode = dnsmessage.RCodeNameError
+		}
+		if soa, ok := t.soaResource(); ok && isInZone(qname, zoneName) {
+			resp.Authorities = append(resp.Authorities, soa)
+		}
+	}
+	log(resp.Header.RCode, qtype, qname, len(resp.Answers))
+	return t.pack(network, &req, &resp)
+}
+
+// handleAXFR returns the zone transfer response messages. The transfer is
+// only allowed over tcp, from a client address in the dns.axfr_allow
+// networks.
+func (t *server) handleAXFR(network string, addr net.Addr, req, resp *dnsmessage.Message, log func(dnsmessage.RCode, string, string, int)) [][]byte {
+	qname := strings.ToLower(req.Questions[0].Name.String())
+	if network != "tcp" || !t.isAXFRAllowed(addr) || qname != t.zoneName {
+		resp.Header.RCode = dnsmessage.RCodeRefused
+		log(resp.Header.RCode, "AXFR", qname, 0)
+		return t.pack(network, req, resp)
+	}
+	soa, ok := t.soaResource()
+	if !ok {
+		resp.Header.RCode = dnsmessage.RCodeServerFailure
+		log(resp.Header.RCode, "AXFR", qname, 0)
+		return t.pack(network, req, resp)
+	}
+	records := []dnsmessage.Resource{soa}
+	for _, record := range t.getServerZone() {
+		if record.Type == "SOA" {
+			continue
+		}
+		rr, err := record.resource()
+		if err != nil {
+			t.log.Debugf("server: axfr skip record %s %s %s: %s", record.Name, record.Type, record.Content, err)
+			continue
+		}
+		records = append(records, rr)
+	}
+	records = append(records, soa)
+
+	l := make([][]byte, 0)
+	for i := 0; i < len(records); i += axfrChunkSize {
+		j := i + axfrChunkSize
+		if j > len(records) {
+			j = len(records)
+		}
+		m := dnsmessage.Message{
+			Header:    resp.Header,
+			Questions: resp.Questions,
+			Answers:   records[i:j],
+		}
+		if i > 0 {
+			m.Questions = nil
+		}
+		b, err := m.Pack()
+		if err != nil {
+			t.log.Warnf("server: axfr pack: %s", err)
+			return nil
+		}
+		l = append(l, b)
+	}
+	log(resp.Header.RCode, "AXFR", qname, len(records))
+	return l
+}
+
+func (t *server) isAXFRAllowed(addr net.Addr) bool {
+	var ip net.IP
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		ip = a.IP
+	case *net.UDPAddr:
+		ip = a.IP
+	default:
+		return false
+	}
+	for _, ipnet := range t.axfrAllow {
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// pack returns the packed response. Over udp, the answers are dropped and
+// the truncated flag is set if the message exceeds the client payload size,
+// so the client retries over tcp.
+func (t *server) pack(network string, req, resp *dnsmessage.Message) [][]byte {
+	maxSize := udpMinSize
+	for _, rr := range req.Additionals {
+		if rr.Header.Type != dnsmessage.TypeOPT {
+			continue
+		}
+		if size := int(rr.Header.Class); size > maxSize {
+			maxSize = size
+		}
+		var opt dnsmessage.ResourceHeader
+		if err := opt.SetEDNS0(udpMaxSize, dnsmessage.RCodeSuccess, false); err == nil {
+			resp.Additionals = append(resp.Additionals, dnsmessage.Resource{Header: opt, Body: &dnsmessage.OPTResource{}})
+		}
+		break
+	}
+	if maxSize > udpMaxSize {
+		maxSize = udpMaxSize
+	}
+	b, err := resp.Pack()
+	if err != nil {
+		t.log.Warnf("server: pack response: %s", err)
+		return nil
+	}
+	if network == "udp" && len(b) > maxSize {
+		resp.Header.Truncated = true
+		resp.Answers = nil
+		resp.Authorities = nil
+		if b, err = resp.Pack(); err != nil {
+			t.log.Warnf("server: pack truncated response: %s", err)
+			return nil
+		}
+	}
+	return [][]byte{b}
+}
+
+// soaResource returns the zone SOA record. The zone only has a SOA record
+// if cluster.dns is set, so a default SOA is returned if not.
+func (t *server) soaResource() (dnsmessage.Resource, bool) {
+	for _, record := range t.getServerRecords("SOA", t.zoneName) {
+		if rr, err := record.resource(); err == nil {
+			return rr, true
+		}
+	}
+	record := Record{
+		Name:    t.zoneName,
+		Type:    "SOA",
+		TTL:     60,
+		Content: fmt.Sprintf("dns.%s %s %d %d %d %d %d", t.zoneName, contact, serial, refresh, retry, expire, minimum),
+	}
+	if rr, err := record.resource(); err == nil {
+		return rr, true
+	}
+	return dnsmessage.Resource{}, false
+}
+
+// hasName returns true if the zone has at least one record named name,
+// whatever its type.
+func (t *server) hasName(name string) bool {
+	return len(t.getServerRecords("ANY", name)) > 0
+}
+
+func (t *server) getServerRecords(recordType, recordName string) Zone {
+	err := make(chan error, 1)
+	c := cmdGet{
+		errC:    err,
+		Name:    recordName,
+		Type:    recordType,
+		Healthy: t.config.HealthAware,
+		resp:    make(chan Zone),
+	}
+	select {
+	case t.cmdC <- c:
+	case <-t.ctx.Done():
+		return Zone{}
+	}
+	if <-err != nil {
+		return Zone{}
+	}
+	return <-c.resp
+}
+
+func (t *server) getServerZone() Zone {
+	err := make(chan error, 1)
+	c := cmdGetZone{
+		errC:    err,
+		Healthy: t.config.HealthAware,
+		resp:    make(chan Zone),
+	}
+	select {
+	case t.cmdC <- c:
+	case <-t.ctx.Done():
+		return Zone{}
+	}
+	if <-err != nil {
+		return Zone{}
+	}
+	return <-c.resp
+}
+
+// resource converts the record to a dns message resource.
+func (t Record) resource() (dnsmessage.Resource, error) {
+	var rr dnsmessage.Resource
+	name, err := dnsmessage.NewName(t.Name)
+	if err != nil {
+		return rr, err
+	}
+	rr.Header = dnsmessage.ResourceHeader{
+		Name:  name,
+		Class: dnsmessage.ClassINET,
+		TTL:   uint32(t.TTL),
+	}
+	fields := strings.Fields(t.Content)
+	switch t.Type {
+	case "A":
+		ip := net.ParseIP(t.Content).To4()
+		if ip == nil {
+			return rr, fmt.Errorf("invalid ipv4 address")
+		}
+		body := &dnsmessage.AResource{}
+		copy(body.A[:], ip)
+		rr.Header.Type = dnsmessage.TypeA
+		rr.Body = body
+	case "AAAA":
+		ip := net.ParseIP(t.Content).To16()
+		if ip == nil {
+			return rr, fmt.Errorf("invalid ipv6 address")
+		}
+		body := &dnsmessage.AAAAResource{}
+		copy(body.AAAA[:], ip)
+		rr.Header.Type = dnsmessage.TypeAAAA
+		rr.Body = body
+	case "PTR":
+		ptr, err := dnsmessage.NewName(t.Content)
+		if err != nil {
+			return rr, err
+		}
+		rr.Header.Type = dnsmessage.TypePTR
+		rr.Body = &dnsmessage.PTRResource{PTR: ptr}
+	case "NS":
+		ns, err := dnsmessage.NewName(t.Content)
+		if err != nil {
+			return rr, err
+		}
+		rr.Header.Type = dnsmessage.TypeNS
+		rr.Body = &dnsmessage.NSResource{NS: ns}
+	case "TXT":
+		rr.Header.Type = dnsmessage.TypeTXT
+		rr.Body = &dnsmessage.TXTResource{TXT: []string{t.Content}}
+	case "SRV":
+		if len(fields) != 4 {
+			return rr, fmt.Errorf("invalid srv content")
+		}
+		var v [3]uint16
+		for i := 0; i < 3; i++ {
+			n, err := strconv.ParseUint(fields[i], 10, 16)
+			if err != nil {
+				return rr, err
+			}
+			v[i] = uint16(n)
+		}
+		target, err := dnsmessage.NewName(fields[3])
+		if err != nil {
+			return rr, err
+		}
+		rr.Header.Type = dnsmessage.TypeSRV
+		rr.Body = &dnsmessage.SRVResource{Priority: v[0], Weight: v[1], Port: v[2], Target: target}
+	case "SOA":
+		if len(fields) != 7 {
+			return rr, fmt.Errorf("invalid soa content")
+		}
+		ns, err := dnsmessage.NewName(fields[0])
+		if err != nil {
+			return rr, err
+		}
+		mbox, err := dnsmessage.NewName(strings.Replace(fields[1], "@", ".", 1) + ".")
+		if err != nil {
+			return rr, err
+		}
+		var v [5]uint32
+		for i := 0; i < 5; i++ {
+			n, err := strconv.ParseUint(fields[i+2], 10, 32)
+			if err != nil {
+				return rr, err
+			}
+			v[i] = uint32(n)
+		}
+		rr.Header.Type = dnsmessage.TypeSOA
+		rr.Body = &dnsmessage.SOAResource{NS: ns, MBox: mbox, Serial: v[0], Refresh: v[1], Retry: v[2], Expire: v[3], MinTTL: v[4]}
+	default:
+		return rr, fmt.Errorf("unsupported record type %s", t.Type)
+	}
+	return rr, nil
+}
+
+// isInZone returns true if name is zoneName or a sub domain of zoneName.
+func isInZone(name, zoneName string) bool {
+	return name == zoneName || strings.HasSuffix(name, "."+zoneName)
+}
+
+// isReverseName returns true if name is in the ipv4 or ipv6 reverse
+// lookup domains.
+func isReverseName(name string) bool {
+	return strings.HasSuffix(name, ".in-addr.arpa.") || strings.HasSuffix(name, ".ip6.arpa.")
+}
@@ -0,0 +1,161 @@
+package dns
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+func newTestServer(t *testing.T, config cluster.ConfigDNS) *server {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	m := NewManager(0, nil)
+	m.ctx = ctx
+	m.log = plog.NewDefaultLogger()
+	m.clusterConfig = cluster.Config{Name: "test", DNS: []string{"10.0.0.1"}}
+	up := stateKey{path: "ns1/svc/up", node: "n1"}
+	down := stateKey{path: "ns1/svc/down", node: "n1"}
+	m.state[up] = Zone{
+		{Name: "up.ns1.svc.test.", Type: "A", TTL: 60, Content: "10.0.1.1"},
+		{Name: "_80._tcp.up.ns1.svc.test.", Type: "SRV", TTL: 60, Content: "0 10 8080 up.ns1.svc.n1.node.test."},
+	}
+	m.avail[up] = status.Up
+	m.state[down] = Zone{
+		{Name: "down.ns1.svc.test.", Type: "A", TTL: 60, Content: "10.0.1.2"},
+	}
+	m.avail[down] = status.Down
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case i := <-m.cmdC:
+				switch c := i.(type) {
+				case cmdGetZone:
+					m.onCmdGetZone(c)
+				case cmdGet:
+					m.onCmdGet(c)
+				}
+			}
+		}
+	}()
+	return &server{
+		Manager:   m,
+		ctx:       ctx,
+		cancel:    cancel,
+		config:    config,
+		zoneName:  "test.",
+		axfrAllow: []*net.IPNet{{IP: net.IPv4(127, 0, 0, 0), Mask: net.CIDRMask(8, 32)}},
+	}
+}
+
+func query(t *testing.T, srv *server, network, name string, qtype dnsmessage.Type, addr net.Addr) []dnsmessage.Message {
+	req := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: 1},
+		Questions: []dnsmessage.Question{
+			{Name: dnsmessage.MustNewName(name), Type: qtype, Class: dnsmessage.ClassINET},
+		},
+	}
+	b, err := req.Pack()
+	require.NoError(t, err)
+	l := make([]dnsmessage.Message, 0)
+	for _, b := range srv.handle(network, addr, b) {
+		var resp dnsmessage.Message
+		require.NoError(t, resp.Unpack(b))
+		l = append(l, resp)
+	}
+	return l
+}
+
+func TestServerHandle(t *testing.T) {
+	addr := &net.UDPAddr{IP: net.IPv4(192, 168, 0, 1)}
+
+	t.Run("answer A", func(t *testing.T) {
+		srv := newTestServer(t, cluster.ConfigDNS{})
+		l := query(t, srv, "udp", "up.ns1.svc.test.", dnsmessage.TypeA, addr)
+		require.Len(t, l, 1)
+		require.True(t, l[0].Header.Authoritative)
+		require.Len(t, l[0].Answers, 1)
+		require.Equal(t, [4]byte{10, 0, 1, 1}, l[0].Answers[0].Body.(*dnsmessage.AResource).A)
+	})
+
+	t.Run("answer SRV", func(t *testing.T) {
+		srv := newTestServer(t, cluster.ConfigDNS{})
+		l := query(t, srv, "udp", "_80._tcp.up.ns1.svc.test.", dnsmessage.TypeSRV, addr)
+		require.Len(t, l, 1)
+		require.Len(t, l[0].Answers, 1)
+		srv0 := l[0].Answers[0].Body.(*dnsmessage.SRVResource)
+		require.Equal(t, uint16(10), srv0.Weight)
+		require.Equal(t, uint16(8080), srv0.Port)
+	})
+
+	t.Run("nxdomain has soa authority", func(t *testing.T) {
+		srv := newTestServer(t, cluster.ConfigDNS{})
+		l := query(t, srv, "udp", "foo.ns1.svc.test.", dnsmessage.TypeA, addr)
+		require.Len(t, l, 1)
+		require.Equal(t, dnsmessage.RCodeNameError, l[0].Header.RCode)
+		require.Len(t, l[0].Authorities, 1)
+		require.Equal(t, dnsmessage.TypeSOA, l[0].Authorities[0].Header.Type)
+	})
+
+	t.Run("refuse out of zone", func(t *testing.T) {
+		srv := newTestServer(t, cluster.ConfigDNS{})
+		l := query(t, srv, "udp", "www.opensvc.com.", dnsmessage.TypeA, addr)
+		require.Len(t, l, 1)
+		require.Equal(t, dnsmessage.RCodeRefused, l[0].Header.RCode)
+	})
+
+	t.Run("answer PTR of a cluster address", func(t *testing.T) {
+		srv := newTestServer(t, cluster.ConfigDNS{})
+		srv.state[stateKey{path: "ns1/svc/up", node: "n1"}] = append(srv.state[stateKey{path: "ns1/svc/up", node: "n1"}],
+			Record{Name: "1.1.0.10.in-addr.arpa.", Type: "PTR", TTL: 60, Content: "up.ns1.svc.test."})
+		l := query(t, srv, "udp", "1.1.0.10.in-addr.arpa.", dnsmessage.TypePTR, addr)
+		require.Len(t, l, 1)
+		require.Equal(t, dnsmessage.RCodeSuccess, l[0].Header.RCode)
+		require.Len(t, l[0].Answers, 1)
+		require.Equal(t, "up.ns1.svc.test.", l[0].Answers[0].Body.(*dnsmessage.PTRResource).PTR.String())
+	})
+
+	t.Run("refuse PTR of a non cluster address", func(t *testing.T) {
+		srv := newTestServer(t, cluster.ConfigDNS{})
+		l := query(t, srv, "udp", "1.0.168.192.in-addr.arpa.", dnsmessage.TypePTR, addr)
+		require.Len(t, l, 1)
+		require.Equal(t, dnsmessage.RCodeRefused, l[0].Header.RCode)
+		require.Len(t, l[0].Answers, 0)
+	})
+
+	t.Run("health aware hides down instances", func(t *testing.T) {
+		srv := newTestServer(t, cluster.ConfigDNS{})
+		l := query(t, srv, "udp", "down.ns1.svc.test.", dnsmessage.TypeA, addr)
+		require.Len(t, l[0].Answers, 1)
+
+		srv = newTestServer(t, cluster.ConfigDNS{HealthAware: true})
+		l = query(t, srv, "udp", "down.ns1.svc.test.", dnsmessage.TypeA, addr)
+		require.Len(t, l[0].Answers, 0)
+		require.Equal(t, dnsmessage.RCodeNameError, l[0].Header.RCode)
+	})
+
+	t.Run("axfr", func(t *testing.T) {
+		srv := newTestServer(t, cluster.ConfigDNS{})
+		l := query(t, srv, "udp", "test.", dnsmessage.TypeAXFR, &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		require.Equal(t, dnsmessage.RCodeRefused, l[0].Header.RCode, "axfr over udp must be refused")
+
+		l = query(t, srv, "tcp", "test.", dnsmessage.TypeAXFR, &net.TCPAddr{IP: net.IPv4(192, 168, 0, 1)})
+		require.Equal(t, dnsmessage.RCodeRefused, l[0].Header.RCode, "axfr from a not allowed address must be refused")
+
+		l = query(t, srv, "tcp", "test.", dnsmessage.TypeAXFR, &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		require.Len(t, l, 1)
+		answers := l[0].Answers
+		require.Equal(t, dnsmessage.TypeSOA, answers[0].Header.Type)
+		require.Equal(t, dnsmessage.TypeSOA, answers[len(answers)-1].Header.Type)
+		// soa + ns + ns glue + 3 records + soa
+		require.Len(t, answers, 7)
+	})
+}
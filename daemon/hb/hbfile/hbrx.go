@@ -0,0 +1,208 @@
+package hbfile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/hbtype"
+	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/v3/daemon/hb/hbaudit"
+	"github.com/opensvc/om3/v3/daemon/hb/hbcrypto"
+	"github.com/opensvc/om3/v3/daemon/hb/hbctrl"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	// rx holds an hb file receiver
+	rx struct {
+		sync.WaitGroup
+		cfg
+		ctx   context.Context
+		id    string
+		nodes []string
+
+		// generation is the last accepted generation per peer node.
+		generation map[string]uint64
+
+		name   string
+		log    *plog.Logger
+		cmdC   chan<- any
+		msgC   chan<- *hbtype.Msg
+		cancel func()
+
+		crypto decryptWithNoder
+
+		alert []daemonsubsystem.Alert
+	}
+
+	decryptWithNoder interface {
+		DecryptWithNode(data []byte) ([]byte, string, error)
+	}
+)
+
+// ID implements the ID function of the Receiver interface for rx
+func (t *rx) ID() string {
+	return t.id
+}
+
+// Stop implements the Stop function of the Receiver interface for rx
+func (t *rx) Stop() error {
+	t.log.Tracef("cancelling")
+	t.cancel()
+	for _, node := range t.nodes {
+		t.cmdC <- hbctrl.CmdDelWatcher{
+			HbID:     t.id,
+			Nodename: node,
+		}
+	}
+	t.Wait()
+	t.log.Tracef("wait done")
+	return nil
+}
+
+func (t *rx) streamPeerDesc(node string) string {
+	return fmt.Sprintf("← %s", t.nodeFile(node))
+}
+
+// Start implements the Start function of the Receiver interface for rx
+func (t *rx) Start(cmdC chan<- any, msgC chan<- *hbtype.Msg) error {
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.ctx = ctx
+	t.cancel = cancel
+
+	hbaudit.EnableAudit(ctx, t.id, t.log, "hb", strings.Replace(t.id, "hb#", "hb:", 1))
+
+	if err := t.checkDir(); err != nil {
+		err := fmt.Errorf("path %s: %w", t.dir, err)
+		t.log.Warnf("startup failed: %s", err)
+		cancel()
+		return err
+	}
+
+	t.cmdC = cmdC
+	t.msgC = msgC
+
+	for _, node := range t.nodes {
+		cmdC <- hbctrl.CmdAddWatcher{
+			HbID:     t.id,
+			Nodename: node,
+			Ctx:      ctx,
+			Timeout:  t.timeout,
+			Desc:     t.streamPeerDesc(node),
+		}
+	}
+
+	t.Add(1)
+	go func() {
+		defer t.Done()
+		t.log.Infof("started")
+		defer t.log.Infof("stopped")
+
+		crypto := hbcrypto.CryptoFromContext(ctx)
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.crypto = crypto.Load()
+				t.onTick()
+			case <-ctx.Done():
+				t.cancel()
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (t *rx) onTick() {
+	var alert []daemonsubsystem.Alert
+	for _, node := range t.nodes {
+		alert = append(alert, t.recv(node)...)
+	}
+	if !slices.Equal(alert, t.alert) {
+		t.alert = alert
+		t.sendAlert()
+	}
+}
+
+// recv reads the nodename heartbeat file and forwards its message if it has
+// been updated since the last read. It returns the alerts about the file.
+func (t *rx) recv(nodename string) []daemonsubsystem.Alert {
+	filename := t.nodeFile(nodename)
+	c, mtime, err := t.readCapsule(nodename)
+	if errors.Is(err, os.ErrNotExist) {
+		t.log.Tracef("node %s file %s has never been written", nodename, filename)
+		return nil
+	} else if err != nil {
+		t.log.Tracef("node %s read %s: %s", nodename, filename, err)
+		return []daemonsubsystem.Alert{{Severity: "warning", Message: fmt.Sprintf("can't read %s: %s", filename, err)}}
+	}
+	stale, alert := checkTimes(nodename, c.Updated, mtime, time.Now(), t.timeout, t.maxSkew)
+	if c.Generation == t.generation[nodename] {
+		t.log.Tracef("node %s file %s unchanged since last read", nodename, filename)
+		return alert
+	}
+	if stale {
+		t.log.Tracef("node %s file %s has not been updated since %s", nodename, filename, mtime)
+		return alert
+	}
+	b, msgNodename, err := t.crypto.DecryptWithNode(c.Msg)
+	if err != nil {
+		t.log.Tracef("node %s file %s decrypt: %s", nodename, filename, err)
+		return alert
+	}
+	if nodename != msgNodename {
+		t.log.Warnf("node %s file %s contains a message from node %s", nodename, filename, msgNodename)
+		return append(alert, daemonsubsystem.Alert{
+			Severity: "warning",
+			Message:  fmt.Sprintf("node %s file contains a message from node %s", nodename, msgNodename),
+		})
+	}
+	msg := hbtype.Msg{}
+	if err := json.Unmarshal(b, &msg); err != nil {
+		t.log.Warnf("node %s file %s can't unmarshal msg: %s", nodename, filename, err)
+		return alert
+	}
+	t.log.Tracef("node %s file %s generation %d ok", nodename, filename, c.Generation)
+	t.cmdC <- hbctrl.CmdSetPeerSuccess{
+		Nodename: msg.Nodename,
+		HbID:     t.id,
+		Success:  true,
+	}
+	t.msgC <- &msg
+	t.generation[nodename] = c.Generation
+	return alert
+}
+
+func (t *rx) sendAlert() {
+	t.cmdC <- hbctrl.CmdSetAlert{
+		HbID:  t.id,
+		Alert: append([]daemonsubsystem.Alert{}, t.alert...),
+	}
+}
+
+func newRx(ctx context.Context, name string, nodes []string, cfg cfg) *rx {
+	id := name + ".rx"
+	log := plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbfile").
+		Attr("hb_func", "rx").
+		Attr("hb_name", name).
+		Attr("hb_id", id).
+		WithPrefix("daemon: hb: file: rx: " + name + ": ")
+	return &rx{
+		cfg:        cfg,
+		ctx:        ctx,
+		id:         id,
+		nodes:      nodes,
+		generation: make(map[string]uint64),
+		name:       name,
+		log:        log,
+	}
+}
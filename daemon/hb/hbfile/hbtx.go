@@ -0,0 +1,185 @@
+package hbfile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/v3/daemon/hb/hbaudit"
+	"github.com/opensvc/om3/v3/daemon/hb/hbctrl"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	tx struct {
+		sync.WaitGroup
+		cfg
+		ctx   context.Context
+		id    string
+		nodes []string
+
+		// generation is incremented on each heartbeat file write, so the
+		// receivers can detect the updates without relying on the clocks.
+		generation uint64
+
+		name   string
+		log    *plog.Logger
+		cmdC   chan<- interface{}
+		cancel func()
+		alert  []daemonsubsystem.Alert
+	}
+)
+
+// ID implements the ID function of Transmitter interface for tx
+func (t *tx) ID() string {
+	return t.id
+}
+
+// Stop implements the Stop function of Transmitter interface for tx
+func (t *tx) Stop() error {
+	t.log.Tracef("cancelling")
+	t.cancel()
+	for _, node := range t.nodes {
+		t.cmdC <- hbctrl.CmdDelWatcher{
+			HbID:     t.id,
+			Nodename: node,
+		}
+	}
+	t.Wait()
+	t.log.Tracef("wait done")
+	return nil
+}
+
+func (t *tx) Ctx() context.Context {
+	return t.ctx
+}
+
+func (t *tx) streamPeerDesc() string {
+	return fmt.Sprintf("→ %s", t.nodeFile(t.localhost))
+}
+
+// Start implements the Start function of Transmitter interface for tx
+func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.ctx = ctx
+	t.cancel = cancel
+	hbaudit.EnableAudit(ctx, t.id, t.log, "hb", strings.Replace(t.id, "hb#", "hb:", 1))
+	if err := t.checkDir(); err != nil {
+		err := fmt.Errorf("path %s: %w", t.dir, err)
+		t.log.Warnf("startup failed: %s", err)
+		cancel()
+		return err
+	}
+
+	// continue the generation sequence of the file written before restart,
+	// so the peers don't ignore the next writes.
+	if c, _, err := t.readCapsule(t.localhost); err == nil {
+		t.generation = c.Generation
+	} else if !errors.Is(err, os.ErrNotExist) {
+		t.log.Infof("initial read of %s: %s", t.nodeFile(t.localhost), err)
+	}
+
+	reasonTick := fmt.Sprintf("send msg (interval %s)", t.interval)
+	t.cmdC = cmdC
+	t.Add(1)
+	go func() {
+		defer t.Done()
+		t.log.Infof("started")
+		defer t.log.Infof("stopped")
+		for _, node := range t.nodes {
+			cmdC <- hbctrl.CmdAddWatcher{
+				HbID:     t.id,
+				Nodename: node,
+				Ctx:      ctx,
+				Timeout:  t.timeout,
+				Desc:     t.streamPeerDesc(),
+			}
+		}
+		var b []byte
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		var reason string
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case b = <-msgC:
+				reason = "send msg"
+
+				// No need to send the next message before a full ticker period.
+				ticker.Reset(t.interval)
+			case <-ticker.C:
+				reason = reasonTick
+			}
+			if len(b) == 0 {
+				continue
+			}
+			t.log.Tracef(reason)
+			t.send(b)
+		}
+	}()
+	return nil
+}
+
+func (t *tx) send(b []byte) {
+	t.generation++
+	c := capsule{
+		Nodename:   t.localhost,
+		Generation: t.generation,
+		Updated:    time.Now(),
+		Msg:        b,
+	}
+	var alert []daemonsubsystem.Alert
+	defer func() {
+		if !slices.Equal(alert, t.alert) {
+			t.alert = alert
+			t.sendAlert()
+		}
+	}()
+	if err := t.writeCapsule(c); err != nil {
+		t.log.Errorf("write %s: %s", t.nodeFile(t.localhost), err)
+		alert = append(alert, daemonsubsystem.Alert{
+			Severity: "warning",
+			Message:  fmt.Sprintf("can't write %s: %s", t.nodeFile(t.localhost), err),
+		})
+		return
+	}
+	t.log.Tracef("written %s generation %d len %d", t.nodeFile(t.localhost), c.Generation, len(b))
+	for _, node := range t.nodes {
+		t.cmdC <- hbctrl.CmdSetPeerSuccess{
+			Nodename: node,
+			HbID:     t.id,
+			Success:  true,
+		}
+	}
+}
+
+func (t *tx) sendAlert() {
+	t.cmdC <- hbctrl.CmdSetAlert{
+		HbID:  t.id,
+		Alert: append([]daemonsubsystem.Alert{}, t.alert...),
+	}
+}
+
+func newTx(ctx context.Context, name string, nodes []string, cfg cfg) *tx {
+	id := name + ".tx"
+	log := plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbfile").
+		Attr("hb_func", "tx").
+		Attr("hb_name", name).
+		Attr("hb_id", id).
+		WithPrefix("daemon: hb: file: tx: " + name + ": ")
+	return &tx{
+		cfg:   cfg,
+		ctx:   ctx,
+		id:    id,
+		nodes: nodes,
+		name:  name,
+		log:   log,
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Package hbfile implements a hb driver exchanging the node data through
+per-node files in a directory of a shared filesystem.
+
+Each node atomically replaces its file with a capsule containing its
+encrypted message and a generation counter incremented on each write. The
+receivers detect the peer updates from the generation changes, and the
+stale files and clock skews from the file modification times.
+*/
+package hbfile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/hbcfg"
+	"github.com/opensvc/om3/v3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/v3/daemon/hb/hbaudit"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/key"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	T struct {
+		hbcfg.T
+	}
+
+	cfg struct {
+		dir      string
+		timeout  time.Duration
+		interval time.Duration
+
+		// maxSkew is the maximum clock difference tolerated between the
+		// nodes and the shared filesystem.
+		maxSkew time.Duration
+
+		localhost string
+	}
+
+	// capsule is the content of a node heartbeat file.
+	capsule struct {
+		Nodename   string    `json:"nodename"`
+		Generation uint64    `json:"generation"`
+		Updated    time.Time `json:"updated"`
+		Msg        []byte    `json:"msg"`
+	}
+)
+
+const (
+	fileSuffix = ".hb"
+)
+
+func New() hbcfg.Confer {
+	t := &T{}
+	var i interface{} = t
+	return i.(hbcfg.Confer)
+}
+
+func init() {
+	hbcfg.Register("file", New)
+}
+
+// Configure implements the Configure function of Confer interface for T
+func (t *T) Configure(ctx context.Context) {
+	log := plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbfile").Attr("hb_name", t.Name()).WithPrefix("daemon: hb: file: " + t.Name() + ": configure: ")
+	hbaudit.AttachActiveAuditIfAny(ctx, log, "hb", "hb.main", strings.Replace(t.Name(), "hb#", "hb:", 1))
+	timeout := t.GetDuration("timeout", 9*time.Second)
+	interval := t.GetDuration("interval", 4*time.Second)
+	if timeout < 2*interval+1*time.Second {
+		oldTimeout := timeout
+		timeout = interval*2 + 1*time.Second
+		log.Warnf("reajust timeout: %s => %s (<interval>*2+1s)", oldTimeout, timeout)
+	}
+	dir := t.GetString("path")
+	if dir == "" {
+		log.Errorf("%s.path is not set in node.conf", t.Name())
+		return
+	}
+	maxSkew := t.GetDuration("max_skew", 5*time.Second)
+	nodes := t.GetStrings("nodes")
+	if len(nodes) == 0 {
+		k := key.T{Section: "cluster", Option: "nodes"}
+		nodes = t.Config().GetStrings(k)
+	}
+	oNodes := hostname.OtherNodes(nodes)
+	log.Tracef("timeout=%s interval=%s path=%s max_skew=%s nodes=%s onodes=%s", timeout, interval, dir, maxSkew, nodes, oNodes)
+	t.SetNodes(oNodes)
+	t.SetTimeout(timeout)
+	cfg := cfg{
+		dir:       dir,
+		timeout:   timeout,
+		interval:  interval,
+		maxSkew:   maxSkew,
+		localhost: hostname.Hostname(),
+	}
+	signature := fmt.Sprintf("type: hb.file, path: %s nodes: %s timeout: %s interval: %s max_skew: %s",
+		dir, nodes, timeout, interval, maxSkew)
+	t.SetSignature(signature)
+	log.Debugf("signature: [%s]", signature)
+	name := t.Name()
+	tx := newTx(ctx, name, oNodes, cfg)
+	t.SetTx(tx)
+	rx := newRx(ctx, name, oNodes, cfg)
+	t.SetRx(rx)
+}
+
+// checkDir returns an error if the heartbeat directory is not an existing
+// directory.
+func (t *cfg) checkDir() error {
+	if info, err := os.Stat(t.dir); err != nil {
+		return err
+	} else if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", t.dir)
+	}
+	return nil
+}
+
+// nodeFile returns the path of the nodename heartbeat file.
+func (t *cfg) nodeFile(nodename string) string {
+	return filepath.Join(t.dir, nodename+fileSuffix)
+}
+
+// writeCapsule atomically replaces the c.Nodename heartbeat file, writing
+// to a temporary file of the same directory renamed over the heartbeat file.
+func (t *cfg) writeCapsule(c capsule) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(t.dir, "."+c.Nodename+fileSuffix+".*")
+	if err != nil {
+		return err
+	}
+	tmpName := f.Name()
+	defer func() { _ = os.Remove(tmpName) }()
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpName, t.nodeFile(c.Nodename))
+}
+
+// readCapsule returns the nodename heartbeat file content and modification
+// time.
+func (t *cfg) readCapsule(nodename string) (capsule, time.Time, error) {
+	var c capsule
+	filename := t.nodeFile(nodename)
+	f, err := os.Open(filename)
+	if err != nil {
+		return c, time.Time{}, err
+	}
+	defer func() { _ = f.Close() }()
+	info, err := f.Stat()
+	if err != nil {
+		return c, time.Time{}, err
+	}
+	if err := json.NewDecoder(f).Decode(&c); err != nil {
+		return c, info.ModTime(), fmt.Errorf("decode %s: %w", filename, err)
+	}
+	return c, info.ModTime(), nil
+}
+
+// checkTimes compares the time the nodename heartbeat was written at,
+// according to the writer clock, with the file modification time, according
+// to the shared filesystem clock, and with the local clock now.
+//
+// It returns true if the file was not modified during timeout, and the
+// alerts describing the stale file and the clock skews.
+func checkTimes(nodename string, updated, mtime, now time.Time, timeout, maxSkew time.Duration) (bool, []daemonsubsystem.Alert) {
+	var (
+		stale  bool
+		alerts []daemonsubsystem.Alert
+	)
+	if skew := mtime.Sub(now); skew > maxSkew {
+		alerts = append(alerts, daemonsubsystem.Alert{
+			Severity: "warning",
+			Message:  fmt.Sprintf("local clock is %s behind the shared filesystem clock", skew.Round(time.Second)),
+		})
+	} else if elapsed := now.Sub(mtime); elapsed > timeout {
+		stale = true
+		alerts = append(alerts, daemonsubsystem.Alert{
+			Severity: "warning",
+			Message:  fmt.Sprintf("node %s file is stale: not modified for %s", nodename, elapsed.Round(time.Second)),
+		})
+	}
+	if skew := updated.Sub(mtime).Abs(); skew > maxSkew {
+		alerts = append(alerts, daemonsubsystem.Alert{
+			Severity: "warning",
+			Message:  fmt.Sprintf("node %s clock skew with the shared filesystem clock: %s", nodename, skew.Round(time.Second)),
+		})
+	}
+	return stale, alerts
+}
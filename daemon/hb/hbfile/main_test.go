@@ -0,0 +1,55 @@
+package hbfile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapsule(t *testing.T) {
+	c := cfg{dir: t.TempDir()}
+	_, _, err := c.readCapsule("node1")
+	require.Error(t, err)
+
+	for generation := uint64(1); generation <= 2; generation++ {
+		written := capsule{
+			Nodename:   "node1",
+			Generation: generation,
+			Updated:    time.Now().Round(0),
+			Msg:        []byte("msg"),
+		}
+		require.NoError(t, c.writeCapsule(written))
+		read, mtime, err := c.readCapsule("node1")
+		require.NoError(t, err)
+		require.False(t, mtime.IsZero())
+		require.Equal(t, written.Generation, read.Generation)
+		require.Equal(t, written.Msg, read.Msg)
+		require.True(t, written.Updated.Equal(read.Updated))
+	}
+}
+
+func TestCheckTimes(t *testing.T) {
+	now := time.Now()
+	timeout := 9 * time.Second
+	maxSkew := 5 * time.Second
+	cases := map[string]struct {
+		updated time.Time
+		mtime   time.Time
+		stale   bool
+		alerts  int
+	}{
+		"fresh":          {updated: now, mtime: now},
+		"stale":          {updated: now.Add(-time.Minute), mtime: now.Add(-time.Minute), stale: true, alerts: 1},
+		"writer skew":    {updated: now.Add(-time.Minute), mtime: now, alerts: 1},
+		"local skew":     {updated: now.Add(time.Minute), mtime: now.Add(time.Minute), alerts: 1},
+		"stale and skew": {updated: now, mtime: now.Add(-time.Minute), stale: true, alerts: 2},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stale, alerts := checkTimes("node1", tc.updated, tc.mtime, now, timeout, maxSkew)
+			require.Equal(t, tc.stale, stale)
+			require.Len(t, alerts, tc.alerts)
+		})
+	}
+}
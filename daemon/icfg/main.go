@@ -17,13 +17,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"slices"
 	"strings"
 	"time"
 
 	"github.com/opensvc/om3/v3/core/clusternode"
+	"github.com/opensvc/om3/v3/core/confighistory"
 	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/maintenance"
 	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/node"
 	"github.com/opensvc/om3/v3/core/object"
 	"github.com/opensvc/om3/v3/core/placement"
 	"github.com/opensvc/om3/v3/core/priority"
@@ -76,24 +80,36 @@ var (
 	// standby resources.
 	standbyDefaultRestart = 2
 
-	keyApp              = key.New("DEFAULT", "app")
-	keyChildren         = key.New("DEFAULT", "children")
-	keyDisable          = key.New("DEFAULT", "disable")
-	keyEnv              = key.New("DEFAULT", "env")
-	keyFlexMax          = key.New("DEFAULT", "flex_max")
-	keyFlexMin          = key.New("DEFAULT", "flex_min")
-	keyFlexTarget       = key.New("DEFAULT", "flex_target")
-	keyMonitorAction    = key.New("DEFAULT", "monitor_action")
-	keyNodes            = key.New("DEFAULT", "nodes")
-	keyOrchestrate      = key.New("DEFAULT", "orchestrate")
-	keyParents          = key.New("DEFAULT", "parents")
-	keyPool             = key.New("DEFAULT", "pool")
-	keyPlacement        = key.New("DEFAULT", "placement")
-	keyPreMonitorAction = key.New("DEFAULT", "pre_monitor_action")
-	keyPriority         = key.New("DEFAULT", "priority")
-	keySize             = key.New("DEFAULT", "size")
-	keyTopology         = key.New("DEFAULT", "topology")
-	keyStonith          = key.New("DEFAULT", "stonith")
+	// imageDriverGroups and imageDriverTypes select the resources running a
+	// container from an image the daemon can pre-fetch.
+	imageDriverGroups = []string{"container", "task"}
+	imageDriverTypes  = []string{"docker", "podman"}
+
+	keyApp                 = key.New("DEFAULT", "app")
+	keyChildren            = key.New("DEFAULT", "children")
+	keyDisable             = key.New("DEFAULT", "disable")
+	keyEnv                 = key.New("DEFAULT", "env")
+	keyFlexMax             = key.New("DEFAULT", "flex_max")
+	keyFlexMin             = key.New("DEFAULT", "flex_min")
+	keyFlexTarget          = key.New("DEFAULT", "flex_target")
+	keyImageDigestCheck    = key.New("DEFAULT", "image_digest_check")
+	keyImagePrepull        = key.New("DEFAULT", "image_prepull")
+	keyMaintenanceMode     = key.New("DEFAULT", "maintenance_mode")
+	keyMaintenanceWindow   = key.New("DEFAULT", "maintenance_window")
+	keyMonitorAction       = key.New("DEFAULT", "monitor_action")
+	keyNodes               = key.New("DEFAULT", "nodes")
+	keyOrchestrate         = key.New("DEFAULT", "orchestrate")
+	keyParents             = key.New("DEFAULT", "parents")
+	keyPool                = key.New("DEFAULT", "pool")
+	keyPlacement           = key.New("DEFAULT", "placement")
+	keyPreMonitorAction    = key.New("DEFAULT", "pre_monitor_action")
+	keyPriority            = key.New("DEFAULT", "priority")
+	keySize                = key.New("DEFAULT", "size")
+	keySwitchPrewarm       = key.New("DEFAULT", "switch_prewarm")
+	keySwitchVerify        = key.New("DEFAULT", "switch_verify")
+	keySwitchVerifyTimeout = key.New("DEFAULT", "switch_verify_timeout")
+	keyTopology            = key.New("DEFAULT", "topology")
+	keyStonith             = key.New("DEFAULT", "stonith")
 )
 
 // Start launch goroutine instConfig worker for a local instance config
@@ -259,7 +275,14 @@ func (t *Manager) onNodeLabelsCommited() {
 	_ = t.configFileCheckRefresh(true)
 }
 
+// onConfigFileRemoved stops the manager and removes the config history of
+// the deleted or purged object, so it does not outlive the object.
 func (t *Manager) onConfigFileRemoved() {
+	if !file.Exists(t.filename) {
+		if err := confighistory.Delete(t.path); err != nil {
+			t.log.Warnf("config history: delete: %s", err)
+		}
+	}
 	t.cancel()
 }
 
@@ -363,20 +386,30 @@ func (t *Manager) configFileCheck() error {
 	}
 	if actor, ok := any(t.configure).(object.Actor); ok {
 		cfg.ActorConfig = &instance.ActorConfig{
-			App:              cf.GetString(keyApp),
-			Children:         t.getChildren(cf),
-			Env:              cf.GetString(keyEnv),
-			IsDisabled:       cf.GetBool(keyDisable),
-			MonitorAction:    t.getMonitorAction(cf),
-			Orchestrate:      t.getOrchestrate(cf),
-			Parents:          t.getParents(cf),
-			PreMonitorAction: cf.GetString(keyPreMonitorAction),
-			PlacementPolicy:  t.getPlacementPolicy(cf),
-			Resources:        t.getResources(cf),
-			Schedules:        make([]schedule.Config, 0),
-			Subsets:          t.getSubsets(cf),
-			Topology:         t.getTopology(cf),
-			Stonith:          cf.GetBool(keyStonith),
+			App:               cf.GetString(keyApp),
+			Children:          t.getChildren(cf),
+			Env:               cf.GetString(keyEnv),
+			IsDisabled:        cf.GetBool(keyDisable),
+			MonitorAction:     t.getMonitorAction(cf),
+			Orchestrate:       t.getOrchestrate(cf),
+			Parents:           t.getParents(cf),
+			PreMonitorAction:  cf.GetString(keyPreMonitorAction),
+			PlacementPolicy:   t.getPlacementPolicy(cf),
+			Resources:         t.getResources(cf),
+			Schedules:         make([]schedule.Config, 0),
+			Subsets:           t.getSubsets(cf),
+			Topology:          t.getTopology(cf),
+			Stonith:           cf.GetBool(keyStonith),
+			Images:            t.getImages(cf),
+			ImagePrepull:      cf.GetBool(keyImagePrepull),
+			ImageDigestCheck:  cf.GetBool(keyImageDigestCheck),
+			MaintenanceWindow: cf.GetString(keyMaintenanceWindow),
+			MaintenanceMode:   t.getMaintenanceMode(cf),
+			SwitchPrewarm:     cf.GetStrings(keySwitchPrewarm),
+			SwitchVerify:      cf.GetString(keySwitchVerify),
+		}
+		if d := cf.GetDuration(keySwitchVerifyTimeout); d != nil {
+			cfg.ActorConfig.SwitchVerifyTimeout = *d
 		}
 		if cfg.Topology == topology.Flex {
 			instanceCount := len(scope)
@@ -395,10 +428,34 @@ func (t *Manager) configFileCheck() error {
 	}
 
 	t.lastMtime = mtime
+	t.recordRevision()
 	t.updateConfig(&cfg)
 	return nil
 }
 
+// recordRevision records the config file content as a new revision in the
+// object config history, unless the content is unchanged since the last
+// revision.
+func (t *Manager) recordRevision() {
+	b, err := os.ReadFile(t.filename)
+	if err != nil {
+		t.log.Warnf("config history: read %s: %s", t.filename, err)
+		return
+	}
+	var max int
+	if nodeConfig := node.ConfigData.GetByNode(t.localhost); nodeConfig != nil {
+		max = nodeConfig.ConfigHistoryMax
+	}
+	rev, created, err := confighistory.Record(t.path, b, max)
+	if err != nil {
+		t.log.Warnf("config history: record revision: %s", err)
+		return
+	}
+	if created {
+		t.log.Infof("config history: recorded revision %d (author: %s)", rev.ID, rev.Author)
+	}
+}
+
 // getScope return sorted scopes for object
 //
 // depending on object kind
@@ -448,6 +505,15 @@ func (t *Manager) getTopology(cf *xconfig.T) topology.T {
 	return topology.New(s)
 }
 
+func (t *Manager) getMaintenanceMode(cf *xconfig.T) maintenance.Mode {
+	mode, err := maintenance.ParseMode(cf.GetString(keyMaintenanceMode))
+	if err != nil {
+		t.log.Warnf("%s", err)
+		return maintenance.ModeFreeze
+	}
+	return mode
+}
+
 func (t *Manager) getOrchestrate(cf *xconfig.T) string {
 	s := cf.GetString(keyOrchestrate)
 	return s
@@ -493,6 +559,31 @@ func (t *Manager) getResources(cf *xconfig.T) instance.ResourceConfigs {
 	return m
 }
 
+// getImages returns the image references of the container and task
+// resources, indexed by resource id.
+func (t *Manager) getImages(cf *xconfig.T) map[string]string {
+	m := make(map[string]string)
+	for _, section := range cf.SectionStrings() {
+		group, _, _ := strings.Cut(section, "#")
+		if !slices.Contains(imageDriverGroups, group) {
+			continue
+		}
+		if !slices.Contains(imageDriverTypes, cf.GetString(key.New(section, "type"))) {
+			continue
+		}
+		if cf.GetBool(key.New(section, "disable")) {
+			continue
+		}
+		if image := cf.GetString(key.New(section, "image")); image != "" {
+			m[section] = image
+		}
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
 func (t *Manager) getPriority(cf *xconfig.T) priority.T {
 	s := cf.GetInt(keyPriority)
 	return priority.T(s)
@@ -138,6 +138,23 @@ func (t *Manager) crmFreeze() error {
 	return t.crmAction("freeze", t.path.String(), "instance", "freeze")
 }
 
+func (t *Manager) crmImagePull(rids []string) error {
+	s := strings.Join(rids, ",")
+	return t.crmAction("image pull", t.path.String(), "instance", "image", "pull", "--rid", s)
+}
+
+func (t *Manager) crmMoveFunc(dst string) func() error {
+	return func() error {
+		return t.crmAction("move", t.path.String(), "instance", "move", "--to", dst)
+	}
+}
+
+func (t *Manager) crmMoveCheckFunc(dst string) func() error {
+	return func() error {
+		return t.crmAction("move check", t.path.String(), "instance", "move-check", "--to", dst)
+	}
+}
+
 func (t *Manager) crmProvisionNonLeader() error {
 	return t.crmAction("provision non leader", t.path.String(), "instance", "provision")
 }
@@ -0,0 +1,136 @@
+package imon
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/priority"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/daemon/runner"
+)
+
+// imagesChanged returns true if the container image references of cfg differ
+// from the ones of the currently loaded instance config.
+func (t *Manager) imagesChanged(cfg instance.Config) bool {
+	var before, after map[string]string
+	if t.instConfig.ActorConfig != nil {
+		before = t.instConfig.Images
+	}
+	if cfg.ActorConfig != nil {
+		after = cfg.Images
+	}
+	return !maps.Equal(before, after)
+}
+
+type (
+	// imagePull serializes the background image pulls. The images of a
+	// config received while a pull is queued or running are pulled next,
+	// replacing the images of an older config not pulled yet.
+	imagePull struct {
+		sync.Mutex
+		queued bool
+		next   []string
+		prio   priority.T
+	}
+)
+
+// push sets the resource ids of the next pull, and returns true if the
+// caller must start the pull loop.
+func (t *imagePull) push(rids []string, prio priority.T) bool {
+	t.Lock()
+	defer t.Unlock()
+	t.next = rids
+	t.prio = prio
+	if t.queued {
+		return false
+	}
+	t.queued = true
+	return true
+}
+
+// pop returns the resource ids of the next pull, or false if there is
+// nothing left to pull.
+func (t *imagePull) pop() ([]string, priority.T, bool) {
+	t.Lock()
+	defer t.Unlock()
+	if t.next == nil {
+		t.queued = false
+		return nil, 0, false
+	}
+	rids := t.next
+	t.next = nil
+	return rids, t.prio, true
+}
+
+// prepullImages runs the image pull action in the background, so a failover
+// or switch to this node does not stall on the image download.
+func (t *Manager) prepullImages() {
+	if t.instConfig.ActorConfig == nil || !t.instConfig.ImagePrepull || len(t.instConfig.Images) == 0 {
+		return
+	}
+	rids := slices.Sorted(maps.Keys(t.instConfig.Images))
+	if !t.imagePull.push(rids, t.instConfig.Priority) {
+		t.log.Infof("image pull already queued, pull the new images next")
+		return
+	}
+	go func() {
+		for {
+			rids, prio, ok := t.imagePull.pop()
+			if !ok {
+				return
+			}
+			_ = runner.Run(prio, func() error {
+				return t.crmImagePull(rids)
+			})
+		}
+	}()
+}
+
+// checkImageDigests returns an error if a destination node has no local copy
+// of an image a running container was created from, or a different digest
+// for this image.
+func (t *Manager) checkImageDigests(destinations []string) error {
+	if t.instConfig.ActorConfig == nil || !t.instConfig.ImageDigestCheck {
+		return nil
+	}
+	running := make(map[string]string)
+	for _, instStatus := range t.instStatus {
+		if instStatus.Avail != status.Up {
+			continue
+		}
+		for rid, resStatus := range instStatus.Resources {
+			if resStatus.Image != nil && resStatus.Image.RunningDigest != "" {
+				running[rid] = resStatus.Image.RunningDigest
+			}
+		}
+	}
+	if len(running) == 0 {
+		return nil
+	}
+	for _, s := range destinations {
+		for _, node := range strings.Split(s, ",") {
+			instStatus, ok := t.instStatus[node]
+			if !ok {
+				return fmt.Errorf("image digest check: no instance status for node %s", node)
+			}
+			if instStatus.Avail == status.Up {
+				continue
+			}
+			for _, rid := range slices.Sorted(maps.Keys(running)) {
+				digest := running[rid]
+				image := instStatus.Resources[rid].Image
+				switch {
+				case image == nil || !image.Present:
+					return fmt.Errorf("image digest check: %s image is not present on node %s", rid, node)
+				case image.Digest != digest:
+					return fmt.Errorf("image digest check: %s image %s digest %s on node %s differs from the running digest %s", rid, image.Name, image.Digest, node, digest)
+				}
+			}
+		}
+	}
+	return nil
+}
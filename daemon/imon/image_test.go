@@ -0,0 +1,28 @@
+package imon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImagePull(t *testing.T) {
+	var q imagePull
+
+	require.True(t, q.push([]string{"container#1"}, 50), "the first push starts the pull loop")
+	require.False(t, q.push([]string{"container#1", "container#2"}, 40), "a push while queued does not start a new loop")
+
+	rids, prio, ok := q.pop()
+	require.True(t, ok)
+	require.Equal(t, []string{"container#1", "container#2"}, rids, "the newer config replaces the queued rids")
+	require.EqualValues(t, 40, prio)
+
+	require.False(t, q.push([]string{"container#2"}, 50), "a push while pulling does not start a new loop")
+	rids, _, ok = q.pop()
+	require.True(t, ok)
+	require.Equal(t, []string{"container#2"}, rids, "the config received while pulling is pulled next")
+
+	_, _, ok = q.pop()
+	require.False(t, ok, "the loop ends when nothing is left to pull")
+	require.True(t, q.push([]string{"container#1"}, 50), "a push after the loop ended starts a new loop")
+}
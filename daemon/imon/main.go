@@ -33,6 +33,7 @@ import (
 	"github.com/opensvc/om3/v3/daemon/daemonctx"
 
 	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/maintenance"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/node"
 	"github.com/opensvc/om3/v3/core/object"
@@ -80,6 +81,11 @@ type (
 		// or InstanceMonitorUpdated from other nodes.
 		instMonitor map[string]instance.Monitor
 
+		// remoteUnreachable is the set of remote clusters, referenced by
+		// path@cluster:<name> relations, whose event stream is not
+		// connected. The parents of such clusters block the start.
+		remoteUnreachable map[string]bool
+
 		nodeMonitor   map[string]node.Monitor
 		nodeStats     map[string]node.Stats
 		nodeStatus    map[string]node.Status
@@ -96,6 +102,9 @@ type (
 		// TODO: need review
 		statusQueued atomic.Bool
 
+		// imagePull is the background image pull queue
+		imagePull imagePull
+
 		// peerDrop stores which node needs a stonith before starting the local instance, if any
 		peerDrop   string
 		peerDropAt time.Time
@@ -253,6 +262,9 @@ func start(parent context.Context, qs pubsub.QueueSizer, p naming.Path, nodes []
 		instMonitor:   make(map[string]instance.Monitor),
 		nodeMonitor:   make(map[string]node.Monitor),
 		nodeStats:     make(map[string]node.Stats),
+
+		remoteUnreachable: make(map[string]bool),
+
 		nodeStatus:    make(map[string]node.Status),
 		priors:        make([]string, 0),
 		localhost:     localhost,
@@ -410,6 +422,9 @@ func (t *Manager) worker(initialNodes []string) {
 			}
 		}()
 	}()
+	maintenanceTicker := time.NewTicker(maintenance.CheckInterval)
+	defer maintenanceTicker.Stop()
+
 	t.log.Tracef("started")
 	for {
 		select {
@@ -441,6 +456,14 @@ func (t *Manager) worker(initialNodes []string) {
 				t.onObjectStatusUpdated(c)
 			case *msgbus.ProgressInstanceMonitor:
 				t.onProgressInstanceMonitor(c)
+			case *msgbus.RemoteClusterReachable:
+				t.onRemoteClusterReachable(c)
+			case *msgbus.RemoteClusterUnreachable:
+				t.onRemoteClusterUnreachable(c)
+			case *msgbus.RemoteObjectStatusDeleted:
+				t.onRelationRemoteObjectStatusDeleted(c)
+			case *msgbus.RemoteObjectStatusUpdated:
+				t.onRelationRemoteObjectStatusUpdated(c)
 			case *msgbus.SetInstanceMonitor:
 				t.onSetInstanceMonitor(c)
 			case *msgbus.NodeConfigUpdated:
@@ -469,6 +492,8 @@ func (t *Manager) worker(initialNodes []string) {
 			}
 		case <-t.delayTimer.C:
 			t.onDelayTimer()
+		case <-maintenanceTicker.C:
+			t.onMaintenanceTicker()
 		}
 	}
 }
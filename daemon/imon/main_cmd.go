@@ -22,6 +22,7 @@ import (
 	"github.com/opensvc/om3/v3/core/status"
 	"github.com/opensvc/om3/v3/core/topology"
 	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/daemon/remotemon"
 	"github.com/opensvc/om3/v3/daemon/runner"
 	"github.com/opensvc/om3/v3/util/errcontext"
 	"github.com/opensvc/om3/v3/util/pubsub"
@@ -110,6 +111,8 @@ func (t *Manager) initRelationAvailStatus() {
 		relationS := relation.String()
 		if objectPath, node, err := relation.Split(); err != nil {
 			t.log.Warnf("init relation %s status cache: split %s: %s", name, relation, err)
+		} else if relation.Cluster() != "" {
+			t.initRemoteRelation("init relation", relation, objectPath, name, cache)
 		} else if node == "" {
 			t.log.Infof("init relation subscribe to %s %s object avail status updates and deletes", name, objectPath)
 			t.sub.AddFilter(&msgbus.ObjectStatusUpdated{}, pubsub.Label{"path", objectPath.String()})
@@ -144,6 +147,124 @@ func (t *Manager) initRelationAvailStatus() {
 	}
 }
 
+// initRemoteRelation subscribes to the avail status updates of the
+// remote cluster object of a path@cluster:<name> relation, and initializes
+// the relation avail status and the remote cluster reachability from the
+// remote clusters watcher data.
+func (t *Manager) initRemoteRelation(logPrefix string, relation naming.Relation, objectPath naming.Path, name string, cache map[string]status.T) {
+	clusterName := relation.Cluster()
+	labelCluster := pubsub.Label{"cluster", clusterName}
+	t.log.Infof("%s subscribe to %s %s remote object avail status updates and deletes", logPrefix, name, relation)
+	t.sub.AddFilter(&msgbus.RemoteObjectStatusUpdated{}, labelCluster, pubsub.Label{"path", objectPath.String()})
+	t.sub.AddFilter(&msgbus.RemoteObjectStatusDeleted{}, labelCluster, pubsub.Label{"path", objectPath.String()})
+	t.sub.AddFilter(&msgbus.RemoteClusterReachable{}, labelCluster)
+	t.sub.AddFilter(&msgbus.RemoteClusterUnreachable{}, labelCluster)
+	t.remoteUnreachable[clusterName] = !remotemon.Data.IsReachable(clusterName)
+	if t.remoteUnreachable[clusterName] {
+		t.log.Infof("%s %s %s avail status init to %s (cluster %s unreachable)", logPrefix, name, relation, status.Undef, clusterName)
+		cache[relation.String()] = status.Undef
+	} else if st := remotemon.Data.GetObjectStatus(clusterName, objectPath); st != nil {
+		t.log.Infof("%s %s %s avail status init to %s", logPrefix, name, relation, st.Avail)
+		cache[relation.String()] = st.Avail
+	} else {
+		t.log.Infof("%s %s %s avail status init to %s", logPrefix, name, relation, status.Undef)
+		cache[relation.String()] = status.Undef
+	}
+}
+
+// setRemoteRelationAvail updates the avail status of the relations to a
+// remote cluster object. It returns true if a relation avail status
+// changed.
+func (t *Manager) setRemoteRelationAvail(relation string, avail status.T, reason string) bool {
+	changes := false
+	do := func(name string, cache map[string]status.T) {
+		if v, ok := cache[relation]; !ok {
+			return
+		} else if v == avail {
+			t.log.Tracef("update relation %s %s avail status unchanged", name, relation)
+			return
+		}
+		t.log.Infof("update relation %s %s avail status change %s -> %s%s", name, relation, cache[relation], avail, reason)
+		cache[relation] = avail
+		changes = true
+	}
+	do("Child", t.state.Children)
+	do("Parent", t.state.Parents)
+	return changes
+}
+
+func (t *Manager) onRelationRemoteObjectStatusUpdated(c *msgbus.RemoteObjectStatusUpdated) {
+	relation := c.Path.String() + "@" + naming.RelationClusterPrefix + c.Cluster
+	if t.setRemoteRelationAvail(relation, c.Value.Avail, "") {
+		t.change = true
+		t.onChange()
+	}
+}
+
+func (t *Manager) onRelationRemoteObjectStatusDeleted(c *msgbus.RemoteObjectStatusDeleted) {
+	relation := c.Path.String() + "@" + naming.RelationClusterPrefix + c.Cluster
+	if t.setRemoteRelationAvail(relation, status.Undef, " (deleted remote object)") {
+		t.change = true
+		t.onChange()
+	}
+}
+
+func (t *Manager) onRemoteClusterReachable(c *msgbus.RemoteClusterReachable) {
+	if unreachable, ok := t.remoteUnreachable[c.Cluster]; !ok || !unreachable {
+		// already reachable, or no longer referenced by a relation
+		return
+	}
+	t.log.Infof("cluster %s is reachable via %s", c.Cluster, c.URL)
+	t.remoteUnreachable[c.Cluster] = false
+	t.change = true
+	t.onChange()
+}
+
+// onRemoteClusterUnreachable resets the avail status of the relations to
+// the remote cluster objects, as they are no longer updated. The parents
+// of an unreachable cluster block the start until the cluster is
+// reachable again.
+func (t *Manager) onRemoteClusterUnreachable(c *msgbus.RemoteClusterUnreachable) {
+	if unreachable, ok := t.remoteUnreachable[c.Cluster]; !ok || unreachable {
+		// already unreachable, or no longer referenced by a relation
+		return
+	}
+	t.log.Warnf("cluster %s is unreachable: %s", c.Cluster, c.Error)
+	t.remoteUnreachable[c.Cluster] = true
+	for _, cache := range []map[string]status.T{t.state.Children, t.state.Parents} {
+		for relation := range cache {
+			if naming.Relation(relation).Cluster() == c.Cluster {
+				t.setRemoteRelationAvail(relation, status.Undef, " (unreachable cluster)")
+			}
+		}
+	}
+	t.change = true
+	t.onChange()
+}
+
+// janitorRemoteClusters unsubscribes from the reachability updates of the
+// remote clusters no longer referenced by a relation.
+func (t *Manager) janitorRemoteClusters() {
+	referenced := make(map[string]bool)
+	for _, cache := range []map[string]status.T{t.state.Children, t.state.Parents} {
+		for relation := range cache {
+			if clusterName := naming.Relation(relation).Cluster(); clusterName != "" {
+				referenced[clusterName] = true
+			}
+		}
+	}
+	for clusterName := range t.remoteUnreachable {
+		if referenced[clusterName] {
+			continue
+		}
+		t.log.Infof("janitor relations unsubscribe from cluster %s reachability updates", clusterName)
+		labelCluster := pubsub.Label{"cluster", clusterName}
+		t.sub.DelFilter(&msgbus.RemoteClusterReachable{}, labelCluster)
+		t.sub.DelFilter(&msgbus.RemoteClusterUnreachable{}, labelCluster)
+		delete(t.remoteUnreachable, clusterName)
+	}
+}
+
 func (t *Manager) onRelationObjectStatusDeleted(c *msgbus.ObjectStatusDeleted) {
 	if c.Path == t.path {
 		// Can't relate to self.
@@ -255,6 +376,9 @@ func (t *Manager) onMyInstanceStatusUpdated(srcNode string, srcCmd *msgbus.Insta
 		return
 	}
 	t.instStatus[srcCmd.Node] = srcCmd.Value
+	if srcCmd.Node == t.localhost {
+		t.updateResourceActionTimeouts(srcCmd.Value)
+	}
 	t.mergePeerFrozen()
 	t.clearStonith(srcCmd.Node, srcCmd.Value.Avail)
 	t.handleResourceFiles(srcCmd)
@@ -312,6 +436,9 @@ func (t *Manager) onLocalInstanceConfigUpdated(srcCmd *msgbus.InstanceConfigUpda
 			} else if objectPath, node, err := relation.Split(); err != nil {
 				t.log.Warnf("janitor relations %s status cache: split %s: %s", name, relation, err)
 				continue
+			} else if relation.Cluster() != "" {
+				t.initRemoteRelation("janitor relations", relation, objectPath, name, currentRelations)
+				t.change = true
 			} else {
 				t.log.Infof("janitor relations subscribe to %s %s avail status updates and deletes", name, relationS)
 				if node == "" {
@@ -345,7 +472,11 @@ func (t *Manager) onLocalInstanceConfigUpdated(srcCmd *msgbus.InstanceConfigUpda
 				droppedRelations = append(droppedRelations, relationS)
 				t.log.Infof("janitor relations unsubscribe from %s %s avail status updates and deletes", name, relationS)
 				objectPath, node, _ := naming.Relation(relationS).Split()
-				if node == "" {
+				if clusterName := naming.Relation(relationS).Cluster(); clusterName != "" {
+					labelCluster := pubsub.Label{"cluster", clusterName}
+					t.sub.DelFilter(&msgbus.RemoteObjectStatusUpdated{}, labelCluster, pubsub.Label{"path", objectPath.String()})
+					t.sub.DelFilter(&msgbus.RemoteObjectStatusDeleted{}, labelCluster, pubsub.Label{"path", objectPath.String()})
+				} else if node == "" {
 					t.sub.DelFilter(&msgbus.InstanceStatusUpdated{}, pubsub.Label{"path", objectPath.String()})
 					t.sub.DelFilter(&msgbus.InstanceStatusDeleted{}, pubsub.Label{"path", objectPath.String()})
 				} else {
@@ -360,6 +491,7 @@ func (t *Manager) onLocalInstanceConfigUpdated(srcCmd *msgbus.InstanceConfigUpda
 		}
 	}
 
+	imagesChanged := t.imagesChanged(srcCmd.Value)
 	t.instConfig = srcCmd.Value
 	t.log.Tracef("refresh resource monitor states on local instance config updated")
 	t.initResourceMonitor()
@@ -367,9 +499,14 @@ func (t *Manager) onLocalInstanceConfigUpdated(srcCmd *msgbus.InstanceConfigUpda
 	if srcCmd.Value.ActorConfig != nil {
 		janitorRelations(srcCmd.Value.ActorConfig.Children, "Child", t.state.Children)
 		janitorRelations(srcCmd.Value.ActorConfig.Parents, "Parent", t.state.Parents)
+		t.janitorRemoteClusters()
 	}
 	// config has changed refresh resource monitor states
 	t.requestStatusRefresh(t.instConfig.Priority)
+	if imagesChanged {
+		t.prepullImages()
+	}
+	t.onMaintenanceTicker()
 }
 
 func (t *Manager) onMyInstanceStatusDeleted(c *msgbus.InstanceStatusDeleted) {
@@ -514,6 +651,11 @@ func (t *Manager) onSetInstanceMonitor(c *msgbus.SetInstanceMonitor) {
 		switch *c.Value.GlobalExpect {
 		case instance.MonitorGlobalExpectPlacedAt:
 			options, ok := c.Value.GlobalExpectOptions.(instance.MonitorGlobalExpectOptionsPlacedAt)
+			if err := t.checkBlueGreen(options); err != nil {
+				t.log.Infof("set instance monitor: %s", err)
+				globalExpectRefused()
+				return err
+			}
 			if !ok || len(options.Destination) == 0 {
 				// Switch cmd without explicit target nodes.
 				// Select some nodes automatically.
@@ -524,6 +666,11 @@ func (t *Manager) onSetInstanceMonitor(c *msgbus.SetInstanceMonitor) {
 					globalExpectRefused()
 					return err
 				}
+				if err := t.checkImageDigests([]string{dst}); err != nil {
+					t.log.Infof("set instance monitor: %s", err)
+					globalExpectRefused()
+					return err
+				}
 				options.Destination = []string{dst}
 				globalExpectOptions = options
 			} else if options.Live && len(options.Destination) > 1 {
@@ -547,9 +694,21 @@ func (t *Manager) onSetInstanceMonitor(c *msgbus.SetInstanceMonitor) {
 				} else if can != want[0] {
 					t.log.Infof("set instance monitor: change destination nodes from %s to %s", want, can)
 				}
+				if err := t.checkImageDigests([]string{can}); err != nil {
+					t.log.Infof("set instance monitor: %s", err)
+					globalExpectRefused()
+					return err
+				}
 				options.Destination = []string{can}
 				globalExpectOptions = options
 			}
+		case instance.MonitorGlobalExpectMoved:
+			options, _ := c.Value.GlobalExpectOptions.(instance.MonitorGlobalExpectOptionsMoved)
+			if err := t.checkMoved(options); err != nil {
+				t.log.Infof("set instance monitor: %s", err)
+				globalExpectRefused()
+				return err
+			}
 		case instance.MonitorGlobalExpectStarted:
 			if v, reason := t.isStartable(); !v {
 				err := fmt.Errorf("%s", reason)
@@ -0,0 +1,115 @@
+package imon
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/maintenance"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+)
+
+// onMaintenanceTicker opens or closes the object maintenance window
+// according to the DEFAULT.maintenance_window schedule expression.
+func (t *Manager) onMaintenanceTicker() {
+	t.evalMaintenanceWindow(time.Now())
+	t.updateIfChange()
+}
+
+func (t *Manager) evalMaintenanceWindow(now time.Time) {
+	var (
+		expr string
+		mode maintenance.Mode
+	)
+	if t.instConfig.ActorConfig != nil {
+		expr = t.instConfig.MaintenanceWindow
+		mode = t.instConfig.MaintenanceMode
+	}
+	isOpen, err := maintenance.IsOpen(expr, now)
+	if err != nil {
+		t.log.Warnf("maintenance window %s: %s", expr, err)
+		return
+	}
+	current := t.state.MaintenanceWindow
+	if current != nil && (!isOpen || current.Schedule != expr || current.Mode != mode) {
+		if err := t.closeMaintenanceWindow(); err != nil {
+			return
+		}
+		current = nil
+	}
+	switch {
+	case isOpen && current == nil:
+		t.openMaintenanceWindow(maintenance.Window{Schedule: expr, Mode: mode, OpenedAt: now})
+	case !isOpen && current == nil && maintenance.IsMarkedFrozen(t.frozenFile()):
+		// the window closed while the daemon was not running
+		if err := t.maintenanceUnfreeze(); err != nil {
+			t.log.Errorf("maintenance window closed: unfreeze: %s", err)
+		} else {
+			t.log.Infof("maintenance window closed: instance has been unfrozen")
+		}
+	}
+}
+
+func (t *Manager) frozenFile() string {
+	return filepath.Join(t.path.VarDir(), "frozen")
+}
+
+// maintenanceUnfreeze unfreezes the instance frozen by a maintenance window
+// opening, and removes the maintenance marker.
+func (t *Manager) maintenanceUnfreeze() error {
+	if err := t.unfreeze(); err != nil {
+		return err
+	}
+	return maintenance.UnmarkFrozen(t.frozenFile())
+}
+
+func (t *Manager) openMaintenanceWindow(w maintenance.Window) {
+	if w.Mode == maintenance.ModeFreeze {
+		if t.instStatus[t.localhost].IsFrozen() {
+			t.log.Infof("maintenance window %s opened: instance is already frozen", w.Schedule)
+		} else if err := t.freeze(); err != nil {
+			t.log.Errorf("maintenance window %s opened: freeze: %s", w.Schedule, err)
+			return
+		} else {
+			t.log.Infof("maintenance window %s opened: instance has been frozen", w.Schedule)
+			if err := maintenance.MarkFrozen(t.frozenFile()); err != nil {
+				t.log.Warnf("maintenance window %s opened: %s", w.Schedule, err)
+			}
+		}
+	} else {
+		t.log.Infof("maintenance window %s opened: block orchestrations", w.Schedule)
+	}
+	t.state.MaintenanceWindow = &w
+	t.change = true
+	t.publisher.Pub(&msgbus.InstanceMaintenanceWindowOpened{Path: t.path, Node: t.localhost, Value: w}, t.pubLabels...)
+}
+
+// closeMaintenanceWindow unfreezes the instance if it was frozen by a
+// window opening, and publishes the window closing.
+func (t *Manager) closeMaintenanceWindow() error {
+	w := *t.state.MaintenanceWindow
+	if maintenance.IsMarkedFrozen(t.frozenFile()) {
+		if err := t.maintenanceUnfreeze(); err != nil {
+			t.log.Errorf("maintenance window %s closed: unfreeze: %s", w.Schedule, err)
+			return err
+		}
+		t.log.Infof("maintenance window %s closed: instance has been unfrozen", w.Schedule)
+	} else {
+		t.log.Infof("maintenance window %s closed", w.Schedule)
+	}
+	t.state.MaintenanceWindow = nil
+	t.change = true
+	t.publisher.Pub(&msgbus.InstanceMaintenanceWindowClosed{Path: t.path, Node: t.localhost, Value: w}, t.pubLabels...)
+	return nil
+}
+
+// isMaintenanceBlocked returns true when an open object or node maintenance
+// window blocks the automatic orchestrations of the local instance.
+func (t *Manager) isMaintenanceBlocked() bool {
+	if w := t.state.MaintenanceWindow; w != nil && w.Mode == maintenance.ModeBlock {
+		return true
+	}
+	if w := t.nodeStatus[t.localhost].MaintenanceWindow; w != nil && w.Mode == maintenance.ModeBlock {
+		return true
+	}
+	return false
+}
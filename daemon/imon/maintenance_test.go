@@ -0,0 +1,96 @@
+package imon
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/maintenance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/testhelper"
+	"github.com/opensvc/om3/v3/util/file"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+type nopPublisher struct{}
+
+func (nopPublisher) Pub(pubsub.Messager, ...pubsub.Label) {}
+
+// newMaintenanceTestManager returns a manager as started by the daemon,
+// with no open maintenance window in its state.
+func newMaintenanceTestManager(p naming.Path) *Manager {
+	m := &Manager{
+		path:       p,
+		localhost:  "node1",
+		log:        plog.NewLogger(zerolog.Nop()),
+		publisher:  nopPublisher{},
+		instStatus: map[string]instance.Status{"node1": {}},
+	}
+	m.instConfig.ActorConfig = &instance.ActorConfig{
+		MaintenanceWindow: "10:00-12:00",
+		MaintenanceMode:   maintenance.ModeFreeze,
+	}
+	return m
+}
+
+func TestMaintenanceWindowFreeze(t *testing.T) {
+	now := time.Now()
+	during := time.Date(now.Year(), now.Month(), now.Day(), 11, 0, 0, 0, time.Local)
+	after := during.Add(2 * time.Hour)
+
+	setup := func(t *testing.T) (naming.Path, string) {
+		testhelper.Setup(t)
+		p := naming.Path{Namespace: "test", Kind: naming.KindSvc, Name: "s1"}
+		frozenFile := filepath.Join(p.VarDir(), "frozen")
+		return p, frozenFile
+	}
+
+	t.Run("unfreezes at the end of the window", func(t *testing.T) {
+		p, frozenFile := setup(t)
+		m := newMaintenanceTestManager(p)
+		m.evalMaintenanceWindow(during)
+		require.True(t, file.Exists(frozenFile))
+		m.evalMaintenanceWindow(after)
+		require.False(t, file.Exists(frozenFile))
+		require.False(t, maintenance.IsMarkedFrozen(frozenFile))
+	})
+
+	t.Run("unfreezes at the end of the window after a restart", func(t *testing.T) {
+		p, frozenFile := setup(t)
+		newMaintenanceTestManager(p).evalMaintenanceWindow(during)
+		require.True(t, file.Exists(frozenFile))
+
+		m := newMaintenanceTestManager(p)
+		m.evalMaintenanceWindow(during.Add(time.Minute))
+		require.NotNil(t, m.state.MaintenanceWindow)
+		require.True(t, file.Exists(frozenFile))
+		m.evalMaintenanceWindow(after)
+		require.False(t, file.Exists(frozenFile))
+	})
+
+	t.Run("unfreezes when the window ended during a restart", func(t *testing.T) {
+		p, frozenFile := setup(t)
+		newMaintenanceTestManager(p).evalMaintenanceWindow(during)
+		require.True(t, file.Exists(frozenFile))
+
+		newMaintenanceTestManager(p).evalMaintenanceWindow(after)
+		require.False(t, file.Exists(frozenFile))
+	})
+
+	t.Run("keeps an instance frozen before the window", func(t *testing.T) {
+		p, frozenFile := setup(t)
+		require.NoError(t, os.MkdirAll(filepath.Dir(frozenFile), 0755))
+		require.NoError(t, os.WriteFile(frozenFile, nil, 0644))
+		m := newMaintenanceTestManager(p)
+		m.instStatus["node1"] = instance.Status{FrozenAt: file.ModTime(frozenFile)}
+		m.evalMaintenanceWindow(during)
+		m.evalMaintenanceWindow(after)
+		require.True(t, file.Exists(frozenFile))
+	})
+}
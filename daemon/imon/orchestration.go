@@ -90,6 +90,8 @@ func (t *Manager) orchestrate() {
 		t.orchestrateNone()
 	case instance.MonitorGlobalExpectFrozen:
 		t.orchestrateFrozen()
+	case instance.MonitorGlobalExpectMoved:
+		t.orchestrateMoved()
 	case instance.MonitorGlobalExpectProvisioned:
 		t.orchestrateProvisioned()
 	case instance.MonitorGlobalExpectPlaced:
@@ -115,6 +117,10 @@ func (t *Manager) orchestrate() {
 func (t *Manager) isAnyParentWaitingChilren() bool {
 	l := make([]string, 0)
 	for relation, _ := range t.state.Parents {
+		if naming.Relation(relation).Cluster() != "" {
+			// the remote cluster instance monitors are not watched
+			continue
+		}
 		path, err := naming.ParsePath(relation)
 		if err != nil {
 			t.log.Errorf("%s", err)
@@ -135,6 +141,14 @@ func (t *Manager) isAnyParentWaitingChilren() bool {
 
 func (t *Manager) setWaitParents() bool {
 	for relation, availStatus := range t.state.Parents {
+		if clusterName := naming.Relation(relation).Cluster(); clusterName != "" && t.remoteUnreachable[clusterName] {
+			if t.state.State != instance.MonitorStateWaitParents {
+				t.log.Infof("wait parents because %s cluster is unreachable", relation)
+				t.state.State = instance.MonitorStateWaitParents
+				t.change = true
+			}
+			return true
+		}
 		if !availStatus.Is(status.Up, status.Undef) {
 			if t.state.State != instance.MonitorStateWaitParents {
 				t.log.Infof("wait parents because %s avail status is %s", relation, availStatus)
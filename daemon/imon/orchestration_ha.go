@@ -22,6 +22,8 @@ func (t *Manager) orchestrateHAStop() {
 
 	if t.nodeStatus[t.localhost].IsFrozen() {
 		return
+	} else if t.isMaintenanceBlocked() {
+		return
 	} else if t.objStatus.UpInstancesCount <= t.objStatus.Flex.Target {
 		return
 	} else if t.state.IsHALeader {
@@ -57,6 +59,14 @@ func (t *Manager) orchestrateHAStart() {
 		t.transitionTo(instance.MonitorStateIdle)
 		return
 	}
+	if t.isMaintenanceBlocked() {
+		if t.pendingCancel != nil && t.state.State == instance.MonitorStateReady {
+			t.log.Infof("maintenance window is open, clear the ready state")
+			t.clearPending()
+			t.transitionTo(instance.MonitorStateIdle)
+		}
+		return
+	}
 	if v, reason := t.isStartable(); !v {
 		if t.pendingCancel != nil && t.state.State == instance.MonitorStateReady {
 			t.log.Infof("instance is not startable, clear the ready state: %s", reason)
@@ -0,0 +1,332 @@
+package imon
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/provisioned"
+	"github.com/opensvc/om3/v3/core/resourceid"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/core/topology"
+	"github.com/opensvc/om3/v3/daemon/runner"
+)
+
+// A live migration moves a running failover instance to the destination
+// node without stopping its movable resources:
+//
+//	source:      move check    verify the destination capacity, shared storage and cpu compatibility
+//	source:      move          live migrate the movable resources, stop the other resources
+//	destination: start         once the source is moved, start the other resources
+//
+// If the move check fails, the source instance is left untouched. If the
+// move fails before a movable resource is migrated, the movable resources
+// roll back their pre-move actions and the source instance restarts the
+// resources it stopped. If the move fails after a movable resource is
+// migrated, the source instance is move partially failed and the
+// destination instance starts the other resources.
+
+var (
+	// moveCheckDriverGroups are the driver groups of the resources the
+	// destination instance must see provisioned before a live migration.
+	moveCheckDriverGroups = []driver.Group{driver.GroupDisk, driver.GroupVolume}
+
+	movedSourceFailedStates = []instance.MonitorState{
+		instance.MonitorStateMoveCheckFailure,
+		instance.MonitorStateMoveFailure,
+	}
+
+	// movedSourceMovedStates are the source states where the movable
+	// resources run on the destination node.
+	movedSourceMovedStates = []instance.MonitorState{
+		instance.MonitorStateMoveSuccess,
+		instance.MonitorStateMovePartialFailure,
+	}
+)
+
+func (t *Manager) getMovedDestination() (string, bool) {
+	options, ok := t.state.GlobalExpectOptions.(instance.MonitorGlobalExpectOptionsMoved)
+	if !ok || options.Destination == "" {
+		return "", false
+	}
+	return options.Destination, true
+}
+
+// checkMoved returns an error if the moved options can't be applied to the
+// object.
+func (t *Manager) checkMoved(options instance.MonitorGlobalExpectOptionsMoved) error {
+	if options.Destination == "" {
+		return fmt.Errorf("live migration needs a destination node")
+	}
+	if t.instConfig.ActorConfig == nil || t.instConfig.Topology != topology.Failover {
+		return fmt.Errorf("live migration is only possible with the failover topology")
+	}
+	if _, ok := t.instStatus[options.Destination]; !ok {
+		return fmt.Errorf("no instance on the destination node %s", options.Destination)
+	}
+	if source := t.movedSource(options.Destination); source == "" {
+		return fmt.Errorf("no running instance to live migrate to %s", options.Destination)
+	}
+	return nil
+}
+
+// movedSource returns the node running the instance to live migrate to
+// the destination node.
+func (t *Manager) movedSource(dst string) string {
+	nodes := make([]string, 0)
+	for nodename, instStatus := range t.instStatus {
+		if nodename == dst {
+			continue
+		}
+		switch instStatus.Avail {
+		case status.Up, status.Warn:
+			nodes = append(nodes, nodename)
+		}
+	}
+	if len(nodes) != 1 {
+		return ""
+	}
+	return nodes[0]
+}
+
+func (t *Manager) orchestrateMoved() {
+	dst, ok := t.getMovedDestination()
+	if !ok {
+		t.log.Errorf("missing moved destination")
+		return
+	}
+	if dst == t.localhost {
+		t.orchestrateMovedDestination()
+	} else {
+		t.orchestrateMovedSource(dst)
+	}
+}
+
+func (t *Manager) orchestrateMovedSource(dst string) {
+	switch t.state.State {
+	case instance.MonitorStateIdle:
+		t.placedUnfreeze()
+	case instance.MonitorStateUnfreezeSuccess:
+		t.movedSourceFromUnfrozen(dst)
+	case instance.MonitorStateMoveCheckSuccess:
+		t.doMove(dst)
+	case instance.MonitorStateMoveCheckFailure:
+		t.movedSourceFromFailure()
+	case instance.MonitorStateMoveSuccess:
+		t.movedSourceFromMoved(dst)
+	case instance.MonitorStateMoveFailure:
+		t.movedSourceFromFailure()
+	case instance.MonitorStateMovePartialFailure:
+		t.movedSourceFromMoved(dst)
+	case instance.MonitorStateUnfreezeProgress:
+	case instance.MonitorStateMoveCheckProgress:
+	case instance.MonitorStateMoveProgress:
+	case instance.MonitorStateWaitChildren:
+		t.setWaitChildren()
+	default:
+		t.log.Errorf("don't know how to orchestrate moved source from %s", t.state.State)
+	}
+}
+
+func (t *Manager) orchestrateMovedDestination() {
+	switch t.state.State {
+	case instance.MonitorStateIdle:
+		t.placedUnfreeze()
+	case instance.MonitorStateUnfreezeSuccess:
+		t.movedDestinationFromUnfrozen()
+	case instance.MonitorStateStartSuccess:
+		t.orchestrateFailoverPlacedStartFromStarted()
+	case instance.MonitorStateStartFailure:
+		t.orchestratePlacedFromStartFailed()
+	case instance.MonitorStateUnfreezeProgress:
+	case instance.MonitorStateStartProgress:
+	case instance.MonitorStateWaitParents:
+		t.orchestratePlacedAtSetWaitParents()
+	default:
+		t.log.Errorf("don't know how to orchestrate moved destination from %s", t.state.State)
+	}
+}
+
+// movedSourceFromUnfrozen checks the destination from the cluster data,
+// then asks the movable resources to check the destination.
+func (t *Manager) movedSourceFromUnfrozen(dst string) {
+	switch t.instStatus[t.localhost].Avail {
+	case status.Up, status.Warn:
+	default:
+		t.loggerWithState().Infof("instance is not running, nothing to move")
+		t.doneAndIdle()
+		t.clearPending()
+		return
+	}
+	if t.setWaitChildren() {
+		return
+	}
+	if err := t.moveCheckDestination(dst); err != nil {
+		t.loggerWithState().Warnf("move check: %s", err)
+		t.transitionTo(instance.MonitorStateMoveCheckFailure)
+		return
+	}
+	t.queueAction(t.crmMoveCheckFunc(dst), instance.MonitorStateMoveCheckProgress, instance.MonitorStateMoveCheckSuccess, instance.MonitorStateMoveCheckFailure)
+}
+
+// doMove queues the move action. A move failing after a movable resource
+// was migrated to the destination ends in the move partially failed state,
+// so the destination instance knows it has to start.
+func (t *Manager) doMove(dst string) {
+	t.createPendingWithDuration(stopDuration)
+	t.disableMonitor("orchestrate move")
+	move := t.crmMoveFunc(dst)
+	_ = runner.Run(t.instConfig.Priority, func() error {
+		t.transitionTo(instance.MonitorStateMoveProgress)
+		var nextState instance.MonitorState
+		switch err := move(); {
+		case err == nil:
+			nextState = instance.MonitorStateMoveSuccess
+		case object.IsMovedTo(t.path, dst):
+			nextState = instance.MonitorStateMovePartialFailure
+		default:
+			nextState = instance.MonitorStateMoveFailure
+		}
+		go t.orchestrateAfterAction(instance.MonitorStateMoveProgress, nextState)
+		return nil
+	})
+}
+
+// movedSourceFromMoved keeps the moved or move partially failed state
+// visible until the destination instance is done starting the resources
+// stopped by the move. The move partially failed state is kept after the
+// orchestration is done.
+func (t *Manager) movedSourceFromMoved(dst string) {
+	instMonitor, ok := t.GetInstanceMonitor(dst)
+	if !ok || instMonitor.OrchestrationID != t.state.OrchestrationID {
+		return
+	}
+	switch {
+	case instMonitor.OrchestrationIsDone:
+	case instMonitor.State.IsOneOf(instance.MonitorStateStartSuccess, instance.MonitorStateStartFailure):
+	default:
+		return
+	}
+	if t.state.State == instance.MonitorStateMovePartialFailure {
+		t.loggerWithState().Warnf("orchestration %s partially failed, set done: the movable resources run on node %s", t.state.GlobalExpect, dst)
+		t.done()
+		t.clearPending()
+		return
+	}
+	t.clearStopped()
+}
+
+func (t *Manager) movedSourceFromFailure() {
+	instStatus := t.instStatus[t.localhost]
+	t.loggerWithState().Warnf("orchestration %s failed, set done: state is %s with avail %s",
+		t.state.GlobalExpect, t.state.State, instStatus.Avail)
+	t.done()
+	t.clearPending()
+}
+
+// movedDestinationFromUnfrozen starts the local instance when the source
+// instance is moved, even partially.
+func (t *Manager) movedDestinationFromUnfrozen() {
+	nodename, state, ok := t.movedSourceState()
+	switch {
+	case !ok:
+	case state.IsOneOf(movedSourceMovedStates...):
+		if state == instance.MonitorStateMovePartialFailure {
+			t.loggerWithState().Warnf("source instance on node %s is %s, start the local instance", nodename, state)
+		}
+		if t.orchestratePlacedAtSetWaitParents() {
+			return
+		}
+		t.doPlacedStart()
+	case state.IsOneOf(movedSourceFailedStates...):
+		t.loggerWithState().Warnf("source instance on node %s is %s, don't start the local instance", nodename, state)
+		t.doneAndIdle()
+		t.clearPending()
+	}
+}
+
+// movedSourceState returns the node and the monitor state of the first peer
+// instance engaged in the move of the current orchestration.
+func (t *Manager) movedSourceState() (string, instance.MonitorState, bool) {
+	nodes := make([]string, 0)
+	for nodename := range t.instMonitor {
+		nodes = append(nodes, nodename)
+	}
+	sort.Strings(nodes)
+	for _, nodename := range nodes {
+		instMonitor := t.instMonitor[nodename]
+		if nodename == t.localhost || instMonitor.OrchestrationID != t.state.OrchestrationID {
+			continue
+		}
+		switch instMonitor.State {
+		case instance.MonitorStateMoveCheckProgress,
+			instance.MonitorStateMoveCheckFailure,
+			instance.MonitorStateMoveCheckSuccess,
+			instance.MonitorStateMoveProgress,
+			instance.MonitorStateMoveFailure,
+			instance.MonitorStateMoveSuccess,
+			instance.MonitorStateMovePartialFailure:
+			return nodename, instMonitor.State, true
+		}
+	}
+	return "", instance.MonitorStateInit, false
+}
+
+// moveCheckDestination verifies, from the cluster data, the destination
+// node can host the live migrated instance: the node must be idle, not
+// frozen and not overloaded, and its instance must be down with the disk
+// and volume resources provisioned.
+func (t *Manager) moveCheckDestination(dst string) error {
+	if nodeMonitor, ok := t.nodeMonitor[dst]; !ok {
+		return fmt.Errorf("node %s monitor is not known", dst)
+	} else if nodeMonitor.State != node.MonitorStateIdle {
+		return fmt.Errorf("node %s monitor state is %s", dst, nodeMonitor.State)
+	}
+	if nodeStatus, ok := t.nodeStatus[dst]; !ok {
+		return fmt.Errorf("node %s status is not known", dst)
+	} else if nodeStatus.IsFrozen() {
+		return fmt.Errorf("node %s is frozen", dst)
+	} else if nodeStatus.IsOverloaded {
+		return fmt.Errorf("node %s is overloaded", dst)
+	}
+	dstStatus, ok := t.instStatus[dst]
+	if !ok {
+		return fmt.Errorf("instance status on node %s is not known", dst)
+	}
+	switch dstStatus.Avail {
+	case status.Down, status.StandbyDown, status.StandbyUp:
+	default:
+		return fmt.Errorf("instance on node %s is %s", dst, dstStatus.Avail)
+	}
+	rids := make([]string, 0)
+	for rid := range t.instStatus[t.localhost].Resources {
+		rids = append(rids, rid)
+	}
+	sort.Strings(rids)
+	for _, rid := range rids {
+		if t.instStatus[t.localhost].Resources[rid].IsDisabled {
+			continue
+		}
+		resourceID, err := resourceid.Parse(rid)
+		if err != nil {
+			continue
+		}
+		if !slices.Contains(moveCheckDriverGroups, resourceID.DriverGroup()) {
+			continue
+		}
+		resourceStatus, ok := dstStatus.Resources[rid]
+		switch {
+		case !ok:
+			return fmt.Errorf("resource %s is not configured on node %s", rid, dst)
+		case resourceStatus.IsProvisioned.State == provisioned.False:
+			return fmt.Errorf("resource %s is not provisioned on node %s", rid, dst)
+		case resourceStatus.Status == status.Undef:
+			return fmt.Errorf("resource %s status is undef on node %s", rid, dst)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package imon
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/node"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/provisioned"
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/core/topology"
+	"github.com/opensvc/om3/v3/testhelper"
+)
+
+func newMovedTestManager() *Manager {
+	m := &Manager{
+		localhost: "node1",
+		nodeMonitor: map[string]node.Monitor{
+			"node2": {State: node.MonitorStateIdle},
+		},
+		nodeStatus: map[string]node.Status{
+			"node2": {},
+		},
+		instStatus: map[string]instance.Status{
+			"node1": {
+				Avail: status.Up,
+				Resources: map[string]resource.Status{
+					"container#1": {Status: status.Up},
+					"disk#1":      {Status: status.Up},
+					"ip#1":        {Status: status.Up},
+				},
+			},
+			"node2": {
+				Avail: status.Down,
+				Resources: map[string]resource.Status{
+					"container#1": {Status: status.Down},
+					"disk#1":      {Status: status.Down, IsProvisioned: resource.ProvisionStatus{State: provisioned.True}},
+					"ip#1":        {Status: status.Down},
+				},
+			},
+		},
+	}
+	m.instConfig.ActorConfig = &instance.ActorConfig{Topology: topology.Failover}
+	return m
+}
+
+func TestMoveCheckDestination(t *testing.T) {
+	cases := map[string]struct {
+		setup func(*Manager)
+		ok    bool
+	}{
+		"ready": {
+			ok: true,
+		},
+		"node not idle": {
+			setup: func(m *Manager) {
+				m.nodeMonitor["node2"] = node.Monitor{State: node.MonitorStateDrainProgress}
+			},
+		},
+		"node frozen": {
+			setup: func(m *Manager) {
+				m.nodeStatus["node2"] = node.Status{FrozenAt: time.Now()}
+			},
+		},
+		"node overloaded": {
+			setup: func(m *Manager) {
+				m.nodeStatus["node2"] = node.Status{IsOverloaded: true}
+			},
+		},
+		"instance up": {
+			setup: func(m *Manager) {
+				instStatus := m.instStatus["node2"]
+				instStatus.Avail = status.Up
+				m.instStatus["node2"] = instStatus
+			},
+		},
+		"disk not provisioned": {
+			setup: func(m *Manager) {
+				m.instStatus["node2"].Resources["disk#1"] = resource.Status{IsProvisioned: resource.ProvisionStatus{State: provisioned.False}}
+			},
+		},
+		"disk not configured": {
+			setup: func(m *Manager) {
+				delete(m.instStatus["node2"].Resources, "disk#1")
+			},
+		},
+		"disabled disk not configured": {
+			setup: func(m *Manager) {
+				m.instStatus["node1"].Resources["disk#1"] = resource.Status{IsDisabled: true}
+				delete(m.instStatus["node2"].Resources, "disk#1")
+			},
+			ok: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := newMovedTestManager()
+			if tc.setup != nil {
+				tc.setup(m)
+			}
+			err := m.moveCheckDestination("node2")
+			if tc.ok {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckMoved(t *testing.T) {
+	m := newMovedTestManager()
+	assert.NoError(t, m.checkMoved(instance.MonitorGlobalExpectOptionsMoved{Destination: "node2"}))
+	assert.Error(t, m.checkMoved(instance.MonitorGlobalExpectOptionsMoved{}))
+	assert.Error(t, m.checkMoved(instance.MonitorGlobalExpectOptionsMoved{Destination: "node3"}))
+	assert.Error(t, m.checkMoved(instance.MonitorGlobalExpectOptionsMoved{Destination: "node1"}), "no running instance but the destination")
+
+	m.instConfig.ActorConfig.Topology = topology.Flex
+	assert.Error(t, m.checkMoved(instance.MonitorGlobalExpectOptionsMoved{Destination: "node2"}))
+}
+
+func TestMovedSourceState(t *testing.T) {
+	orchestrationID := uuid.New()
+	cases := map[string]struct {
+		state  instance.MonitorState
+		ok     bool
+		moved  bool
+		failed bool
+	}{
+		"moving": {
+			state: instance.MonitorStateMoveProgress,
+			ok:    true,
+		},
+		"moved": {
+			state: instance.MonitorStateMoveSuccess,
+			ok:    true,
+			moved: true,
+		},
+		"move partially failed": {
+			state: instance.MonitorStateMovePartialFailure,
+			ok:    true,
+			moved: true,
+		},
+		"move failed": {
+			state:  instance.MonitorStateMoveFailure,
+			ok:     true,
+			failed: true,
+		},
+		"move check failed": {
+			state:  instance.MonitorStateMoveCheckFailure,
+			ok:     true,
+			failed: true,
+		},
+		"idle": {
+			state: instance.MonitorStateIdle,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := newMovedTestManager()
+			m.state.OrchestrationID = orchestrationID
+			m.instMonitor = map[string]instance.Monitor{
+				"node2": {State: tc.state, OrchestrationID: orchestrationID},
+			}
+			nodename, state, ok := m.movedSourceState()
+			assert.Equal(t, tc.ok, ok)
+			if !tc.ok {
+				return
+			}
+			assert.Equal(t, "node2", nodename)
+			assert.Equal(t, tc.moved, state.IsOneOf(movedSourceMovedStates...))
+			assert.Equal(t, tc.failed, state.IsOneOf(movedSourceFailedStates...))
+		})
+	}
+}
+
+func TestIsMovedTo(t *testing.T) {
+	testhelper.Setup(t)
+	p := naming.Path{Name: "svc1", Namespace: "root", Kind: naming.KindSvc}
+	assert.False(t, object.IsMovedTo(p, "node2"), "no moved file")
+
+	require.NoError(t, os.MkdirAll(p.VarDir(), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(p.VarDir(), "moved"), []byte("node2"), 0644))
+	assert.True(t, object.IsMovedTo(p, "node2"))
+	assert.False(t, object.IsMovedTo(p, "node3"))
+}
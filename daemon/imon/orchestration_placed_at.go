@@ -8,6 +8,10 @@ import (
 )
 
 func (t *Manager) orchestrateFailoverPlacedStart() {
+	if t.getPlacedAtBlueGreen() {
+		t.orchestrateBlueGreenPlacedStart()
+		return
+	}
 	switch t.state.State {
 	case instance.MonitorStateIdle:
 		t.placedUnfreeze()
@@ -63,6 +67,10 @@ func (t *Manager) orchestrateFlexPlacedStart() {
 }
 
 func (t *Manager) orchestrateFailoverPlacedStop() {
+	if t.getPlacedAtBlueGreen() {
+		t.orchestrateBlueGreenPlacedStop()
+		return
+	}
 	switch t.state.State {
 	case instance.MonitorStateIdle:
 		t.placedUnfreeze()
@@ -0,0 +1,370 @@
+package imon
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/resourceid"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/core/topology"
+	"github.com/opensvc/om3/v3/util/command"
+)
+
+// A blue/green switch moves a failover instance to the destination node
+// with a shorter downtime than the default switch:
+//
+//	destination: prewarm       start the switch_prewarm resources, run switch_verify
+//	source:      stop          once the destination is prewarmed
+//	destination: start         once the source is down
+//
+// If the destination fails to prewarm, the source instance is not stopped.
+// If the destination fails to start, it is stopped and the source instance
+// is restarted. These rollback actions use the revert states.
+
+var (
+	// blueGreenExclusiveGroups are the driver groups that can't run on the
+	// source and the destination nodes at the same time, so can't be
+	// prewarmed.
+	blueGreenExclusiveGroups = []driver.Group{driver.GroupIP, driver.GroupApp, driver.GroupDisk}
+
+	blueGreenDestinationFailedStates = []instance.MonitorState{
+		instance.MonitorStatePrewarmFailure,
+		instance.MonitorStateStartFailure,
+		instance.MonitorStateRevertProgress,
+		instance.MonitorStateRevertSuccess,
+		instance.MonitorStateRevertFailure,
+	}
+)
+
+func (t *Manager) getPlacedAtBlueGreen() bool {
+	options, ok := t.state.GlobalExpectOptions.(instance.MonitorGlobalExpectOptionsPlacedAt)
+	if !ok {
+		return false
+	}
+	return options.BlueGreen
+}
+
+// checkBlueGreen returns an error if the placed@ options can't be applied
+// to the object.
+func (t *Manager) checkBlueGreen(options instance.MonitorGlobalExpectOptionsPlacedAt) error {
+	if !options.BlueGreen {
+		return nil
+	}
+	if options.Live {
+		return fmt.Errorf("blue/green switch is not possible with live migration")
+	}
+	if t.instConfig.ActorConfig == nil || t.instConfig.Topology != topology.Failover {
+		return fmt.Errorf("blue/green switch is only possible with the failover topology")
+	}
+	if rids := t.blueGreenPrewarmExclusiveRIDs(); len(rids) > 0 {
+		return fmt.Errorf("blue/green switch is not possible with exclusive resources in switch_prewarm: %s", strings.Join(rids, ", "))
+	}
+	return nil
+}
+
+func (t *Manager) orchestrateBlueGreenPlacedStart() {
+	switch t.state.State {
+	case instance.MonitorStateIdle:
+		t.placedUnfreeze()
+	case instance.MonitorStateUnfreezeSuccess:
+		t.blueGreenStartFromUnfrozen()
+	case instance.MonitorStatePrewarmSuccess:
+		t.blueGreenStartFromPrewarmed()
+	case instance.MonitorStatePrewarmFailure:
+		t.doBlueGreenDestinationRevert()
+	case instance.MonitorStateStartSuccess:
+		t.orchestrateFailoverPlacedStartFromStarted()
+	case instance.MonitorStateStartFailure:
+		t.doBlueGreenDestinationRevert()
+	case instance.MonitorStateRevertSuccess:
+		t.blueGreenFromReverted()
+	case instance.MonitorStateRevertFailure:
+		t.blueGreenFromRevertFailure()
+	case instance.MonitorStateUnfreezeProgress:
+	case instance.MonitorStatePrewarmProgress:
+	case instance.MonitorStateStartProgress:
+	case instance.MonitorStateRevertProgress:
+	case instance.MonitorStateWaitParents:
+		t.orchestratePlacedAtSetWaitParents()
+	default:
+		t.log.Errorf("don't know how to orchestrate blue/green placed start from %s", t.state.State)
+	}
+}
+
+func (t *Manager) orchestrateBlueGreenPlacedStop() {
+	switch t.state.State {
+	case instance.MonitorStateIdle:
+		t.placedUnfreeze()
+	case instance.MonitorStateUnfreezeSuccess:
+		t.blueGreenStopFromUnfrozen()
+	case instance.MonitorStateStopSuccess:
+		t.blueGreenStopFromStopped()
+	case instance.MonitorStateStopFailure:
+		t.orchestratePlacedStopFromStopFailure()
+	case instance.MonitorStateRevertSuccess:
+		t.loggerWithState().Infof("instance restarted after the destination failure")
+		t.doneAndIdle()
+		t.clearPending()
+	case instance.MonitorStateRevertFailure:
+		t.blueGreenFromRevertFailure()
+	case instance.MonitorStateUnfreezeProgress:
+	case instance.MonitorStateStopProgress:
+	case instance.MonitorStateRevertProgress:
+	case instance.MonitorStateWaitChildren:
+		t.setWaitChildren()
+	default:
+		t.log.Errorf("don't know how to orchestrate blue/green placed stop from %s", t.state.State)
+	}
+}
+
+func (t *Manager) blueGreenStartFromUnfrozen() {
+	if t.instStatus[t.localhost].Avail == status.Up {
+		t.transitionTo(instance.MonitorStateStartSuccess)
+		return
+	}
+	if t.orchestratePlacedAtSetWaitParents() {
+		return
+	}
+	t.queueAction(t.crmPrewarm, instance.MonitorStatePrewarmProgress, instance.MonitorStatePrewarmSuccess, instance.MonitorStatePrewarmFailure)
+}
+
+// blueGreenStartFromPrewarmed probes the prewarmed resources, and starts
+// the local instance when the source instances are down.
+func (t *Manager) blueGreenStartFromPrewarmed() {
+	instStatus := t.instStatus[t.localhost]
+	if instStatus.UpdatedAt.Before(t.state.StateUpdatedAt) {
+		// wait for a status evaluated after the prewarm
+		t.requestStatusRefresh(t.instConfig.Priority)
+		return
+	}
+	if notUp := t.blueGreenPrewarmNotUp(instStatus); len(notUp) > 0 {
+		t.loggerWithState().Warnf("prewarmed resources are not up: %s", strings.Join(notUp, ", "))
+		t.doBlueGreenDestinationRevert()
+		return
+	}
+	if node, ok := t.blueGreenSourceFailed(); ok {
+		t.loggerWithState().Warnf("source instance on node %s failed to stop", node)
+		t.doBlueGreenDestinationRevert()
+		return
+	}
+	if !t.blueGreenSourceIsDown() {
+		return
+	}
+	t.doPlacedStart()
+}
+
+func (t *Manager) blueGreenStopFromUnfrozen() {
+	switch t.instStatus[t.localhost].Avail {
+	case status.Up, status.Warn:
+	default:
+		t.loggerWithState().Infof("instance is already down")
+		t.doneAndIdle()
+		t.clearPending()
+		return
+	}
+	state, ok := t.blueGreenDestinationState()
+	switch {
+	case !ok:
+	case state == instance.MonitorStatePrewarmSuccess:
+		t.placedStop()
+	case state.IsOneOf(blueGreenDestinationFailedStates...):
+		t.loggerWithState().Warnf("destination instance is %s, keep the local instance running", state)
+		t.doneAndIdle()
+		t.clearPending()
+	}
+}
+
+func (t *Manager) blueGreenStopFromStopped() {
+	state, ok := t.blueGreenDestinationState()
+	switch {
+	case !ok:
+	case state == instance.MonitorStateStartSuccess:
+		t.clearStopped()
+	case state == instance.MonitorStateRevertSuccess:
+		t.loggerWithState().Warnf("destination instance is %s, restart the local instance", state)
+		t.queueAction(t.crmStart, instance.MonitorStateRevertProgress, instance.MonitorStateRevertSuccess, instance.MonitorStateRevertFailure)
+	case state == instance.MonitorStateRevertFailure:
+		t.loggerWithState().Warnf("destination instance is %s, don't restart the local instance", state)
+		t.doneAndIdle()
+		t.clearPending()
+	}
+}
+
+// doBlueGreenDestinationRevert stops the partially started local instance,
+// so the source instance can be restarted.
+func (t *Manager) doBlueGreenDestinationRevert() {
+	t.createPendingWithDuration(stopDuration)
+	t.disableMonitor("orchestrate blue/green switch revert")
+	t.queueAction(t.crmStop, instance.MonitorStateRevertProgress, instance.MonitorStateRevertSuccess, instance.MonitorStateRevertFailure)
+}
+
+// blueGreenFromReverted ends the destination orchestration when the source
+// instances are done reacting to the revert.
+func (t *Manager) blueGreenFromReverted() {
+	if !t.orchestrationIsDoneOnPeers() {
+		return
+	}
+	t.doneAndIdle()
+	t.clearPending()
+}
+
+func (t *Manager) blueGreenFromRevertFailure() {
+	t.loggerWithState().Warnf("orchestration %s revert failed, set done", t.state.GlobalExpect)
+	t.done()
+	t.clearPending()
+}
+
+// blueGreenDestinationState returns the monitor state of the destination
+// instance.
+func (t *Manager) blueGreenDestinationState() (instance.MonitorState, bool) {
+	dst, ok := t.getPlacedAtDestination()
+	if !ok || len(dst) != 1 {
+		return instance.MonitorStateInit, false
+	}
+	instMonitor, ok := t.GetInstanceMonitor(dst[0])
+	if !ok || instMonitor.OrchestrationID != t.state.OrchestrationID {
+		return instance.MonitorStateInit, false
+	}
+	return instMonitor.State, true
+}
+
+// blueGreenSourceFailed returns the first peer node whose instance failed to
+// stop in the current orchestration.
+func (t *Manager) blueGreenSourceFailed() (string, bool) {
+	for node, instMonitor := range t.instMonitor {
+		if instMonitor.OrchestrationID != t.state.OrchestrationID {
+			continue
+		}
+		if instMonitor.State == instance.MonitorStateStopFailure {
+			return node, true
+		}
+	}
+	return "", false
+}
+
+// blueGreenSourceIsDown returns true when no peer instance is up or
+// stopping.
+func (t *Manager) blueGreenSourceIsDown() bool {
+	for node, instStatus := range t.instStatus {
+		if node == t.localhost {
+			continue
+		}
+		switch instStatus.Avail {
+		case status.Up, status.Warn:
+			return false
+		}
+		if instMonitor, ok := t.instMonitor[node]; ok && instMonitor.State == instance.MonitorStateStopProgress {
+			return false
+		}
+	}
+	return true
+}
+
+// blueGreenPrewarmRIDs returns the sorted ids of the resources to start
+// before the source instance stops: the resources selected by switch_prewarm,
+// or the standby resources if switch_prewarm is not set.
+func (t *Manager) blueGreenPrewarmRIDs() []string {
+	rids := make([]string, 0)
+	for rid, resourceConfig := range t.instConfig.Resources {
+		if len(t.instConfig.SwitchPrewarm) > 0 {
+			if slices.ContainsFunc(t.instConfig.SwitchPrewarm, func(s string) bool { return resourceid.Match(rid, s) }) {
+				rids = append(rids, rid)
+			}
+		} else if resourceConfig.IsStandby {
+			rids = append(rids, rid)
+		}
+	}
+	sort.Strings(rids)
+	return rids
+}
+
+// blueGreenPrewarmExclusiveRIDs returns the sorted ids of the resources
+// selected by switch_prewarm in a driver group that can't be prewarmed.
+func (t *Manager) blueGreenPrewarmExclusiveRIDs() []string {
+	rids := make([]string, 0)
+	if len(t.instConfig.SwitchPrewarm) == 0 {
+		return rids
+	}
+	for _, rid := range t.blueGreenPrewarmRIDs() {
+		resourceID, err := resourceid.Parse(rid)
+		if err != nil {
+			continue
+		}
+		if slices.Contains(blueGreenExclusiveGroups, resourceID.DriverGroup()) {
+			rids = append(rids, rid)
+		}
+	}
+	return rids
+}
+
+// blueGreenPrewarmNotUp returns the ids of the prewarmed resources that are
+// not up in the instance status.
+func (t *Manager) blueGreenPrewarmNotUp(instStatus instance.Status) []string {
+	l := make([]string, 0)
+	for _, rid := range t.blueGreenPrewarmRIDs() {
+		resourceStatus, ok := instStatus.Resources[rid]
+		if !ok {
+			continue
+		}
+		switch resourceStatus.Status {
+		case status.Up, status.StandbyUp, status.NotApplicable:
+		default:
+			l = append(l, rid)
+		}
+	}
+	return l
+}
+
+// crmPrewarm starts the prewarm resources and executes the user-defined
+// verification command.
+func (t *Manager) crmPrewarm() error {
+	if rids := t.blueGreenPrewarmRIDs(); len(rids) > 0 {
+		if err := t.crmAction("prewarm", t.path.String(), "instance", "start", "--rid", strings.Join(rids, ",")); err != nil {
+			return err
+		}
+	}
+	return t.doSwitchVerify()
+}
+
+// doSwitchVerify executes the user-defined command verifying the prewarmed
+// instance.
+func (t *Manager) doSwitchVerify() error {
+	if t.instConfig.SwitchVerify == "" {
+		return nil
+	}
+	t.log.Infof("execute switch verify: %s", t.instConfig.SwitchVerify)
+	cmdArgs, err := command.CmdArgsFromString(t.instConfig.SwitchVerify)
+	if err != nil {
+		return err
+	}
+	if len(cmdArgs) == 0 {
+		return nil
+	}
+	timeout := t.instConfig.SwitchVerifyTimeout
+	if timeout == 0 {
+		timeout = time.Minute
+	}
+	cmd := command.New(
+		command.WithName(cmdArgs[0]),
+		command.WithVarArgs(cmdArgs[1:]...),
+		command.WithLogger(t.log),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+		command.WithTimeout(timeout),
+		command.WithVarEnv(
+			"OPENSVC_SVCPATH="+t.path.String(),
+			"OPENSVC_SVCNAME="+t.path.Name,
+		),
+	)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("switch verify: %w", err)
+	}
+	return nil
+}
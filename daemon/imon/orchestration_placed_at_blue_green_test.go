@@ -0,0 +1,73 @@
+package imon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/topology"
+)
+
+func TestBlueGreenPrewarmRIDs(t *testing.T) {
+	resources := instance.ResourceConfigs{
+		"app#1":       {},
+		"container#1": {},
+		"container#2": {},
+		"disk#1":      {},
+		"fs#1":        {},
+		"fs#2":        {IsStandby: true},
+		"ip#1":        {},
+	}
+	cases := map[string]struct {
+		prewarm  []string
+		expected []string
+	}{
+		"default": {
+			expected: []string{"fs#2"},
+		},
+		"driver group": {
+			prewarm:  []string{"fs"},
+			expected: []string{"fs#1", "fs#2"},
+		},
+		"resource id and driver group": {
+			prewarm:  []string{"container#2", "fs"},
+			expected: []string{"container#2", "fs#1", "fs#2"},
+		},
+		"no match": {
+			prewarm:  []string{"sync"},
+			expected: []string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &Manager{}
+			m.instConfig.ActorConfig = &instance.ActorConfig{
+				Resources:     resources,
+				SwitchPrewarm: tc.prewarm,
+			}
+			assert.Equal(t, tc.expected, m.blueGreenPrewarmRIDs())
+		})
+	}
+}
+
+func TestCheckBlueGreen(t *testing.T) {
+	m := &Manager{}
+	m.instConfig.ActorConfig = &instance.ActorConfig{Topology: topology.Failover}
+	assert.NoError(t, m.checkBlueGreen(instance.MonitorGlobalExpectOptionsPlacedAt{}))
+	assert.NoError(t, m.checkBlueGreen(instance.MonitorGlobalExpectOptionsPlacedAt{BlueGreen: true}))
+	assert.Error(t, m.checkBlueGreen(instance.MonitorGlobalExpectOptionsPlacedAt{BlueGreen: true, Live: true}))
+
+	m.instConfig.ActorConfig.Resources = instance.ResourceConfigs{
+		"disk#1": {},
+		"fs#1":   {},
+	}
+	m.instConfig.ActorConfig.SwitchPrewarm = []string{"fs"}
+	assert.NoError(t, m.checkBlueGreen(instance.MonitorGlobalExpectOptionsPlacedAt{BlueGreen: true}))
+	m.instConfig.ActorConfig.SwitchPrewarm = []string{"fs", "disk#1"}
+	assert.Error(t, m.checkBlueGreen(instance.MonitorGlobalExpectOptionsPlacedAt{BlueGreen: true}))
+
+	m.instConfig.ActorConfig.SwitchPrewarm = nil
+	m.instConfig.ActorConfig.Topology = topology.Flex
+	assert.Error(t, m.checkBlueGreen(instance.MonitorGlobalExpectOptionsPlacedAt{BlueGreen: true}))
+}
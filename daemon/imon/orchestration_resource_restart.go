@@ -228,6 +228,13 @@ func (t *Manager) orchestrateResourceRestart() {
 		return
 	}
 
+	// ignore if a maintenance window blocks the orchestrations
+	if t.isMaintenanceBlocked() {
+		t.log.Tracef("skip restart: maintenance window is open")
+		t.cancelResourceOrchestrateSchedules()
+		return
+	}
+
 	// ignore if the instance is not provisioned
 	if instanceStatus := t.instStatus[t.localhost]; instanceStatus.Provisioned.IsOneOf(provisioned.False, provisioned.Mixed, provisioned.Undef) {
 		t.log.Tracef("skip restart: provisioned is %s", instanceStatus.Provisioned)
@@ -0,0 +1,82 @@
+package imon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+func TestRemoteClusterRelations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bus := pubsub.NewBus(t.Name())
+	bus.Start(ctx)
+	defer bus.Stop()
+
+	sub := bus.Sub(t.Name())
+	m := &Manager{
+		path:              naming.Path{Namespace: "root", Kind: naming.KindSvc, Name: "s1"},
+		localhost:         "node1",
+		log:               plog.NewLogger(zerolog.Nop()),
+		publisher:         nopPublisher{},
+		sub:               sub,
+		remoteUnreachable: make(map[string]bool),
+		delayTimer:        time.NewTimer(time.Hour),
+	}
+	m.state.Parents = make(map[string]status.T)
+	m.state.Children = make(map[string]status.T)
+	p1 := naming.Path{Namespace: "root", Kind: naming.KindSvc, Name: "p1"}
+	c1 := naming.Path{Namespace: "root", Kind: naming.KindSvc, Name: "c1"}
+	m.initRemoteRelation("test", "p1@cluster:cl1", p1, "Parent", m.state.Parents)
+	m.initRemoteRelation("test", "c1@cluster:cl2", c1, "Child", m.state.Children)
+	sub.Start()
+	defer func() { _ = sub.Stop() }()
+	require.Equal(t, map[string]bool{"cl1": true, "cl2": true}, m.remoteUnreachable, "remote clusters are unreachable until connected")
+
+	t.Run("keeps the clusters still referenced", func(t *testing.T) {
+		m.janitorRemoteClusters()
+		require.Equal(t, map[string]bool{"cl1": true, "cl2": true}, m.remoteUnreachable)
+	})
+
+	t.Run("unsubscribes from a cluster no longer referenced", func(t *testing.T) {
+		delete(m.state.Children, "c1@cluster:cl2")
+		m.janitorRemoteClusters()
+		require.Equal(t, map[string]bool{"cl1": true}, m.remoteUnreachable)
+
+		bus.Pub(&msgbus.RemoteClusterReachable{Cluster: "cl2"}, pubsub.Label{"cluster", "cl2"})
+		bus.Pub(&msgbus.RemoteClusterReachable{Cluster: "cl1"}, pubsub.Label{"cluster", "cl1"})
+		select {
+		case i := <-sub.C:
+			require.Equal(t, "cl1", i.(*msgbus.RemoteClusterReachable).Cluster)
+		case <-time.After(time.Second):
+			require.Fail(t, "no message received")
+		}
+	})
+
+	t.Run("ignores the reachability of a cluster no longer referenced", func(t *testing.T) {
+		m.onRemoteClusterUnreachable(&msgbus.RemoteClusterUnreachable{Cluster: "cl2"})
+		m.onRemoteClusterReachable(&msgbus.RemoteClusterReachable{Cluster: "cl2"})
+		require.Equal(t, map[string]bool{"cl1": true}, m.remoteUnreachable)
+		require.False(t, m.change)
+	})
+
+	t.Run("resets the relations of an unreachable cluster", func(t *testing.T) {
+		m.onRemoteClusterReachable(&msgbus.RemoteClusterReachable{Cluster: "cl1"})
+		require.False(t, m.remoteUnreachable["cl1"])
+		require.True(t, m.change)
+
+		m.state.Parents["p1@cluster:cl1"] = status.Up
+		m.onRemoteClusterUnreachable(&msgbus.RemoteClusterUnreachable{Cluster: "cl1"})
+		require.True(t, m.remoteUnreachable["cl1"])
+		require.Equal(t, status.Undef, m.state.Parents["p1@cluster:cl1"])
+	})
+}
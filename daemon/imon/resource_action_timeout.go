@@ -0,0 +1,49 @@
+package imon
+
+import (
+	"path/filepath"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/resource"
+)
+
+// updateResourceActionTimeouts sets the last action timeout of the local
+// resources in the instance monitor, with the diagnostics of the
+// terminated processes, so they are published in the instance monitor
+// events.
+func (t *Manager) updateResourceActionTimeouts(instStatus instance.Status) {
+	for rid, resourceStatus := range instStatus.Resources {
+		rmon := t.state.Resources.Get(rid)
+		if rmon == nil {
+			rmon = &instance.ResourceMonitor{}
+		}
+		switch {
+		case resourceStatus.ActionTimeout == nil && rmon.ActionTimeout == nil:
+			continue
+		case resourceStatus.ActionTimeout == nil:
+			rmon.ActionTimeout = nil
+		case rmon.ActionTimeout != nil && rmon.ActionTimeout.At.Equal(resourceStatus.ActionTimeout.At):
+			continue
+		default:
+			actionTimeout, err := resource.LoadActionTimeout(filepath.Join(t.path.VarDir(), rid))
+			if err != nil {
+				t.log.Warnf("%s: load the last action timeout diagnostics: %s", rid, err)
+			}
+			if actionTimeout == nil {
+				v := *resourceStatus.ActionTimeout
+				actionTimeout = &v
+			}
+			if actionTimeout.Abandoned {
+				t.log.Errorf("%s: %s, the resource needs attention", rid, actionTimeout)
+			} else {
+				t.log.Warnf("%s: %s", rid, actionTimeout)
+			}
+			rmon.ActionTimeout = actionTimeout
+		}
+		if t.state.Resources == nil {
+			t.state.Resources = make(instance.ResourceMonitors)
+		}
+		t.state.Resources.Set(rid, *rmon)
+		t.change = true
+	}
+}
@@ -0,0 +1,74 @@
+package imon
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/testhelper"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+func TestUpdateResourceActionTimeouts(t *testing.T) {
+	testhelper.Setup(t)
+	p := naming.Path{Name: "svc1", Namespace: "root", Kind: naming.KindSvc}
+	m := &Manager{
+		path:      p,
+		localhost: "node1",
+		log:       plog.NewLogger(zerolog.Nop()),
+		state: instance.Monitor{
+			Resources: instance.ResourceMonitors{
+				"app#1": {Restart: &instance.ResourceMonitorRestart{Remaining: 1}},
+			},
+		},
+	}
+	at := time.Now().Truncate(time.Second)
+	saved := resource.ActionTimeout{
+		Action:      "stop",
+		At:          at,
+		Timeout:     time.Minute,
+		Abandoned:   true,
+		Diagnostics: "pid 42 state D",
+	}
+	b, err := json.Marshal(saved)
+	require.NoError(t, err)
+	varDir := filepath.Join(p.VarDir(), "app#1")
+	require.NoError(t, os.MkdirAll(varDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(varDir, "timeout.json"), b, 0644))
+
+	reported := saved
+	reported.Diagnostics = ""
+	instStatus := instance.Status{
+		Resources: instance.ResourceStatuses{
+			"app#1": {ActionTimeout: &reported},
+			"app#2": {},
+		},
+	}
+
+	m.updateResourceActionTimeouts(instStatus)
+	require.True(t, m.change)
+	rmon := m.state.Resources.Get("app#1")
+	require.NotNil(t, rmon)
+	require.NotNil(t, rmon.ActionTimeout)
+	require.Equal(t, "pid 42 state D", rmon.ActionTimeout.Diagnostics, "the monitor has the diagnostics")
+	require.True(t, rmon.ActionTimeout.Abandoned)
+	require.Equal(t, 1, rmon.Restart.Remaining, "the restart state is kept")
+	require.Nil(t, m.state.Resources.Get("app#2"))
+
+	m.change = false
+	m.updateResourceActionTimeouts(instStatus)
+	require.False(t, m.change, "same timeout")
+
+	instStatus.Resources["app#1"] = resource.Status{}
+	m.updateResourceActionTimeouts(instStatus)
+	require.True(t, m.change)
+	require.Nil(t, m.state.Resources.Get("app#1").ActionTimeout, "cleared by a successful action")
+}
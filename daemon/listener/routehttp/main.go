@@ -70,6 +70,7 @@ func New(ctx context.Context, enableUI bool) *T {
 	})
 
 	e.Use(daemonapi.LogMiddleware(ctx))
+	e.Use(daemonapi.AuditMiddleware(ctx))
 	e.Use(daemonapi.AuthMiddleware(ctx))
 	e.Use(daemonapi.RateLimiterWithConfig(ctx))
 	e.Use(daemonapi.LogUserMiddleware(ctx))
@@ -0,0 +1,155 @@
+package msgbus
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+type (
+	// Journal is a bounded in-memory ring of the last bus publications,
+	// indexed by their bus sequence number. It implements pubsub.Recorder.
+	//
+	// It allows the event stream clients to resume after a disconnection
+	// without missing the events published in between, as long as these
+	// events are not yet evicted.
+	Journal struct {
+		sync.RWMutex
+		entries []JournalEntry
+
+		// head is the index in entries of the oldest entry
+		head int
+
+		// count is the number of entries in use
+		count int
+
+		// ids is the id of the journaled messages, indexed by message
+		// pointer. The Record call precedes the message delivery to the
+		// subscribers, so they can lookup the id of a received message.
+		ids map[any]uint64
+	}
+
+	// JournalEntry is a journaled publication.
+	JournalEntry struct {
+		ID   uint64
+		Data any
+	}
+
+	contextKey int
+)
+
+const (
+	// DefaultJournalSize is the number of publications retained by the
+	// daemon event journal.
+	DefaultJournalSize = 10000
+
+	contextJournal contextKey = 0
+)
+
+// NewJournal returns a Journal retaining the last size publications.
+func NewJournal(size int) *Journal {
+	if size <= 0 {
+		size = DefaultJournalSize
+	}
+	return &Journal{
+		entries: make([]JournalEntry, size),
+		ids:     make(map[any]uint64, size),
+	}
+}
+
+// Record adds a publication to the journal, evicting the oldest entry
+// when the journal is full.
+func (t *Journal) Record(id uint64, data any) {
+	t.Lock()
+	defer t.Unlock()
+	if isPointer(data) {
+		t.ids[data] = id
+	}
+	size := len(t.entries)
+	if t.count < size {
+		t.entries[(t.head+t.count)%size] = JournalEntry{ID: id, Data: data}
+		t.count++
+		return
+	}
+	evicted := t.entries[t.head]
+	if isPointer(evicted.Data) && t.ids[evicted.Data] == evicted.ID {
+		delete(t.ids, evicted.Data)
+	}
+	t.entries[t.head] = JournalEntry{ID: id, Data: data}
+	t.head = (t.head + 1) % size
+}
+
+// ID returns the id of the journaled message data, as received from a bus
+// subscription. The ok return value is false if data is not journaled.
+func (t *Journal) ID(data any) (id uint64, ok bool) {
+	if !isPointer(data) {
+		return 0, false
+	}
+	t.RLock()
+	defer t.RUnlock()
+	id, ok = t.ids[data]
+	return
+}
+
+// isPointer returns true if data can be used as a key of the message ids
+// map.
+func isPointer(data any) bool {
+	return data != nil && reflect.TypeOf(data).Kind() == reflect.Pointer
+}
+
+// Bounds returns the ids of the oldest and most recent journaled
+// publications, zeros if the journal is empty.
+func (t *Journal) Bounds() (first, last uint64) {
+	t.RLock()
+	defer t.RUnlock()
+	return t.bounds()
+}
+
+func (t *Journal) bounds() (first, last uint64) {
+	if t.count == 0 {
+		return 0, 0
+	}
+	size := len(t.entries)
+	return t.entries[t.head].ID, t.entries[(t.head+t.count-1)%size].ID
+}
+
+// Since returns the journaled publications with an id greater than id,
+// from the oldest to the most recent.
+//
+// The gap return value is true if some publications following id are
+// no longer in the journal, either evicted or lost on daemon restart
+// when id is greater than the last known id.
+func (t *Journal) Since(id uint64) (entries []JournalEntry, gap bool) {
+	t.RLock()
+	defer t.RUnlock()
+	first, last := t.bounds()
+	switch {
+	case id > last:
+		return nil, true
+	case t.count == 0:
+		return nil, false
+	case id+1 < first:
+		gap = true
+	}
+	size := len(t.entries)
+	for i := 0; i < t.count; i++ {
+		e := t.entries[(t.head+i)%size]
+		if e.ID > id {
+			entries = append(entries, e)
+		}
+	}
+	return entries, gap
+}
+
+// ContextWithJournal returns a copy of parent with the event journal j.
+func ContextWithJournal(parent context.Context, j *Journal) context.Context {
+	return context.WithValue(parent, contextJournal, j)
+}
+
+// JournalFromContext returns the event journal stored in ctx, or nil.
+func JournalFromContext(ctx context.Context) *Journal {
+	if j, ok := ctx.Value(contextJournal).(*Journal); ok {
+		return j
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package msgbus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+func TestJournal(t *testing.T) {
+	j := NewJournal(3)
+	entries, gap := j.Since(0)
+	require.Empty(t, entries)
+	require.False(t, gap, "empty journal resumed from 0")
+
+	for i := uint64(1); i <= 5; i++ {
+		j.Record(i, i)
+	}
+	first, last := j.Bounds()
+	require.Equal(t, uint64(3), first)
+	require.Equal(t, uint64(5), last)
+
+	entries, gap = j.Since(3)
+	require.False(t, gap)
+	require.Equal(t, []JournalEntry{{ID: 4, Data: uint64(4)}, {ID: 5, Data: uint64(5)}}, entries)
+
+	entries, gap = j.Since(2)
+	require.False(t, gap, "the event following 2 is still journaled")
+	require.Len(t, entries, 3)
+
+	entries, gap = j.Since(1)
+	require.True(t, gap, "the event 2 is evicted")
+	require.Len(t, entries, 3)
+
+	entries, gap = j.Since(10)
+	require.True(t, gap, "id 10 is unknown, the ids have been reset")
+	require.Empty(t, entries)
+}
+
+func TestJournalRecorder(t *testing.T) {
+	bus := pubsub.NewBus(t.Name())
+	j := NewJournal(10)
+	bus.SetRecorder(j)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bus.Start(ctx)
+	defer bus.Stop()
+
+	sub := bus.Sub(t.Name(), pubsub.Timeout(time.Second))
+	sub.AddFilter(&NodeAlive{})
+	sub.Start()
+	defer func() { _ = sub.Stop() }()
+
+	bus.Pub(&HeartbeatStale{}, pubsub.Label{"node", "node1"})
+	bus.Pub(&NodeAlive{}, pubsub.Label{"node", "node1"})
+
+	select {
+	case i := <-sub.C:
+		msg, ok := i.(*NodeAlive)
+		require.True(t, ok)
+		entries, gap := j.Since(0)
+		require.False(t, gap)
+		require.Len(t, entries, 2)
+		id, ok := j.ID(msg)
+		require.True(t, ok)
+		require.Equal(t, entries[1].ID, id)
+		require.Same(t, msg, entries[1].Data)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for NodeAlive")
+	}
+}
@@ -58,6 +58,7 @@ import (
 	"github.com/opensvc/om3/v3/core/event"
 	"github.com/opensvc/om3/v3/core/hbsecret"
 	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/maintenance"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/node"
 	"github.com/opensvc/om3/v3/core/object"
@@ -119,6 +120,8 @@ var (
 
 		"EnterOverloadPeriod": func() any { return &EnterOverloadPeriod{} },
 
+		"EventGap": func() any { return &EventGap{} },
+
 		"LeaveOverloadPeriod": func() any { return &LeaveOverloadPeriod{} },
 
 		"Exec": func() any { return &Exec{} },
@@ -157,6 +160,10 @@ var (
 
 		"InstanceFrozenFileUpdated": func() any { return &InstanceFrozenFileUpdated{} },
 
+		"InstanceMaintenanceWindowClosed": func() any { return &InstanceMaintenanceWindowClosed{} },
+
+		"InstanceMaintenanceWindowOpened": func() any { return &InstanceMaintenanceWindowOpened{} },
+
 		"InstanceMonitorAction": func() any { return &InstanceMonitorAction{} },
 
 		"InstanceMonitorDeleted": func() any { return &InstanceMonitorDeleted{} },
@@ -201,6 +208,10 @@ var (
 
 		"NodeFrozenFileUpdated": func() any { return &NodeFrozenFileUpdated{} },
 
+		"NodeMaintenanceWindowClosed": func() any { return &NodeMaintenanceWindowClosed{} },
+
+		"NodeMaintenanceWindowOpened": func() any { return &NodeMaintenanceWindowOpened{} },
+
 		"NodeMonitorDeleted": func() any { return &NodeMonitorDeleted{} },
 
 		"NodeMonitorUpdated": func() any { return &NodeMonitorUpdated{} },
@@ -247,8 +258,16 @@ var (
 
 		"NodeRejoin": func() any { return &NodeRejoin{} },
 
+		"RemoteClusterReachable": func() any { return &RemoteClusterReachable{} },
+
+		"RemoteClusterUnreachable": func() any { return &RemoteClusterUnreachable{} },
+
 		"RemoteFileConfig": func() any { return &RemoteFileConfig{} },
 
+		"RemoteObjectStatusDeleted": func() any { return &RemoteObjectStatusDeleted{} },
+
+		"RemoteObjectStatusUpdated": func() any { return &RemoteObjectStatusUpdated{} },
+
 		"RunFileRemoved": func() any { return &RunFileRemoved{} },
 
 		"RunFileUpdated": func() any { return &RunFileUpdated{} },
@@ -426,6 +445,20 @@ type (
 		pubsub.Msg `yaml:",inline"`
 	}
 
+	// EventGap is sent to an event stream resumed after an event id that
+	// has been evicted from the event journal. The events with an id
+	// between Since and First are lost, so the client must resynchronize
+	// its dataset, for example using a replay.
+	EventGap struct {
+		pubsub.Msg `yaml:",inline"`
+
+		// Since is the event id the stream was requested to resume after.
+		Since uint64 `json:"since" yaml:"since"`
+
+		// First is the id of the oldest event still in the journal.
+		First uint64 `json:"first" yaml:"first"`
+	}
+
 	// Exec message describes an exec call
 	Exec struct {
 		pubsub.Msg `yaml:",inline"`
@@ -580,6 +613,24 @@ type (
 		At         time.Time   `json:"at" yaml:"at"`
 	}
 
+	// InstanceMaintenanceWindowClosed is emitted by imon when the object
+	// maintenance window closes.
+	InstanceMaintenanceWindowClosed struct {
+		pubsub.Msg `yaml:",inline"`
+		Path       naming.Path        `json:"path" yaml:"path"`
+		Node       string             `json:"node" yaml:"node"`
+		Value      maintenance.Window `json:"maintenance_window" yaml:"maintenance_window"`
+	}
+
+	// InstanceMaintenanceWindowOpened is emitted by imon when the object
+	// maintenance window opens.
+	InstanceMaintenanceWindowOpened struct {
+		pubsub.Msg `yaml:",inline"`
+		Path       naming.Path        `json:"path" yaml:"path"`
+		Node       string             `json:"node" yaml:"node"`
+		Value      maintenance.Window `json:"maintenance_window" yaml:"maintenance_window"`
+	}
+
 	InstanceMonitorAction struct {
 		pubsub.Msg `yaml:",inline"`
 		Path       naming.Path            `json:"path" yaml:"path"`
@@ -734,6 +785,22 @@ type (
 		At         time.Time `json:"at" yaml:"at"`
 	}
 
+	// NodeMaintenanceWindowClosed is emitted by nmon when the node
+	// maintenance window closes.
+	NodeMaintenanceWindowClosed struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string             `json:"node" yaml:"node"`
+		Value      maintenance.Window `json:"maintenance_window" yaml:"maintenance_window"`
+	}
+
+	// NodeMaintenanceWindowOpened is emitted by nmon when the node
+	// maintenance window opens.
+	NodeMaintenanceWindowOpened struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string             `json:"node" yaml:"node"`
+		Value      maintenance.Window `json:"maintenance_window" yaml:"maintenance_window"`
+	}
+
 	NodeMonitorDeleted struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string `json:"node" yaml:"node"`
@@ -903,6 +970,41 @@ type (
 		Nodes          []string
 	}
 
+	// RemoteClusterReachable is emitted when the event stream of a remote
+	// cluster is connected.
+	RemoteClusterReachable struct {
+		pubsub.Msg `yaml:",inline"`
+		Cluster    string `json:"cluster" yaml:"cluster"`
+		URL        string `json:"url" yaml:"url"`
+	}
+
+	// RemoteClusterUnreachable is emitted when no url of a remote cluster
+	// serves its event stream. The remote objects statuses are no longer
+	// updated until a RemoteClusterReachable.
+	RemoteClusterUnreachable struct {
+		pubsub.Msg `yaml:",inline"`
+		Cluster    string `json:"cluster" yaml:"cluster"`
+		Error      string `json:"error" yaml:"error"`
+	}
+
+	// RemoteObjectStatusDeleted is emitted when an object of a remote
+	// cluster, watched for a path@cluster:<name> relation, is deleted.
+	RemoteObjectStatusDeleted struct {
+		pubsub.Msg `yaml:",inline"`
+		Cluster    string      `json:"cluster" yaml:"cluster"`
+		Path       naming.Path `json:"path" yaml:"path"`
+	}
+
+	// RemoteObjectStatusUpdated is emitted when the status of an object of
+	// a remote cluster, watched for a path@cluster:<name> relation, is
+	// updated.
+	RemoteObjectStatusUpdated struct {
+		pubsub.Msg `yaml:",inline"`
+		Cluster    string        `json:"cluster" yaml:"cluster"`
+		Path       naming.Path   `json:"path" yaml:"path"`
+		Value      object.Status `json:"object_status" yaml:"object_status"`
+	}
+
 	RemoteFileConfig struct {
 		pubsub.Msg `yaml:",inline"`
 		Path       naming.Path     `json:"path" yaml:"path"`
@@ -1156,6 +1258,10 @@ func (e *ExecSuccess) Kind() string {
 	return "ExecSuccess"
 }
 
+func (e *EventGap) Kind() string {
+	return "EventGap"
+}
+
 func (e *Exit) Kind() string {
 	return "Exit"
 }
@@ -1230,6 +1336,14 @@ func (e *InstanceFrozenFileUpdated) Kind() string {
 	return "InstanceFrozenFileUpdated"
 }
 
+func (e *InstanceMaintenanceWindowClosed) Kind() string {
+	return "InstanceMaintenanceWindowClosed"
+}
+
+func (e *InstanceMaintenanceWindowOpened) Kind() string {
+	return "InstanceMaintenanceWindowOpened"
+}
+
 func (e *InstanceMonitorAction) Kind() string {
 	return "InstanceMonitorAction"
 }
@@ -1346,6 +1460,14 @@ func (e *NodeFrozenFileUpdated) Kind() string {
 	return "NodeFrozenFileUpdated"
 }
 
+func (e *NodeMaintenanceWindowClosed) Kind() string {
+	return "NodeMaintenanceWindowClosed"
+}
+
+func (e *NodeMaintenanceWindowOpened) Kind() string {
+	return "NodeMaintenanceWindowOpened"
+}
+
 func (e *NodeMonitorDeleted) Kind() string {
 	return "NodeMonitorDeleted"
 }
@@ -1486,10 +1608,26 @@ func (e *NodeRejoin) Kind() string {
 	return "NodeRejoin"
 }
 
+func (e *RemoteClusterReachable) Kind() string {
+	return "RemoteClusterReachable"
+}
+
+func (e *RemoteClusterUnreachable) Kind() string {
+	return "RemoteClusterUnreachable"
+}
+
 func (e *RemoteFileConfig) Kind() string {
 	return "RemoteFileConfig"
 }
 
+func (e *RemoteObjectStatusDeleted) Kind() string {
+	return "RemoteObjectStatusDeleted"
+}
+
+func (e *RemoteObjectStatusUpdated) Kind() string {
+	return "RemoteObjectStatusUpdated"
+}
+
 func (e *RunFileRemoved) Kind() string {
 	return "RunFileRemoved"
 }
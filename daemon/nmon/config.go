@@ -4,6 +4,7 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/opensvc/om3/v3/core/maintenance"
 	"github.com/opensvc/om3/v3/core/node"
 	"github.com/opensvc/om3/v3/core/object"
 	"github.com/opensvc/om3/v3/util/key"
@@ -11,7 +12,10 @@ import (
 
 func (t *Manager) getNodeConfig() node.Config {
 	var (
+		keyConfigHistoryMax       = key.New("node", "config_history_max")
 		keyMaintenanceGracePeriod = key.New("node", "maintenance_grace_period")
+		keyMaintenanceMode        = key.New("node", "maintenance_mode")
+		keyMaintenanceWindow      = key.New("node", "maintenance_window")
 		keyMaxParallel            = key.New("node", "max_parallel")
 		keyMaxKeySize             = key.New("node", "max_key_size")
 		keyReadyPeriod            = key.New("node", "ready_period")
@@ -40,8 +44,16 @@ func (t *Manager) getNodeConfig() node.Config {
 	cfg.MinAvailMemPct = t.config.GetInt(keyMinAvailMemPct)
 	cfg.MinAvailSwapPct = t.config.GetInt(keyMinAvailSwapPct)
 	cfg.MaxParallel = t.config.GetInt(keyMaxParallel)
+	cfg.ConfigHistoryMax = t.config.GetInt(keyConfigHistoryMax)
 	cfg.Env = t.config.GetString(keyEnv)
 	cfg.SplitAction = t.config.GetString(keySplitAction)
+	cfg.MaintenanceWindow = t.config.GetString(keyMaintenanceWindow)
+	if mode, err := maintenance.ParseMode(t.config.GetString(keyMaintenanceMode)); err != nil {
+		t.log.Warnf("%s", err)
+		cfg.MaintenanceMode = maintenance.ModeFreeze
+	} else {
+		cfg.MaintenanceMode = mode
+	}
 	cfg.SSHKey = t.config.GetString(keySSHKey)
 	cfg.PRKey = t.config.GetString(keyPRKey)
 
@@ -38,6 +38,7 @@ import (
 
 	"github.com/opensvc/om3/v3/core/cluster"
 	"github.com/opensvc/om3/v3/core/hbsecret"
+	"github.com/opensvc/om3/v3/core/maintenance"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/node"
 	"github.com/opensvc/om3/v3/core/nodesinfo"
@@ -457,6 +458,9 @@ func (t *Manager) worker() {
 	lastShutdownFileTouchTicker := time.NewTicker(10 * time.Second)
 	defer lastShutdownFileTouchTicker.Stop()
 
+	maintenanceTicker := time.NewTicker(maintenance.CheckInterval)
+	defer maintenanceTicker.Stop()
+
 	// TODO refreshSanPaths should be refreshed on events,  on ticker ?
 	for {
 		select {
@@ -522,6 +526,8 @@ func (t *Manager) worker() {
 			t.onRejoinGracePeriodExpire()
 		case <-lastShutdownFileTouchTicker.C:
 			t.onLastShutdownFileTouchTicker()
+		case <-maintenanceTicker.C:
+			t.onMaintenanceTicker()
 		}
 	}
 }
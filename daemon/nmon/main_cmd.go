@@ -51,6 +51,9 @@ func (t *Manager) onClusterConfigUpdated(c *msgbus.ClusterConfigUpdated) {
 	// recompute rejoin ticker, perhaps RejoinGracePeriod has been changed
 	t.checkRejoinTicker()
 
+	// apply a maintenance window change without waiting for the ticker
+	t.onMaintenanceTicker()
+
 	if len(c.NetworkChanged) > 0 {
 		t.onNetworkChanged(c.NetworkChanged)
 	}
@@ -133,6 +136,9 @@ func (t *Manager) onConfigFileUpdated(_ *msgbus.ConfigFileUpdated) {
 
 	// recompute rejoin ticker, perhaps RejoinGracePeriod has been changed
 	t.checkRejoinTicker()
+
+	// apply a maintenance window change without waiting for the ticker
+	t.onMaintenanceTicker()
 }
 
 func (t *Manager) checkRejoinTicker() {
@@ -0,0 +1,101 @@
+package nmon
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/freeze"
+	"github.com/opensvc/om3/v3/core/maintenance"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+)
+
+// onMaintenanceTicker opens or closes the node maintenance window
+// according to the node.maintenance_window schedule expression.
+func (t *Manager) onMaintenanceTicker() {
+	t.evalMaintenanceWindow(time.Now())
+}
+
+func (t *Manager) evalMaintenanceWindow(now time.Time) {
+	isOpen, err := maintenance.IsOpen(t.nodeConfig.MaintenanceWindow, now)
+	if err != nil {
+		t.log.Warnf("maintenance window %s: %s", t.nodeConfig.MaintenanceWindow, err)
+		return
+	}
+	current := t.nodeStatus.MaintenanceWindow
+	if current != nil && (!isOpen || current.Schedule != t.nodeConfig.MaintenanceWindow || current.Mode != t.nodeConfig.MaintenanceMode) {
+		if err := t.closeMaintenanceWindow(); err != nil {
+			return
+		}
+		current = nil
+	}
+	switch {
+	case isOpen && current == nil:
+		t.openMaintenanceWindow(now)
+	case !isOpen && current == nil && maintenance.IsMarkedFrozen(nodeFrozenFile()):
+		// the window closed while the daemon was not running
+		if err := maintenanceUnfreeze(); err != nil {
+			t.log.Errorf("maintenance window closed: unfreeze: %s", err)
+		} else {
+			t.log.Infof("maintenance window closed: local node has been unfrozen")
+		}
+	}
+}
+
+func nodeFrozenFile() string {
+	return filepath.Join(rawconfig.Paths.Var, "node", "frozen")
+}
+
+// maintenanceUnfreeze unfreezes the local node frozen by a maintenance
+// window opening, and removes the maintenance marker.
+func maintenanceUnfreeze() error {
+	if err := freeze.Unfreeze(nodeFrozenFile()); err != nil {
+		return err
+	}
+	return maintenance.UnmarkFrozen(nodeFrozenFile())
+}
+
+func (t *Manager) openMaintenanceWindow(now time.Time) {
+	w := maintenance.Window{
+		Schedule: t.nodeConfig.MaintenanceWindow,
+		Mode:     t.nodeConfig.MaintenanceMode,
+		OpenedAt: now,
+	}
+	if w.Mode == maintenance.ModeFreeze {
+		if changed, err := t.nodeFreeze(); err != nil {
+			t.log.Errorf("maintenance window %s opened: freeze: %s", w.Schedule, err)
+			return
+		} else if changed {
+			t.log.Infof("maintenance window %s opened: local node has been frozen", w.Schedule)
+			if err := maintenance.MarkFrozen(nodeFrozenFile()); err != nil {
+				t.log.Warnf("maintenance window %s opened: %s", w.Schedule, err)
+			}
+		} else {
+			t.log.Infof("maintenance window %s opened: local node is already frozen", w.Schedule)
+		}
+	} else {
+		t.log.Infof("maintenance window %s opened: block orchestrations", w.Schedule)
+	}
+	t.nodeStatus.MaintenanceWindow = &w
+	t.publishNodeStatus()
+	t.publisher.Pub(&msgbus.NodeMaintenanceWindowOpened{Node: t.localhost, Value: w}, t.labelLocalhost)
+}
+
+// closeMaintenanceWindow unfreezes the node if it was frozen by a window
+// opening, and publishes the window closing.
+func (t *Manager) closeMaintenanceWindow() error {
+	w := *t.nodeStatus.MaintenanceWindow
+	if maintenance.IsMarkedFrozen(nodeFrozenFile()) {
+		if err := maintenanceUnfreeze(); err != nil {
+			t.log.Errorf("maintenance window %s closed: unfreeze: %s", w.Schedule, err)
+			return err
+		}
+		t.log.Infof("maintenance window %s closed: local node has been unfrozen", w.Schedule)
+	} else {
+		t.log.Infof("maintenance window %s closed", w.Schedule)
+	}
+	t.nodeStatus.MaintenanceWindow = nil
+	t.publishNodeStatus()
+	t.publisher.Pub(&msgbus.NodeMaintenanceWindowClosed{Node: t.localhost, Value: w}, t.labelLocalhost)
+	return nil
+}
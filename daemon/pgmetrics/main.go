@@ -1,8 +1,8 @@
 // Package pgmetrics provides Prometheus metrics for cgroup resource usage
 // under the opensvc.slice hierarchy.
 //
-// It exposes cgroup metrics (CPU, memory, etc.) for all cgroups created by
-// the pg_* object keywords.
+// It exposes cgroup metrics (CPU, memory, pids, pressure stall information,
+// etc.) for all cgroups created by the pg_* object keywords.
 package pgmetrics
 
 import (
@@ -18,6 +18,7 @@ import (
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/core/object"
 	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/pg"
 	"github.com/opensvc/om3/v3/util/plog"
 	"github.com/opensvc/om3/v3/util/pubsub"
 	"github.com/opensvc/om3/v3/util/systemd"
@@ -200,6 +201,50 @@ var (
 		[]string{"namespace", "path"},
 	)
 
+	// pgCgroupPidsCurrent reports the number of tasks in each cgroup
+	pgCgroupPidsCurrent = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "opensvc",
+			Subsystem: "pg",
+			Name:      "cgroup_pids_current",
+			Help:      "Number of tasks in the cgroup",
+		},
+		[]string{"namespace", "path"},
+	)
+
+	// pgCgroupPidsMax reports the maximum number of tasks for each cgroup
+	pgCgroupPidsMax = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "opensvc",
+			Subsystem: "pg",
+			Name:      "cgroup_pids_max",
+			Help:      "Maximum number of tasks for the cgroup (0 = unlimited)",
+		},
+		[]string{"namespace", "path"},
+	)
+
+	// pgCgroupPressure reports the pressure stall averages for each cgroup
+	pgCgroupPressure = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "opensvc",
+			Subsystem: "pg",
+			Name:      "cgroup_pressure_ratio",
+			Help:      "Ratio of time some or all tasks of the cgroup were stalled on the resource over the window",
+		},
+		[]string{"namespace", "path", "resource", "line", "window"},
+	)
+
+	// pgCgroupPressureTotal reports the total pressure stall time for each cgroup
+	pgCgroupPressureTotal = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "opensvc",
+			Subsystem: "pg",
+			Name:      "cgroup_pressure_total_usec",
+			Help:      "Total time in microseconds some or all tasks of the cgroup were stalled on the resource",
+		},
+		[]string{"namespace", "path", "resource", "line"},
+	)
+
 	// pgCgroupExists reports whether a cgroup exists (1) or not (0)
 	pgCgroupExists = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -280,6 +325,10 @@ func (m *Manager) unregisterMetrics() {
 	prometheus.Unregister(pgCgroupCPUPeriod)
 	prometheus.Unregister(pgCgroupCPUCpus)
 	prometheus.Unregister(pgCgroupBlkioWeight)
+	prometheus.Unregister(pgCgroupPidsCurrent)
+	prometheus.Unregister(pgCgroupPidsMax)
+	prometheus.Unregister(pgCgroupPressure)
+	prometheus.Unregister(pgCgroupPressureTotal)
 	prometheus.Unregister(pgCgroupExists)
 }
 
@@ -298,6 +347,10 @@ func (m *Manager) registerMetrics() {
 		pgCgroupCPUPeriod,
 		pgCgroupCPUCpus,
 		pgCgroupBlkioWeight,
+		pgCgroupPidsCurrent,
+		pgCgroupPidsMax,
+		pgCgroupPressure,
+		pgCgroupPressureTotal,
 		pgCgroupExists,
 	)
 }
@@ -411,6 +464,52 @@ func (m *Manager) collectCgroupMetrics(cgroupPath, namespace, objPath string) {
 			pgCgroupBlkioWeight.WithLabelValues(namespace, objPath).Set(float64(val))
 		}
 	}
+
+	// Read pids.current
+	if pidsCurrent, err := readFile(cgroupPath, "pids.current"); err == nil {
+		if val, err := parseUint(pidsCurrent); err == nil {
+			pgCgroupPidsCurrent.WithLabelValues(namespace, objPath).Set(float64(val))
+		}
+	}
+
+	// Read pids.max
+	if pidsMax, err := readFile(cgroupPath, "pids.max"); err == nil {
+		if val, err := parseUint(pidsMax); err == nil {
+			pgCgroupPidsMax.WithLabelValues(namespace, objPath).Set(float64(val))
+		} else if strings.TrimSpace(pidsMax) == "max" {
+			pgCgroupPidsMax.WithLabelValues(namespace, objPath).Set(0)
+		}
+	}
+
+	// Read cpu.pressure, memory.pressure and io.pressure
+	if pressure, err := pg.ReadPressure(cgroupPath); err != nil {
+		m.log.Tracef("%s: %s", cgroupPath, err)
+	} else if pressure != nil {
+		setPressureMetrics(pressure, namespace, objPath)
+	}
+}
+
+func setPressureMetrics(pressure *pg.Pressure, namespace, objPath string) {
+	setLine := func(resource, line string, p pg.PressureLine) {
+		// the kernel reports the averages as percentages
+		pgCgroupPressure.WithLabelValues(namespace, objPath, resource, line, "10s").Set(p.Avg10 / 100)
+		pgCgroupPressure.WithLabelValues(namespace, objPath, resource, line, "60s").Set(p.Avg60 / 100)
+		pgCgroupPressure.WithLabelValues(namespace, objPath, resource, line, "300s").Set(p.Avg300 / 100)
+		pgCgroupPressureTotal.WithLabelValues(namespace, objPath, resource, line).Set(float64(p.Total))
+	}
+	for resource, stat := range map[string]*pg.PressureStat{
+		"cpu":    pressure.CPU,
+		"memory": pressure.Memory,
+		"io":     pressure.IO,
+	} {
+		if stat == nil {
+			continue
+		}
+		setLine(resource, "some", stat.Some)
+		if stat.Full != nil {
+			setLine(resource, "full", *stat.Full)
+		}
+	}
 }
 
 func parseCPUStat(content, namespace, objPath string) {
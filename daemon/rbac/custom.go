@@ -0,0 +1,177 @@
+package rbac
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type (
+	// CustomRole is a site-defined role, declared in a role#<name> section
+	// of the cluster config. A <name>:<namespace> grant allows the Allow
+	// verbs on the objects of Kinds in the namespace, a <name> grant
+	// allows them in all namespaces.
+	CustomRole struct {
+		Name string `json:"name"`
+
+		// Allow is the list of allowed verbs. VerbAny allows all verbs.
+		Allow []string `json:"allow"`
+
+		// Kinds is the list of object kinds the verbs apply to. An empty
+		// list means all kinds.
+		Kinds []string `json:"kinds"`
+	}
+
+	CustomRoles []CustomRole
+
+	// Decision explains why a verb is allowed or denied by a set of grants.
+	Decision struct {
+		Allowed bool   `json:"allowed"`
+		Grant   Grant  `json:"grant,omitempty"`
+		Reason  string `json:"reason"`
+	}
+)
+
+const (
+	// VerbAny is the custom role verb allowing all verbs.
+	VerbAny = "*"
+
+	// VerbGet is the verb of the object read routes, except the data key
+	// value reads.
+	VerbGet = "get"
+
+	// VerbDecode is the verb of the object data key value reads.
+	VerbDecode = "decode"
+
+	// VerbSet is the verb of the object config changes.
+	VerbSet = "set"
+
+	// VerbSetKey is the verb of the object data key changes.
+	VerbSetKey = "set_key"
+
+	kindSec = "sec"
+)
+
+func (t CustomRoles) DeepCopy() CustomRoles {
+	if t == nil {
+		return nil
+	}
+	l := make(CustomRoles, len(t))
+	for i, role := range t {
+		l[i] = CustomRole{
+			Name:  role.Name,
+			Allow: append([]string{}, role.Allow...),
+			Kinds: append([]string{}, role.Kinds...),
+		}
+	}
+	return l
+}
+
+// IsBuiltinRole returns true if name is the name of a builtin role, which
+// can't be redefined by a custom role.
+func IsBuiltinRole(name string) bool {
+	_, ok := roleMap[name]
+	return ok
+}
+
+// Lookup returns the custom role named name.
+func (t CustomRoles) Lookup(name string) (CustomRole, bool) {
+	for _, role := range t {
+		if role.Name == name {
+			return role, true
+		}
+	}
+	return CustomRole{}, false
+}
+
+// Allows returns true if the role allows verb on an object of kind.
+func (t CustomRole) Allows(verb, kind string) bool {
+	if len(t.Kinds) > 0 && !slices.Contains(t.Kinds, kind) {
+		return false
+	}
+	return slices.Contains(t.Allow, VerbAny) || slices.Contains(t.Allow, verb)
+}
+
+// Allow returns the first of grants allowing verb on an object of kind in
+// namespace through a custom role.
+func (t CustomRoles) Allow(grants Grants, verb, kind, namespace string) (Grant, bool) {
+	for _, grant := range grants {
+		name, scope := grant.Split()
+		if scope != "" && scope != namespace {
+			continue
+		}
+		if role, ok := t.Lookup(name); ok && role.Allows(verb, kind) {
+			return grant, true
+		}
+	}
+	return "", false
+}
+
+// BuiltinRolesFor returns the builtin scoped roles allowing verb on an
+// object of kind.
+func BuiltinRolesFor(verb, kind string) []Role {
+	switch verb {
+	case VerbGet:
+		return []Role{RoleGuest, RoleOperator, RoleAdmin}
+	case VerbDecode:
+		if kind == kindSec {
+			return []Role{RoleAdmin}
+		}
+		return []Role{RoleGuest, RoleOperator, RoleAdmin}
+	case VerbSet, VerbSetKey:
+		return []Role{RoleAdmin}
+	default:
+		return []Role{RoleOperator, RoleAdmin}
+	}
+}
+
+// Explain returns the decision of grants, evaluated with the builtin roles
+// and the custom roles, on verb applied to an object of kind in namespace.
+func (t CustomRoles) Explain(grants Grants, verb, kind, namespace string) Decision {
+	if grants.HasGrant(GrantRoot) {
+		return Decision{Allowed: true, Grant: GrantRoot, Reason: "the root role allows all verbs"}
+	}
+	roles := BuiltinRolesFor(verb, kind)
+	for _, grant := range grants {
+		role, scope := SplitGrant(grant)
+		if scope != "" && scope != namespace {
+			continue
+		}
+		if slices.Contains(roles, role) {
+			return Decision{
+				Allowed: true,
+				Grant:   grant,
+				Reason:  fmt.Sprintf("the %s role allows %s on %s objects", role, verb, kind),
+			}
+		}
+	}
+	var denials []string
+	for _, grant := range grants {
+		name, scope := grant.Split()
+		role, ok := t.Lookup(name)
+		if !ok {
+			continue
+		}
+		if scope != "" && scope != namespace {
+			continue
+		}
+		if role.Allows(verb, kind) {
+			return Decision{
+				Allowed: true,
+				Grant:   grant,
+				Reason:  fmt.Sprintf("the %s custom role allows %s on %s objects", name, verb, kind),
+			}
+		}
+		denials = append(denials, fmt.Sprintf("the %s custom role does not allow %s on %s objects", name, verb, kind))
+	}
+	l := make([]string, len(roles))
+	for i, role := range roles {
+		l[i] = string(role)
+	}
+	reason := fmt.Sprintf("no grant allows %s on %s objects in namespace %s, need one of the %s roles or a custom role allowing it",
+		verb, kind, namespace, strings.Join(l, ", "))
+	if len(denials) > 0 {
+		reason += ": " + strings.Join(denials, ", ")
+	}
+	return Decision{Reason: reason}
+}
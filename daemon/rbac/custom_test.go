@@ -0,0 +1,67 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomRolesExplain(t *testing.T) {
+	roles := CustomRoles{
+		{Name: "deployer", Allow: []string{"start", "stop", "restart", "get"}, Kinds: []string{"svc"}},
+		{Name: "auditor", Allow: []string{VerbAny}},
+	}
+	cases := map[string]struct {
+		grants    Grants
+		verb      string
+		kind      string
+		namespace string
+		allowed   bool
+		grant     Grant
+	}{
+		"root": {
+			grants: NewGrants("root"), verb: "set", kind: "svc", namespace: "ns1",
+			allowed: true, grant: "root",
+		},
+		"builtin operator": {
+			grants: NewGrants("operator:ns1"), verb: "start", kind: "svc", namespace: "ns1",
+			allowed: true, grant: "operator:ns1",
+		},
+		"builtin guest can't decode sec": {
+			grants: NewGrants("guest:ns1"), verb: VerbDecode, kind: "sec", namespace: "ns1",
+		},
+		"custom role in namespace": {
+			grants: NewGrants("deployer:ns1"), verb: "restart", kind: "svc", namespace: "ns1",
+			allowed: true, grant: "deployer:ns1",
+		},
+		"custom role in other namespace": {
+			grants: NewGrants("deployer:ns2"), verb: "restart", kind: "svc", namespace: "ns1",
+		},
+		"custom role unallowed verb": {
+			grants: NewGrants("deployer:ns1"), verb: VerbSet, kind: "svc", namespace: "ns1",
+		},
+		"custom role unallowed kind": {
+			grants: NewGrants("deployer:ns1"), verb: VerbGet, kind: "sec", namespace: "ns1",
+		},
+		"custom role without namespace and any verb": {
+			grants: NewGrants("guest:ns2", "auditor"), verb: VerbDecode, kind: "sec", namespace: "ns1",
+			allowed: true, grant: "auditor",
+		},
+		"undefined custom role": {
+			grants: NewGrants("unknown:ns1"), verb: VerbGet, kind: "svc", namespace: "ns1",
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			decision := roles.Explain(c.grants, c.verb, c.kind, c.namespace)
+			require.Equal(t, c.allowed, decision.Allowed, decision.Reason)
+			require.Equal(t, c.grant, decision.Grant)
+			require.NotEmpty(t, decision.Reason)
+
+			_, allowed := roles.Allow(c.grants, c.verb, c.kind, c.namespace)
+			if !c.allowed {
+				require.False(t, allowed)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package remotemon
+
+import (
+	"sync"
+
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+)
+
+type (
+	// data holds the last known reachability and objects statuses of the
+	// watched remote clusters, so the object monitors started after the
+	// publications can initialize their relations avail status.
+	data struct {
+		sync.RWMutex
+		reachable map[string]bool
+		status    map[string]map[naming.Path]object.Status
+	}
+)
+
+// Data is the package data holder of the watched remote clusters.
+var Data = &data{
+	reachable: make(map[string]bool),
+	status:    make(map[string]map[naming.Path]object.Status),
+}
+
+// IsReachable returns true if the event stream of the remote cluster is
+// connected. A remote cluster not yet connected is not reachable.
+func (t *data) IsReachable(name string) bool {
+	t.RLock()
+	defer t.RUnlock()
+	return t.reachable[name]
+}
+
+// GetObjectStatus returns the last known status of the remote cluster
+// object, or nil if unknown.
+func (t *data) GetObjectStatus(name string, p naming.Path) *object.Status {
+	t.RLock()
+	defer t.RUnlock()
+	if st, ok := t.status[name][p]; ok {
+		return st.DeepCopy()
+	}
+	return nil
+}
+
+// setReachable sets the remote cluster reachability and returns true if
+// it changed.
+func (t *data) setReachable(name string, v bool) bool {
+	t.Lock()
+	defer t.Unlock()
+	if old, ok := t.reachable[name]; ok && old == v {
+		return false
+	}
+	t.reachable[name] = v
+	return true
+}
+
+func (t *data) setObjectStatus(name string, p naming.Path, st object.Status) {
+	t.Lock()
+	defer t.Unlock()
+	if _, ok := t.status[name]; !ok {
+		t.status[name] = make(map[naming.Path]object.Status)
+	}
+	t.status[name][p] = st
+}
+
+func (t *data) unsetObjectStatus(name string, p naming.Path) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.status[name], p)
+}
+
+// clusters returns the names of the remote clusters with a known
+// reachability.
+func (t *data) clusters() []string {
+	t.RLock()
+	defer t.RUnlock()
+	l := make([]string, 0, len(t.reachable))
+	for name := range t.reachable {
+		l = append(l, name)
+	}
+	return l
+}
+
+func (t *data) unsetCluster(name string) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.reachable, name)
+	delete(t.status, name)
+}
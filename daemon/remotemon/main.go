@@ -0,0 +1,217 @@
+// Package remotemon watches the objects of the remote clusters referenced
+// by the path@cluster:<name> parents and children relations of the local
+// instances.
+//
+// It runs one event stream watcher per referenced remote cluster, using
+// the remote#<name> cluster config section url and credentials, and
+// publishes on the local bus:
+//   - msgbus.RemoteClusterReachable
+//   - msgbus.RemoteClusterUnreachable
+//   - msgbus.RemoteObjectStatusDeleted
+//   - msgbus.RemoteObjectStatusUpdated
+package remotemon
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+type (
+	T struct {
+		localhost string
+
+		// watchers is the running remote cluster watchers, indexed by
+		// remote cluster name.
+		watchers map[string]*watcher
+
+		log *plog.Logger
+
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		publisher pubsub.Publisher
+
+		sub   *pubsub.Subscription
+		subQS pubsub.QueueSizer
+
+		labelLocalhost pubsub.Label
+		wg             sync.WaitGroup
+	}
+)
+
+func New(subQS pubsub.QueueSizer) *T {
+	localhost := hostname.Hostname()
+	return &T{
+		localhost:      localhost,
+		labelLocalhost: pubsub.Label{"node", localhost},
+		subQS:          subQS,
+		watchers:       make(map[string]*watcher),
+	}
+}
+
+func (t *T) Start(ctx context.Context) error {
+	t.log = plog.NewDefaultLogger().WithPrefix("daemon: remotemon: ").Attr("pkg", "daemon/remotemon")
+	t.log.Tracef("starting")
+	defer t.log.Tracef("started")
+	err := make(chan error)
+	t.wg.Add(1)
+	go func(errC chan<- error) {
+		defer t.wg.Done()
+		defer t.log.Infof("stopped")
+
+		t.ctx, t.cancel = context.WithCancel(ctx)
+		t.publisher = pubsub.PubFromContext(t.ctx)
+
+		sub := pubsub.SubFromContext(t.ctx, "daemon.remotemon", t.subQS)
+		sub.AddFilter(&msgbus.AuditStart{})
+		sub.AddFilter(&msgbus.AuditStop{})
+		sub.AddFilter(&msgbus.ClusterConfigUpdated{}, t.labelLocalhost)
+		sub.AddFilter(&msgbus.InstanceConfigDeleted{}, t.labelLocalhost)
+		sub.AddFilter(&msgbus.InstanceConfigUpdated{}, t.labelLocalhost)
+		sub.Start()
+		t.sub = sub
+
+		defer func() {
+			if err := sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+				t.log.Warnf("subscription stop: %s", err)
+			}
+		}()
+		defer t.stopWatchers()
+		t.log.Infof("started")
+		errC <- nil
+		t.reconcile()
+		t.worker()
+	}(err)
+
+	return <-err
+}
+
+func (t *T) Stop() error {
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
+
+func (t *T) worker() {
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case i := <-t.sub.C:
+			switch msg := i.(type) {
+			case *msgbus.AuditStart:
+				t.log.HandleAuditStart(msg.Q, msg.Subsystems, "remotemon")
+			case *msgbus.AuditStop:
+				t.log.HandleAuditStop(msg.Q, msg.Subsystems, "remotemon")
+			case *msgbus.ClusterConfigUpdated:
+				t.reconcile()
+			case *msgbus.InstanceConfigDeleted:
+				t.reconcile()
+			case *msgbus.InstanceConfigUpdated:
+				t.reconcile()
+			}
+		}
+	}
+}
+
+// wantedPaths returns the remote object paths referenced by the local
+// instances relations, indexed by remote cluster name.
+func (t *T) wantedPaths() map[string]naming.Paths {
+	m := make(map[string]naming.Paths)
+	add := func(relations naming.Relations) {
+		for _, relation := range relations {
+			name := relation.Cluster()
+			if name == "" {
+				continue
+			}
+			p, err := relation.Path()
+			if err != nil {
+				t.log.Warnf("relation %s: %s", relation, err)
+				continue
+			}
+			if !slices.Contains(m[name], p) {
+				m[name] = append(m[name], p)
+			}
+		}
+	}
+	for _, cfg := range instance.ConfigData.GetByNode(t.localhost) {
+		if cfg == nil || cfg.ActorConfig == nil {
+			continue
+		}
+		add(cfg.ActorConfig.Parents)
+		add(cfg.ActorConfig.Children)
+	}
+	for _, l := range m {
+		slices.SortFunc(l, func(a, b naming.Path) int {
+			return strings.Compare(a.String(), b.String())
+		})
+	}
+	return m
+}
+
+// reconcile starts, restarts or stops the remote cluster watchers so
+// they match the remote relations of the local instances and the
+// remote#<name> cluster config sections.
+func (t *T) reconcile() {
+	wanted := t.wantedPaths()
+	remotes := cluster.ConfigData.Get().Remotes
+	for name, w := range t.watchers {
+		remote, ok := remotes.Lookup(name)
+		paths, isWanted := wanted[name]
+		switch {
+		case !isWanted:
+			t.log.Infof("stop watching cluster %s: no more relations", name)
+		case !ok:
+			t.log.Warnf("stop watching cluster %s: no more remote#%s section", name, name)
+		case !w.equal(remote, paths):
+			t.log.Infof("restart watching cluster %s: config or relations changed", name)
+		default:
+			continue
+		}
+		w.stop()
+		delete(t.watchers, name)
+		Data.unsetCluster(name)
+	}
+	for _, name := range Data.clusters() {
+		// the unwatched clusters without remote#<name> section
+		if _, ok := wanted[name]; !ok {
+			Data.unsetCluster(name)
+		}
+	}
+	for name, paths := range wanted {
+		if _, ok := t.watchers[name]; ok {
+			continue
+		}
+		remote, ok := remotes.Lookup(name)
+		if !ok {
+			err := "no remote#" + name + " section in the cluster config"
+			if Data.setReachable(name, false) {
+				t.log.Warnf("can't watch cluster %s: %s", name, err)
+				t.publisher.Pub(&msgbus.RemoteClusterUnreachable{Cluster: name, Error: err}, pubsub.Label{"cluster", name})
+			}
+			continue
+		}
+		t.log.Infof("start watching cluster %s objects %s", name, paths)
+		w := newWatcher(remote, paths, t.publisher, t.log)
+		w.start(t.ctx)
+		t.watchers[name] = w
+	}
+}
+
+func (t *T) stopWatchers() {
+	for name, w := range t.watchers {
+		w.stop()
+		delete(t.watchers, name)
+	}
+}
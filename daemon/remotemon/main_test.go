@@ -0,0 +1,79 @@
+package remotemon
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/core/instance"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+type recordPublisher struct {
+	msgs []pubsub.Messager
+}
+
+func (t *recordPublisher) Pub(msg pubsub.Messager, _ ...pubsub.Label) {
+	t.msgs = append(t.msgs, msg)
+}
+
+func TestReconcile(t *testing.T) {
+	instance.InitData()
+	cluster.InitData()
+	cluster.ConfigData.Set(&cluster.Config{})
+	t.Cleanup(func() {
+		instance.InitData()
+		cluster.InitData()
+	})
+
+	localhost := "node1"
+	p1 := naming.Path{Kind: naming.KindSvc, Name: "s1"}
+	p2 := naming.Path{Kind: naming.KindSvc, Name: "s2"}
+	instance.ConfigData.Set(p1, localhost, &instance.Config{
+		ActorConfig: &instance.ActorConfig{
+			Parents:  naming.Relations{"p1@cluster:c1", "p2"},
+			Children: naming.Relations{"c2@cluster:c2"},
+		},
+	})
+	instance.ConfigData.Set(p2, localhost, &instance.Config{
+		ActorConfig: &instance.ActorConfig{
+			Parents: naming.Relations{"p0@cluster:c1", "p1@cluster:c1"},
+		},
+	})
+
+	publisher := &recordPublisher{}
+	m := &T{
+		localhost: localhost,
+		watchers:  make(map[string]*watcher),
+		log:       plog.NewLogger(zerolog.Nop()),
+		publisher: publisher,
+	}
+
+	require.Equal(t, map[string]naming.Paths{
+		"c1": {{Namespace: "root", Kind: naming.KindSvc, Name: "p0"}, {Namespace: "root", Kind: naming.KindSvc, Name: "p1"}},
+		"c2": {{Namespace: "root", Kind: naming.KindSvc, Name: "c2"}},
+	}, m.wantedPaths())
+
+	m.reconcile()
+	require.Empty(t, m.watchers, "no watcher without remote section")
+	require.ElementsMatch(t, []string{"c1", "c2"}, Data.clusters())
+	require.False(t, Data.IsReachable("c1"))
+	require.Len(t, publisher.msgs, 2)
+	require.IsType(t, &msgbus.RemoteClusterUnreachable{}, publisher.msgs[0])
+
+	m.reconcile()
+	require.Len(t, publisher.msgs, 2, "the unreachability is published once")
+
+	instance.ConfigData.Unset(p1, localhost)
+	m.reconcile()
+	require.Equal(t, []string{"c1"}, Data.clusters(), "the cluster no longer referenced is forgotten")
+
+	instance.ConfigData.Unset(p2, localhost)
+	m.reconcile()
+	require.Empty(t, Data.clusters())
+}
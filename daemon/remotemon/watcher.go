@@ -0,0 +1,233 @@
+package remotemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/client"
+	clientapi "github.com/opensvc/om3/v3/core/client/api"
+	"github.com/opensvc/om3/v3/core/cluster"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/object"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/daemon/msgbus"
+	"github.com/opensvc/om3/v3/util/funcopt"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/pubsub"
+)
+
+type (
+	// watcher follows the status of a set of objects of a remote cluster
+	// through the event stream of the first reachable remote url.
+	watcher struct {
+		remote    cluster.ConfigRemote
+		paths     naming.Paths
+		publisher pubsub.Publisher
+		log       *plog.Logger
+
+		cancel context.CancelFunc
+		done   chan struct{}
+	}
+)
+
+var (
+	// retryInterval is the delay between two connection attempts when
+	// no url of the remote cluster serves the event stream.
+	retryInterval = 10 * time.Second
+)
+
+func newWatcher(remote cluster.ConfigRemote, paths naming.Paths, publisher pubsub.Publisher, log *plog.Logger) *watcher {
+	return &watcher{
+		remote:    remote,
+		paths:     paths,
+		publisher: publisher,
+		log:       log.AddPrefix(fmt.Sprintf("cluster %s: ", remote.Name)),
+		done:      make(chan struct{}),
+	}
+}
+
+// equal returns true if the watcher follows paths with the remote config.
+func (w *watcher) equal(remote cluster.ConfigRemote, paths naming.Paths) bool {
+	return w.remote.Credentials == remote.Credentials &&
+		w.remote.Username == remote.Username &&
+		w.remote.Insecure == remote.Insecure &&
+		slices.Equal(w.remote.URLs, remote.URLs) &&
+		slices.Equal(w.paths, paths)
+}
+
+func (w *watcher) start(parent context.Context) {
+	var ctx context.Context
+	ctx, w.cancel = context.WithCancel(parent)
+	go func() {
+		defer close(w.done)
+		w.run(ctx)
+	}()
+}
+
+func (w *watcher) stop() {
+	w.cancel()
+	<-w.done
+}
+
+func (w *watcher) run(ctx context.Context) {
+	labelCluster := pubsub.Label{"cluster", w.remote.Name}
+	for {
+		var errs error
+		for _, url := range w.remote.URLs {
+			err := w.watch(ctx, url)
+			if ctx.Err() != nil {
+				return
+			}
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", url, err))
+		}
+		if len(w.remote.URLs) == 0 {
+			errs = fmt.Errorf("no url")
+		}
+		if Data.setReachable(w.remote.Name, false) {
+			w.log.Warnf("unreachable: %s", errs)
+			w.publisher.Pub(&msgbus.RemoteClusterUnreachable{Cluster: w.remote.Name, Error: errs.Error()}, labelCluster)
+		} else {
+			w.log.Debugf("still unreachable: %s", errs)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// watch reads the remote event stream served by url, and publishes the
+// watched objects status changes on the local bus, until the stream
+// breaks.
+func (w *watcher) watch(ctx context.Context, url string) error {
+	labelCluster := pubsub.Label{"cluster", w.remote.Name}
+	cli, err := w.newClient(url)
+	if err != nil {
+		return err
+	}
+	filters := make([]string, 0, 2*len(w.paths))
+	for _, p := range w.paths {
+		filters = append(filters, "ObjectStatusUpdated,path="+p.String())
+		filters = append(filters, "ObjectStatusDeleted,path="+p.String())
+	}
+	reader, err := clientapi.NewGetEvents(cli).
+		SetFilters(filters).
+		SetReplay(true).
+		GetReader(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = reader.Close() }()
+
+	w.log.Infof("watching %s via %s", w.paths, url)
+	if Data.setReachable(w.remote.Name, true) {
+		w.publisher.Pub(&msgbus.RemoteClusterReachable{Cluster: w.remote.Name, URL: url}, labelCluster)
+	}
+	for {
+		ev, err := reader.Read()
+		if err != nil {
+			return err
+		}
+		if ev == nil {
+			continue
+		}
+		msg, err := msgbus.EventToMessage(*ev)
+		if err != nil {
+			w.log.Debugf("skip event %s: %s", ev.Kind, err)
+			continue
+		}
+		switch c := msg.(type) {
+		case *msgbus.ObjectStatusUpdated:
+			Data.setObjectStatus(w.remote.Name, c.Path, c.Value)
+			w.publisher.Pub(&msgbus.RemoteObjectStatusUpdated{Cluster: w.remote.Name, Path: c.Path, Value: c.Value},
+				labelCluster, pubsub.Label{"path", c.Path.String()})
+		case *msgbus.ObjectStatusDeleted:
+			Data.unsetObjectStatus(w.remote.Name, c.Path)
+			w.publisher.Pub(&msgbus.RemoteObjectStatusDeleted{Cluster: w.remote.Name, Path: c.Path},
+				labelCluster, pubsub.Label{"path", c.Path.String()})
+		}
+	}
+}
+
+// newClient returns a client of the remote cluster api served by url,
+// with the credentials of the remote credentials object:
+//
+//   - token: a bearer token
+//   - certificate and private_key: a x509 client certificate
+//   - password: the password of the remote username, defaulting to
+//     the credentials object name
+func (w *watcher) newClient(url string) (*client.T, error) {
+	p, err := naming.ParsePath(w.remote.Credentials)
+	if err != nil {
+		return nil, fmt.Errorf("credentials: %w", err)
+	}
+	ds, err := object.NewDataStore(p, object.WithVolatile(true))
+	if err != nil {
+		return nil, fmt.Errorf("credentials: %w", err)
+	}
+	options := []funcopt.O{
+		client.WithURL(url),
+		client.WithTimeout(0),
+		client.WithInsecureSkipVerify(w.remote.Insecure),
+	}
+	switch {
+	case ds.HasKey("token"):
+		b, err := ds.DecodeKey("token")
+		if err != nil {
+			return nil, fmt.Errorf("credentials %s: %w", p, err)
+		}
+		options = append(options, client.WithBearer(string(b)))
+	case ds.HasKey("certificate") && ds.HasKey("private_key"):
+		certFile, keyFile, err := w.installCertificate(ds)
+		if err != nil {
+			return nil, fmt.Errorf("credentials %s: %w", p, err)
+		}
+		options = append(options, client.WithCertificate(certFile), client.WithKey(keyFile))
+	case ds.HasKey("password"):
+		b, err := ds.DecodeKey("password")
+		if err != nil {
+			return nil, fmt.Errorf("credentials %s: %w", p, err)
+		}
+		username := w.remote.Username
+		if username == "" {
+			username = p.Name
+		}
+		options = append(options, client.WithUsername(username), client.WithPassword(string(b)))
+	default:
+		return nil, fmt.Errorf("credentials %s: no token, certificate and private_key, or password key", p)
+	}
+	return client.New(options...)
+}
+
+// installCertificate writes the x509 client certificate and private key
+// of the credentials object in the remote cluster private var directory,
+// as the client loads them from files.
+func (w *watcher) installCertificate(ds object.DataStore) (certFile, keyFile string, err error) {
+	dir := filepath.Join(rawconfig.Paths.Var, "remote", w.remote.Name)
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return
+	}
+	for _, e := range []struct {
+		key  string
+		file *string
+	}{
+		{"certificate", &certFile},
+		{"private_key", &keyFile},
+	} {
+		var b []byte
+		if b, err = ds.DecodeKey(e.key); err != nil {
+			return
+		}
+		*e.file = filepath.Join(dir, e.key+".pem")
+		if err = os.WriteFile(*e.file, b, 0600); err != nil {
+			return
+		}
+	}
+	return
+}
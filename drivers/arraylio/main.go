@@ -0,0 +1,1077 @@
+// Package arraylio is the array driver of a Linux host running the LIO
+// iSCSI target, managed through targetcli.
+//
+// The driver executes the targetcli, lvm and coreutils commands locally
+// if the array server keyword is the local host name, and through ssh
+// otherwise. The array state is read from the targetcli
+// saveconfig json file, saved after each change.
+package arraylio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kballard/go-shellquote"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"github.com/opensvc/om3/v3/core/array"
+	"github.com/opensvc/om3/v3/core/commoncmd"
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/funcopt"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/lock"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/san"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+	"github.com/opensvc/om3/v3/util/sshnode"
+)
+
+const (
+	BackstoreBlock  = "block"
+	BackstoreFileIO = "fileio"
+	BackstoreLV     = "lv"
+)
+
+var (
+	// SaveConfigFile is the targetcli saveconfig file, restored by the
+	// target service on boot.
+	SaveConfigFile = "/etc/target/saveconfig.json"
+
+	// NAAPrefix is the LIO NAA IEEE Registered Extended designator prefix,
+	// using the OpenFabrics IEEE company id.
+	NAAPrefix = "6001405"
+
+	// lockTimeout is the maximum duration to wait for the other disk
+	// changes of the array to complete.
+	lockTimeout = time.Minute
+)
+
+type (
+	Array struct {
+		*array.Array
+		log *plog.Logger
+	}
+
+	// Config is the subset of the targetcli saveconfig data used by the
+	// driver.
+	Config struct {
+		StorageObjects []StorageObject `json:"storage_objects"`
+		Targets        []Target        `json:"targets"`
+	}
+
+	StorageObject struct {
+		Name     string `json:"name"`
+		Plugin   string `json:"plugin"`
+		Dev      string `json:"dev"`
+		Size     int64  `json:"size,omitempty"`
+		WWN      string `json:"wwn"`
+		ReadOnly bool   `json:"readonly,omitempty"`
+	}
+
+	Target struct {
+		WWN    string `json:"wwn"`
+		Fabric string `json:"fabric"`
+		TPGs   []TPG  `json:"tpgs"`
+	}
+
+	TPG struct {
+		Tag      int       `json:"tag"`
+		Enable   bool      `json:"enable"`
+		LUNs     []LUN     `json:"luns"`
+		NodeACLs []NodeACL `json:"node_acls"`
+		Portals  []Portal  `json:"portals"`
+	}
+
+	LUN struct {
+		Index         int    `json:"index"`
+		StorageObject string `json:"storage_object"`
+	}
+
+	NodeACL struct {
+		NodeWWN    string      `json:"node_wwn"`
+		MappedLUNs []MappedLUN `json:"mapped_luns"`
+	}
+
+	MappedLUN struct {
+		Index        int  `json:"index"`
+		TPGLUN       int  `json:"tpg_lun"`
+		WriteProtect bool `json:"write_protect"`
+	}
+
+	Portal struct {
+		IPAddress string `json:"ip_address"`
+		Port      int    `json:"port"`
+	}
+
+	// Disk is a backstore, with its target lun and its initiators
+	// mapped luns.
+	Disk struct {
+		StorageObject StorageObject `json:"storage_object"`
+		LUN           *int          `json:"lun,omitempty"`
+		Mappings      []DiskMapping `json:"mappings"`
+	}
+
+	DiskMapping struct {
+		Initiator string `json:"initiator"`
+		Target    string `json:"target"`
+		LUN       int    `json:"lun"`
+	}
+
+	// AddDiskOptions defines the backstore to create. Dev is the image
+	// file path of a fileio backstore, or the block device path of a
+	// block backstore. VG is the volume group where to create the logical
+	// volume of a lv backstore.
+	AddDiskOptions struct {
+		Name      string
+		Backstore string
+		Dev       string
+		VG        string
+		Size      int64
+		Sparse    bool
+		Paths     san.Paths
+		LUN       *int
+	}
+
+	// DelDiskOptions defines the backstore to delete. Purge also removes
+	// the fileio image file, or the logical volume.
+	DelDiskOptions struct {
+		Name  string
+		Purge bool
+	}
+
+	MapDiskOptions struct {
+		Name  string
+		Paths san.Paths
+		LUN   *int
+	}
+
+	UnmapDiskOptions struct {
+		Name  string
+		Paths san.Paths
+	}
+)
+
+func init() {
+	driver.Register(drvID, NewDriver)
+}
+
+func NewDriver() array.Driver {
+	t := New()
+	var i any = t
+	return i.(array.Driver)
+}
+
+func New() *Array {
+	t := &Array{
+		Array: array.New(),
+	}
+	return t
+}
+
+func (t *Array) Log() *plog.Logger {
+	if t.log == nil {
+		t.log = plog.NewDefaultLogger().Attr("array", t.Name()).Attr("driver", "array.lio")
+	}
+	return t.log
+}
+
+func (t *Array) Run(args []string) error {
+	var (
+		backstore  string
+		dev        string
+		initiators []string
+		lun        int
+		mappings   []string
+		name       string
+		purge      bool
+		size       string
+		sparse     bool
+		vg         string
+	)
+	useFlagName := func(cmd *cobra.Command) {
+		cmd.Flags().StringVar(&name, "name", "", "backstore name")
+		_ = cmd.MarkFlagRequired("name")
+	}
+	useFlagMapping := func(cmd *cobra.Command) {
+		commoncmd.RawStringSliceVar(cmd.Flags(), &mappings, "mapping", []string{}, "<initiator>:<target>. Can be specified multiple times")
+		commoncmd.RawStringSliceVar(cmd.Flags(), &initiators, "initiator", []string{}, "initiator iqn, mapped through the array target. Can be specified multiple times")
+	}
+	useFlagLUN := func(cmd *cobra.Command) {
+		cmd.Flags().IntVar(&lun, "lun", -1, "target logical unit number")
+	}
+	getLUN := func() *int {
+		if lun < 0 {
+			return nil
+		}
+		return &lun
+	}
+	getPaths := func() (san.Paths, error) {
+		paths, err := san.ParseMapping(strings.Join(mappings, ","))
+		if err != nil {
+			return nil, err
+		}
+		for _, initiator := range initiators {
+			paths = append(paths, t.path(initiator))
+		}
+		return paths, nil
+	}
+	newParent := func() *cobra.Command {
+		cmd := &cobra.Command{
+			Use:           "array",
+			Short:         "Manage a linux lio iscsi target",
+			SilenceUsage:  true,
+			SilenceErrors: true,
+		}
+		return cmd
+	}
+	newAddCmd := func() *cobra.Command {
+		return &cobra.Command{
+			Use:   "add",
+			Short: "add commands",
+		}
+	}
+	newDelCmd := func() *cobra.Command {
+		return &cobra.Command{
+			Use:   "del",
+			Short: "del commands",
+		}
+	}
+	newMapCmd := func() *cobra.Command {
+		return &cobra.Command{
+			Use:   "map",
+			Short: "map commands",
+		}
+	}
+	newUnmapCmd := func() *cobra.Command {
+		return &cobra.Command{
+			Use:   "unmap",
+			Short: "unmap commands",
+		}
+	}
+	newGetCmd := func() *cobra.Command {
+		return &cobra.Command{
+			Use:   "get",
+			Short: "get commands",
+		}
+	}
+	newAddDiskCmd := func() *cobra.Command {
+		cmd := &cobra.Command{
+			Use:   "disk",
+			Short: "create a backstore and map it",
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				paths, err := getPaths()
+				if err != nil {
+					return err
+				}
+				opt := AddDiskOptions{
+					Name:      name,
+					Backstore: backstore,
+					Dev:       dev,
+					VG:        vg,
+					Sparse:    sparse,
+					Paths:     paths,
+					LUN:       getLUN(),
+				}
+				if size != "" {
+					if opt.Size, err = sizeconv.FromSize(size); err != nil {
+						return err
+					}
+				}
+				if data, err := t.AddDisk(cmd.Context(), opt); err != nil {
+					return err
+				} else {
+					return dump(data)
+				}
+			},
+		}
+		useFlagName(cmd)
+		useFlagMapping(cmd)
+		useFlagLUN(cmd)
+		cmd.Flags().StringVar(&backstore, "backstore", BackstoreFileIO, "backstore type: fileio, block or lv")
+		cmd.Flags().StringVar(&dev, "dev", "", "image file path of a fileio backstore, or device path of a block backstore")
+		cmd.Flags().StringVar(&vg, "vg", "", "volume group where to create the logical volume of a lv backstore")
+		cmd.Flags().StringVar(&size, "size", "", "size of a fileio or lv backstore. ex: 10g")
+		cmd.Flags().BoolVar(&sparse, "sparse", false, "create the fileio image file in sparse mode")
+		return cmd
+	}
+	newDelDiskCmd := func() *cobra.Command {
+		cmd := &cobra.Command{
+			Use:   "disk",
+			Short: "unmap and delete a backstore",
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				opt := DelDiskOptions{
+					Name:  name,
+					Purge: purge,
+				}
+				if data, err := t.DelDisk(cmd.Context(), opt); err != nil {
+					return err
+				} else {
+					return dump(data)
+				}
+			},
+		}
+		useFlagName(cmd)
+		cmd.Flags().BoolVar(&purge, "purge", false, "also remove the fileio image file or the logical volume")
+		return cmd
+	}
+	newMapDiskCmd := func() *cobra.Command {
+		cmd := &cobra.Command{
+			Use:   "disk",
+			Short: "map a backstore to initiators",
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				paths, err := getPaths()
+				if err != nil {
+					return err
+				}
+				opt := MapDiskOptions{
+					Name:  name,
+					Paths: paths,
+					LUN:   getLUN(),
+				}
+				if data, err := t.MapDisk(cmd.Context(), opt); err != nil {
+					return err
+				} else {
+					return dump(data)
+				}
+			},
+		}
+		useFlagName(cmd)
+		useFlagMapping(cmd)
+		useFlagLUN(cmd)
+		return cmd
+	}
+	newUnmapDiskCmd := func() *cobra.Command {
+		cmd := &cobra.Command{
+			Use:   "disk",
+			Short: "unmap a backstore from initiators",
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				paths, err := getPaths()
+				if err != nil {
+					return err
+				}
+				opt := UnmapDiskOptions{
+					Name:  name,
+					Paths: paths,
+				}
+				if data, err := t.UnmapDisk(cmd.Context(), opt); err != nil {
+					return err
+				} else {
+					return dump(data)
+				}
+			},
+		}
+		useFlagName(cmd)
+		useFlagMapping(cmd)
+		return cmd
+	}
+	newGetConfigCmd := func() *cobra.Command {
+		return &cobra.Command{
+			Use:   "config",
+			Short: "get the targetcli saved configuration",
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				if data, err := t.GetConfig(cmd.Context()); err != nil {
+					return err
+				} else {
+					return dump(data)
+				}
+			},
+		}
+	}
+	newGetDisksCmd := func() *cobra.Command {
+		return &cobra.Command{
+			Use:   "disks",
+			Short: "get the backstores and their mappings",
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				if data, err := t.GetDisks(cmd.Context()); err != nil {
+					return err
+				} else {
+					return dump(data)
+				}
+			},
+		}
+	}
+	parent := newParent()
+	// skip past the --array <array> arguments
+	parent.SetArgs(array.SkipArgs())
+	addCmd := newAddCmd()
+	addCmd.AddCommand(newAddDiskCmd())
+	parent.AddCommand(addCmd)
+	delCmd := newDelCmd()
+	delCmd.AddCommand(newDelDiskCmd())
+	parent.AddCommand(delCmd)
+	mapCmd := newMapCmd()
+	mapCmd.AddCommand(newMapDiskCmd())
+	parent.AddCommand(mapCmd)
+	unmapCmd := newUnmapCmd()
+	unmapCmd.AddCommand(newUnmapDiskCmd())
+	parent.AddCommand(unmapCmd)
+	getCmd := newGetCmd()
+	getCmd.AddCommand(newGetConfigCmd())
+	getCmd.AddCommand(newGetDisksCmd())
+	parent.AddCommand(getCmd)
+	return parent.Execute()
+}
+
+func dump(data any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "    ")
+	return enc.Encode(data)
+}
+
+func (t *Array) server() string {
+	return t.Config().GetString(t.Key("server"))
+}
+
+func (t *Array) username() string {
+	return t.Config().GetString(t.Key("username"))
+}
+
+func (t *Array) key() string {
+	return t.Config().GetString(t.Key("key"))
+}
+
+// Target returns the iqn of the array iscsi target.
+func (t *Array) Target() string {
+	return t.Config().GetString(t.Key("target"))
+}
+
+func (t *Array) path(initiator string) san.Path {
+	return san.Path{
+		Initiator: san.Initiator{Name: initiator, Type: san.ISCSI},
+		Target:    san.Target{Name: t.Target(), Type: san.ISCSI},
+	}
+}
+
+func (t *Array) isLocal() bool {
+	return t.server() == hostname.Hostname()
+}
+
+// withLock serializes the disk changes of the array, so the free lun and
+// mapped lun indexes read from the saved configuration are not allocated
+// twice.
+func (t *Array) withLock(intent string, f func() error) error {
+	p := filepath.Join(rawconfig.Paths.Lock, "array-"+t.Name()+".lock")
+	return lock.Func(p, lockTimeout, intent, f)
+}
+
+// exists returns true if the path exists on the array server.
+func (t *Array) exists(ctx context.Context, p string) bool {
+	_, err := t.run(ctx, "test", "-e", p)
+	return err == nil
+}
+
+// run executes the argv command on the array server, and returns its
+// stdout.
+func (t *Array) run(ctx context.Context, argv ...string) ([]byte, error) {
+	if t.isLocal() {
+		cmd := command.New(
+			command.WithContext(ctx),
+			command.WithName(argv[0]),
+			command.WithArgs(argv[1:]),
+			command.WithBufferedStdout(),
+			command.WithBufferedStderr(),
+			command.WithLogger(t.Log()),
+			command.WithCommandLogLevel(zerolog.DebugLevel),
+			command.WithStdoutLogLevel(zerolog.TraceLevel),
+			command.WithStderrLogLevel(zerolog.DebugLevel),
+		)
+		if err := cmd.Run(); err != nil {
+			return cmd.Stdout(), fmt.Errorf("%s: %w: %s", cmd, err, bytes.TrimSpace(cmd.Stderr()))
+		}
+		return cmd.Stdout(), nil
+	}
+	return t.runSSH(ctx, argv...)
+}
+
+func (t *Array) runSSH(ctx context.Context, argv ...string) ([]byte, error) {
+	var (
+		opts           []funcopt.O
+		stdout, stderr bytes.Buffer
+	)
+	if s := t.username(); s != "" {
+		opts = append(opts, sshnode.WithUser(s))
+	}
+	if s := t.key(); s != "" {
+		opts = append(opts, sshnode.WithPrivateKeyFiles(s))
+	}
+	server := t.server()
+	client, err := sshnode.NewClient(server, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("ssh %s: %w", server, err)
+	}
+	defer func() { _ = client.Close() }()
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("ssh %s: %w", server, err)
+	}
+	defer func() { _ = session.Close() }()
+
+	// the ssh session is not context aware: close it on context done.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = session.Close()
+		case <-done:
+		}
+	}()
+
+	session.Stdout = &stdout
+	session.Stderr = &stderr
+	s := shellquote.Join(argv...)
+	t.Log().Debugf("ssh %s %s", server, s)
+	if err := session.Run(s); err != nil {
+		return stdout.Bytes(), fmt.Errorf("ssh %s %s: %w: %s", server, s, err, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return stdout.Bytes(), nil
+}
+
+func (t *Array) targetcli(ctx context.Context, args ...string) error {
+	_, err := t.run(ctx, append([]string{"targetcli"}, args...)...)
+	return err
+}
+
+func (t *Array) saveConfig(ctx context.Context) error {
+	return t.targetcli(ctx, "saveconfig")
+}
+
+// GetConfig saves the running LIO configuration and returns the saved
+// data.
+func (t *Array) GetConfig(ctx context.Context) (*Config, error) {
+	if err := t.saveConfig(ctx); err != nil {
+		return nil, err
+	}
+	b, err := t.run(ctx, "cat", SaveConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	return parseConfig(b)
+}
+
+func parseConfig(b []byte) (*Config, error) {
+	var cfg Config
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", SaveConfigFile, err)
+	}
+	return &cfg, nil
+}
+
+// GetDisks returns the backstores, with their mappings through the array
+// target.
+func (t *Array) GetDisks(ctx context.Context) ([]Disk, error) {
+	cfg, err := t.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	l := make([]Disk, len(cfg.StorageObjects))
+	for i, so := range cfg.StorageObjects {
+		l[i] = cfg.disk(so, t.Target())
+	}
+	return l, nil
+}
+
+// GetDisk returns the backstore named name, with its mappings through the
+// array target.
+func (t *Array) GetDisk(ctx context.Context, name string) (*Disk, error) {
+	cfg, err := t.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	so := cfg.storageObject(name)
+	if so == nil {
+		return nil, fmt.Errorf("backstore %s not found", name)
+	}
+	disk := cfg.disk(*so, t.Target())
+	return &disk, nil
+}
+
+func (t *Array) AddDisk(ctx context.Context, opt AddDiskOptions) (disk *Disk, err error) {
+	err = t.withLock("add disk "+opt.Name, func() error {
+		disk, err = t.addDisk(ctx, opt)
+		return err
+	})
+	return
+}
+
+// addDisk creates the backstore and maps it. On error, the backstore, and
+// the logical volume or image file created for it, are removed.
+func (t *Array) addDisk(ctx context.Context, opt AddDiskOptions) (disk *Disk, err error) {
+	var undo []func() error
+	defer func() {
+		if err == nil {
+			return
+		}
+		for i := len(undo) - 1; i >= 0; i-- {
+			if e := undo[i](); e != nil {
+				t.Log().Warnf("add disk %s rollback: %s", opt.Name, e)
+			}
+		}
+	}()
+	if opt.Name == "" {
+		return nil, errors.New("name is required")
+	}
+	cfg, err := t.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.storageObject(opt.Name) != nil {
+		return nil, fmt.Errorf("backstore %s already exists", opt.Name)
+	}
+	wwn := uuid.New().String()
+	switch opt.Backstore {
+	case BackstoreFileIO:
+		if opt.Dev == "" {
+			return nil, errors.New("the image file path is required by a fileio backstore")
+		}
+		if opt.Size <= 0 {
+			return nil, errors.New("size is required by a fileio backstore")
+		}
+		if !t.exists(ctx, opt.Dev) {
+			undo = append(undo, func() error {
+				_, err := t.run(ctx, "rm", "-f", opt.Dev)
+				return err
+			})
+		}
+		err = t.targetcli(ctx, "/backstores/fileio", "create",
+			"name="+opt.Name,
+			"file_or_dev="+opt.Dev,
+			"size="+strconv.FormatInt(opt.Size, 10),
+			"sparse="+strconv.FormatBool(opt.Sparse),
+			"wwn="+wwn,
+		)
+	case BackstoreBlock:
+		if opt.Dev == "" {
+			return nil, errors.New("the device path is required by a block backstore")
+		}
+		err = t.targetcli(ctx, "/backstores/block", "create", "name="+opt.Name, "dev="+opt.Dev, "wwn="+wwn)
+	case BackstoreLV:
+		if opt.VG == "" {
+			return nil, errors.New("the volume group is required by a lv backstore")
+		}
+		if opt.Size <= 0 {
+			return nil, errors.New("size is required by a lv backstore")
+		}
+		if _, err := t.run(ctx, "lvcreate", "--yes", "-n", opt.Name, "-L", strconv.FormatInt(opt.Size, 10)+"b", opt.VG); err != nil {
+			return nil, err
+		}
+		undo = append(undo, func() error {
+			_, err := t.run(ctx, "lvremove", "-f", opt.VG+"/"+opt.Name)
+			return err
+		})
+		err = t.targetcli(ctx, "/backstores/block", "create", "name="+opt.Name, "dev=/dev/"+opt.VG+"/"+opt.Name, "wwn="+wwn)
+	default:
+		return nil, fmt.Errorf("invalid backstore type %q: must be %s, %s or %s", opt.Backstore, BackstoreFileIO, BackstoreBlock, BackstoreLV)
+	}
+	if err != nil {
+		return nil, err
+	}
+	undo = append(undo, func() error {
+		// deleting the backstore also deletes its luns and mapped luns
+		if err := t.targetcli(ctx, "/backstores/"+backstorePlugin(opt.Backstore), "delete", opt.Name); err != nil {
+			return err
+		}
+		return t.saveConfig(ctx)
+	})
+	return t.mapDisk(ctx, MapDiskOptions{
+		Name:  opt.Name,
+		Paths: opt.Paths,
+		LUN:   opt.LUN,
+	})
+}
+
+// backstorePlugin returns the targetcli backstore plugin of the
+// backstore type.
+func backstorePlugin(backstore string) string {
+	if backstore == BackstoreLV {
+		return BackstoreBlock
+	}
+	return backstore
+}
+
+func (t *Array) DelDisk(ctx context.Context, opt DelDiskOptions) (disk *Disk, err error) {
+	err = t.withLock("del disk "+opt.Name, func() error {
+		disk, err = t.delDisk(ctx, opt)
+		return err
+	})
+	return
+}
+
+func (t *Array) delDisk(ctx context.Context, opt DelDiskOptions) (*Disk, error) {
+	cfg, err := t.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	so := cfg.storageObject(opt.Name)
+	if so == nil {
+		return nil, fmt.Errorf("backstore %s not found", opt.Name)
+	}
+	disk := cfg.disk(*so, t.Target())
+	tpg := cfg.tpg(t.Target())
+	if tpg != nil && disk.LUN != nil {
+		tpgPath := t.tpgPath(*tpg)
+		for _, m := range disk.Mappings {
+			if err := t.delMappedLUN(ctx, *tpg, m.Initiator, m.LUN); err != nil {
+				return nil, err
+			}
+		}
+		if err := t.targetcli(ctx, tpgPath+"/luns", "delete", strconv.Itoa(*disk.LUN)); err != nil {
+			return nil, err
+		}
+	}
+	// deleting the backstore also deletes its luns in other targets
+	if err := t.targetcli(ctx, "/backstores/"+so.Plugin, "delete", so.Name); err != nil {
+		return nil, err
+	}
+	if opt.Purge {
+		if err := t.purge(ctx, *so); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.saveConfig(ctx); err != nil {
+		return nil, err
+	}
+	return &disk, nil
+}
+
+// purge removes the image file of a fileio backstore, or the logical
+// volume of a block backstore. The block device of a block backstore not
+// backed by a logical volume is left untouched.
+func (t *Array) purge(ctx context.Context, so StorageObject) error {
+	switch so.Plugin {
+	case BackstoreFileIO:
+		_, err := t.run(ctx, "rm", "-f", so.Dev)
+		return err
+	case BackstoreBlock:
+		if _, err := t.run(ctx, "lvs", so.Dev); err != nil {
+			t.Log().Infof("%s is not a logical volume, leave it", so.Dev)
+			return nil
+		}
+		_, err := t.run(ctx, "lvremove", "-f", so.Dev)
+		return err
+	}
+	return nil
+}
+
+// MapDisk creates the backstore lun in the array target, if not already
+// created, and maps it to the initiators of the paths. The lun is mapped
+// to the initiators at the target lun index if free.
+func (t *Array) MapDisk(ctx context.Context, opt MapDiskOptions) (disk *Disk, err error) {
+	err = t.withLock("map disk "+opt.Name, func() error {
+		disk, err = t.mapDisk(ctx, opt)
+		return err
+	})
+	return
+}
+
+func (t *Array) mapDisk(ctx context.Context, opt MapDiskOptions) (*Disk, error) {
+	cfg, err := t.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	so := cfg.storageObject(opt.Name)
+	if so == nil {
+		return nil, fmt.Errorf("backstore %s not found", opt.Name)
+	}
+	if err := t.validatePaths(opt.Paths); err != nil {
+		return nil, err
+	}
+	tpg := cfg.tpg(t.Target())
+	if tpg == nil {
+		if err := t.targetcli(ctx, "/iscsi", "create", t.Target()); err != nil {
+			return nil, err
+		}
+		if cfg, err = t.GetConfig(ctx); err != nil {
+			return nil, err
+		}
+		if tpg = cfg.tpg(t.Target()); tpg == nil {
+			return nil, fmt.Errorf("target %s has no portal group", t.Target())
+		}
+	}
+	tpgPath := t.tpgPath(*tpg)
+	disk := cfg.disk(*so, t.Target())
+	if disk.LUN == nil {
+		args := []string{tpgPath + "/luns", "create", "/backstores/" + so.Plugin + "/" + so.Name}
+		if opt.LUN != nil {
+			args = append(args, "lun="+strconv.Itoa(*opt.LUN))
+		}
+		args = append(args, "add_mapped_luns=false")
+		if err := t.targetcli(ctx, args...); err != nil {
+			return nil, err
+		}
+		if cfg, err = t.GetConfig(ctx); err != nil {
+			return nil, err
+		}
+		tpg = cfg.tpg(t.Target())
+		disk = cfg.disk(*so, t.Target())
+		if disk.LUN == nil {
+			return nil, fmt.Errorf("backstore %s lun not found in target %s", so.Name, t.Target())
+		}
+	}
+	for _, p := range opt.Paths {
+		initiator := p.Initiator.Name
+		acl := tpg.nodeACL(initiator)
+		if acl == nil {
+			if err := t.targetcli(ctx, tpgPath+"/acls", "create", initiator, "add_mapped_luns=false"); err != nil {
+				return nil, err
+			}
+			acl = &NodeACL{NodeWWN: initiator}
+		}
+		if acl.mappedLUN(*disk.LUN) != nil {
+			continue
+		}
+		index := acl.freeIndex(*disk.LUN)
+		if err := t.targetcli(ctx, tpgPath+"/acls/"+initiator, "create",
+			"mapped_lun="+strconv.Itoa(index),
+			"tpg_lun_or_backstore="+strconv.Itoa(*disk.LUN),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return t.GetDisk(ctx, opt.Name)
+}
+
+// UnmapDisk removes the backstore mapped luns of the initiators of the
+// paths, and the initiator acls left without mapped lun.
+func (t *Array) UnmapDisk(ctx context.Context, opt UnmapDiskOptions) (disk *Disk, err error) {
+	err = t.withLock("unmap disk "+opt.Name, func() error {
+		disk, err = t.unmapDisk(ctx, opt)
+		return err
+	})
+	return
+}
+
+func (t *Array) unmapDisk(ctx context.Context, opt UnmapDiskOptions) (*Disk, error) {
+	cfg, err := t.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	so := cfg.storageObject(opt.Name)
+	if so == nil {
+		return nil, fmt.Errorf("backstore %s not found", opt.Name)
+	}
+	if err := t.validatePaths(opt.Paths); err != nil {
+		return nil, err
+	}
+	tpg := cfg.tpg(t.Target())
+	disk := cfg.disk(*so, t.Target())
+	if tpg == nil || disk.LUN == nil {
+		return &disk, nil
+	}
+	for _, m := range disk.Mappings {
+		if len(opt.Paths.WithInitiatorName(m.Initiator)) == 0 {
+			continue
+		}
+		if err := t.delMappedLUN(ctx, *tpg, m.Initiator, m.LUN); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.saveConfig(ctx); err != nil {
+		return nil, err
+	}
+	return t.GetDisk(ctx, opt.Name)
+}
+
+// delMappedLUN removes the initiator mapped lun, and the initiator acl if
+// it has no other mapped lun.
+func (t *Array) delMappedLUN(ctx context.Context, tpg TPG, initiator string, index int) error {
+	tpgPath := t.tpgPath(tpg)
+	if err := t.targetcli(ctx, tpgPath+"/acls/"+initiator, "delete", strconv.Itoa(index)); err != nil {
+		return err
+	}
+	if acl := tpg.nodeACL(initiator); acl != nil && len(acl.MappedLUNs) > 1 {
+		return nil
+	}
+	return t.targetcli(ctx, tpgPath+"/acls", "delete", initiator)
+}
+
+func (t *Array) validatePaths(paths san.Paths) error {
+	for _, p := range paths {
+		if p.Target.Name != t.Target() {
+			return fmt.Errorf("path %s: target %s is not the array target %s", p, p.Target.Name, t.Target())
+		}
+	}
+	return nil
+}
+
+func (t *Array) tpgPath(tpg TPG) string {
+	return fmt.Sprintf("/iscsi/%s/tpg%d", t.Target(), tpg.Tag)
+}
+
+// DiskID returns the wwid of the disk, as seen by the initiators.
+func (t *Array) DiskID(disk Disk) string {
+	return WWNToNAA(disk.StorageObject.WWN)
+}
+
+// DiskPaths returns the san paths of the disk mappings.
+func (t *Array) DiskPaths(disk Disk) san.Paths {
+	paths := make(san.Paths, len(disk.Mappings))
+	for i, m := range disk.Mappings {
+		paths[i] = san.Path{
+			Initiator: san.Initiator{Name: m.Initiator, Type: san.ISCSI},
+			Target:    san.Target{Name: m.Target, Type: san.ISCSI},
+		}
+	}
+	return paths
+}
+
+// GetDirUsage returns the size and free space in bytes of the filesystem
+// hosting dir on the array server.
+func (t *Array) GetDirUsage(ctx context.Context, dir string) (size, free int64, err error) {
+	var b []byte
+	if b, err = t.run(ctx, "df", "-P", "-B1", dir); err != nil {
+		return
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) < 4 {
+		err = fmt.Errorf("unexpected df output: %s", b)
+		return
+	}
+	if size, err = strconv.ParseInt(fields[1], 10, 64); err != nil {
+		return
+	}
+	free, err = strconv.ParseInt(fields[3], 10, 64)
+	return
+}
+
+// GetVGUsage returns the size and free space in bytes of the volume group
+// on the array server.
+func (t *Array) GetVGUsage(ctx context.Context, vg string) (size, free int64, err error) {
+	var b []byte
+	if b, err = t.run(ctx, "vgs", "--noheadings", "--units", "b", "--nosuffix", "-o", "vg_size,vg_free", vg); err != nil {
+		return
+	}
+	fields := strings.Fields(string(b))
+	if len(fields) != 2 {
+		err = fmt.Errorf("unexpected vgs output: %s", b)
+		return
+	}
+	if size, err = strconv.ParseInt(fields[0], 10, 64); err != nil {
+		return
+	}
+	free, err = strconv.ParseInt(fields[1], 10, 64)
+	return
+}
+
+// WWNToNAA returns the NAA designator LIO derives from a backstore unit
+// serial: the prefix followed by the first 25 hex digits of the serial.
+func WWNToNAA(wwn string) string {
+	var sb strings.Builder
+	sb.WriteString(NAAPrefix)
+	n := 0
+	for _, c := range strings.ToLower(wwn) {
+		if n == 25 {
+			break
+		}
+		if (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') {
+			sb.WriteRune(c)
+			n++
+		}
+	}
+	return sb.String()
+}
+
+func (t Config) storageObject(name string) *StorageObject {
+	for _, so := range t.StorageObjects {
+		if so.Name == name {
+			return &so
+		}
+	}
+	return nil
+}
+
+// tpg returns the first portal group of the iscsi target wwn.
+func (t Config) tpg(wwn string) *TPG {
+	for _, target := range t.Targets {
+		if target.WWN != wwn || len(target.TPGs) == 0 {
+			continue
+		}
+		tpg := target.TPGs[0]
+		return &tpg
+	}
+	return nil
+}
+
+// disk returns the storage object with its lun and mappings in the target
+// wwn.
+func (t Config) disk(so StorageObject, wwn string) Disk {
+	disk := Disk{
+		StorageObject: so,
+		Mappings:      make([]DiskMapping, 0),
+	}
+	tpg := t.tpg(wwn)
+	if tpg == nil {
+		return disk
+	}
+	soPath := "/backstores/" + so.Plugin + "/" + so.Name
+	for _, lun := range tpg.LUNs {
+		if lun.StorageObject == soPath {
+			index := lun.Index
+			disk.LUN = &index
+			break
+		}
+	}
+	if disk.LUN == nil {
+		return disk
+	}
+	for _, acl := range tpg.NodeACLs {
+		if m := acl.mappedLUN(*disk.LUN); m != nil {
+			disk.Mappings = append(disk.Mappings, DiskMapping{
+				Initiator: acl.NodeWWN,
+				Target:    wwn,
+				LUN:       m.Index,
+			})
+		}
+	}
+	return disk
+}
+
+func (t TPG) nodeACL(wwn string) *NodeACL {
+	for _, acl := range t.NodeACLs {
+		if acl.NodeWWN == wwn {
+			return &acl
+		}
+	}
+	return nil
+}
+
+// mappedLUN returns the acl mapped lun pointing the target lun index.
+func (t NodeACL) mappedLUN(tpgLUN int) *MappedLUN {
+	for _, m := range t.MappedLUNs {
+		if m.TPGLUN == tpgLUN {
+			return &m
+		}
+	}
+	return nil
+}
+
+// freeIndex returns the preferred mapped lun index if not used by the acl,
+// or the lowest free index.
+func (t NodeACL) freeIndex(preferred int) int {
+	used := make([]int, len(t.MappedLUNs))
+	for i, m := range t.MappedLUNs {
+		used[i] = m.Index
+	}
+	if !slices.Contains(used, preferred) {
+		return preferred
+	}
+	for i := 0; ; i++ {
+		if !slices.Contains(used, i) {
+			return i
+		}
+	}
+}
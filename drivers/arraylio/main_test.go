@@ -0,0 +1,69 @@
+package arraylio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var saveConfig = []byte(`{
+  "storage_objects": [
+    {"dev": "/srv/lio/d1.img", "name": "d1", "plugin": "fileio", "size": 1073741824, "wwn": "0b7ba4d1-ba4e-4b1c-8c4b-1b2e5f6a7d8e"},
+    {"dev": "/dev/vg1/d2", "name": "d2", "plugin": "block", "readonly": false, "wwn": "e5a1c2d3-0000-4000-8000-000000000002"}
+  ],
+  "targets": [
+    {
+      "fabric": "iscsi",
+      "wwn": "iqn.2003-01.org.linux-iscsi.lio1:sn.opensvc",
+      "tpgs": [
+        {
+          "enable": true,
+          "tag": 1,
+          "luns": [
+            {"index": 0, "storage_object": "/backstores/fileio/d1"},
+            {"index": 1, "storage_object": "/backstores/block/d2"}
+          ],
+          "node_acls": [
+            {"node_wwn": "iqn.1994-05.com.redhat:n1", "mapped_luns": [{"index": 0, "tpg_lun": 0}, {"index": 1, "tpg_lun": 1}]},
+            {"node_wwn": "iqn.1994-05.com.redhat:n2", "mapped_luns": [{"index": 1, "tpg_lun": 0}]}
+          ],
+          "portals": [{"ip_address": "0.0.0.0", "port": 3260}]
+        }
+      ]
+    }
+  ]
+}`)
+
+func TestConfigDisk(t *testing.T) {
+	target := "iqn.2003-01.org.linux-iscsi.lio1:sn.opensvc"
+	cfg, err := parseConfig(saveConfig)
+	require.NoError(t, err)
+
+	so := cfg.storageObject("d1")
+	require.NotNil(t, so)
+	disk := cfg.disk(*so, target)
+	require.NotNil(t, disk.LUN)
+	require.Equal(t, 0, *disk.LUN)
+	require.Equal(t, []DiskMapping{
+		{Initiator: "iqn.1994-05.com.redhat:n1", Target: target, LUN: 0},
+		{Initiator: "iqn.1994-05.com.redhat:n2", Target: target, LUN: 1},
+	}, disk.Mappings)
+
+	disk = cfg.disk(*so, "iqn.2003-01.org.linux-iscsi.lio1:sn.other")
+	require.Nil(t, disk.LUN)
+	require.Empty(t, disk.Mappings)
+
+	require.Nil(t, cfg.storageObject("d3"))
+}
+
+func TestNodeACLFreeIndex(t *testing.T) {
+	acl := NodeACL{MappedLUNs: []MappedLUN{{Index: 0}, {Index: 1}, {Index: 3}}}
+	require.Equal(t, 4, acl.freeIndex(4))
+	require.Equal(t, 2, acl.freeIndex(1))
+	require.Equal(t, 0, NodeACL{}.freeIndex(0))
+}
+
+func TestWWNToNAA(t *testing.T) {
+	require.Equal(t, "60014050b7ba4d1ba4e4b1c8c4b1b2e5", WWNToNAA("0b7ba4d1-ba4e-4b1c-8c4b-1b2e5f6a7d8e"))
+	require.Equal(t, "6001405abc", WWNToNAA("ABC"))
+}
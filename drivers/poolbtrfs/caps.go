@@ -0,0 +1,23 @@
+//go:build linux
+
+package poolbtrfs
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/util/btrfs"
+	"github.com/opensvc/om3/v3/util/capabilities"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner(ctx context.Context) ([]string, error) {
+	volDrvID := driver.NewID(driver.GroupVolume, drvID.Name)
+	if btrfs.IsCapable() {
+		return []string{drvID.Cap(), volDrvID.Cap()}, nil
+	}
+	return []string{}, nil
+}
@@ -0,0 +1,96 @@
+//go:build linux
+
+package poolbtrfs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/util/btrfs"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+type (
+	T struct {
+		pool.T
+	}
+)
+
+var (
+	drvID = driver.NewID(driver.GroupPool, "btrfs")
+)
+
+func init() {
+	driver.Register(drvID, NewPooler)
+}
+
+func NewPooler() pool.Pooler {
+	t := New()
+	var i interface{} = t
+	return i.(pool.Pooler)
+}
+
+func New() *T {
+	t := T{}
+	return &t
+}
+
+func (t T) Head() string {
+	return t.dev()
+}
+
+func (t T) dev() string {
+	return t.GetString("dev")
+}
+
+func (t T) Capabilities() pool.Capabilities {
+	return pool.Capabilities{
+		pool.CapFile,
+		pool.CapROO,
+		pool.CapROX,
+		pool.CapRWO,
+		pool.CapRWX,
+		pool.CapSnap,
+	}
+}
+
+func (t T) Usage(ctx context.Context) (pool.Usage, error) {
+	fs := btrfs.Filesystem{Device: t.dev()}
+	e, err := fs.Usage(ctx)
+	if err != nil {
+		return pool.Usage{}, err
+	}
+	usage := pool.Usage{
+		Size: e.Size,
+		Free: e.Free,
+		Used: e.Used,
+	}
+	return usage, nil
+}
+
+// Translate returns the keywords of a fs.btrfs resource mounting a
+// subvolume named after the volume, with a quota group limit set to the
+// volume size.
+func (t *T) Translate(name string, size int64, shared bool) ([]string, error) {
+	mnt := pool.MountPointFromName(name)
+	data := []string{
+		"fs#0.type=btrfs",
+		"fs#0.dev=" + t.dev(),
+		"fs#0.subvol=" + name,
+		"fs#0.mnt=" + mnt,
+		"fs#0.size=" + sizeconv.ExactBSizeCompact(float64(size)),
+	}
+	if mkfsOpt := t.GetString("mkfs_opt"); mkfsOpt != "" {
+		data = append(data, "fs#0.mkfs_opt="+mkfsOpt)
+	}
+	if mntOpt := t.GetString("mnt_opt"); mntOpt != "" {
+		data = append(data, "fs#0.mnt_opt="+mntOpt)
+	}
+	return data, nil
+}
+
+func (t *T) BlkTranslate(name string, size int64, shared bool) ([]string, error) {
+	return nil, fmt.Errorf("btrfs pool does not support block volumes")
+}
@@ -0,0 +1,17 @@
+//go:build linux || solaris
+
+package poollio
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/util/capabilities"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner(ctx context.Context) ([]string, error) {
+	return []string{drvID.Cap()}, nil
+}
@@ -0,0 +1,194 @@
+//go:build linux || solaris
+
+package poollio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/pool"
+	"github.com/opensvc/om3/v3/core/xconfig"
+	"github.com/opensvc/om3/v3/drivers/arraylio"
+	"github.com/opensvc/om3/v3/util/san"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+type (
+	T struct {
+		pool.T
+	}
+)
+
+var (
+	drvID = driver.NewID(driver.GroupPool, "lio")
+)
+
+func init() {
+	driver.Register(drvID, NewPooler)
+}
+
+func NewPooler() pool.Pooler {
+	t := New()
+	var i interface{} = t
+	return i.(pool.Pooler)
+}
+
+func New() *T {
+	t := T{}
+	return &t
+}
+
+func (t T) Head() string {
+	return fmt.Sprintf("array://%s/%s", t.arrayName(), t.diskgroup())
+}
+
+// diskgroup is the directory hosting the fileio image files, or the
+// volume group hosting the logical volumes.
+func (t T) diskgroup() string {
+	return t.GetString("diskgroup")
+}
+
+func (t T) backstore() string {
+	return t.GetString("backstore")
+}
+
+func (t T) sparse() bool {
+	return t.GetBool("sparse")
+}
+
+func (t T) arrayName() string {
+	return t.GetString("array")
+}
+
+func (t T) Capabilities() pool.Capabilities {
+	return pool.Capabilities{
+		pool.CapBlk,
+		pool.CapFile,
+		pool.CapMove,
+		pool.CapROO,
+		pool.CapROX,
+		pool.CapRWO,
+		pool.CapRWX,
+		pool.CapShared,
+	}
+}
+
+func (t T) Usage(ctx context.Context) (pool.Usage, error) {
+	var (
+		size, free int64
+		err        error
+	)
+	usage := pool.Usage{
+		Shared: true,
+	}
+	a := t.array()
+	switch t.backstore() {
+	case arraylio.BackstoreLV:
+		size, free, err = a.GetVGUsage(ctx, t.diskgroup())
+	default:
+		size, free, err = a.GetDirUsage(ctx, t.diskgroup())
+	}
+	if err != nil {
+		return usage, err
+	}
+	usage.Size = size
+	usage.Free = free
+	usage.Used = size - free
+	return usage, nil
+}
+
+func (t T) array() *arraylio.Array {
+	a := arraylio.New()
+	a.SetName(t.arrayName())
+	a.SetConfig(t.Config().(*xconfig.T))
+	return a
+}
+
+func (t *T) Translate(name string, size int64, shared bool) ([]string, error) {
+	data, err := t.BlkTranslate(name, size, shared)
+	if err != nil {
+		return nil, err
+	}
+	fs := pool.FS{
+		Pool:      t,
+		Name:      name,
+		Shared:    shared,
+		FsIndex:   1,
+		DiskIndex: 0,
+		OnDisk:    "disk#0",
+	}
+	data = append(data, fs.Keywords()...)
+	return data, nil
+}
+
+func (t *T) BlkTranslate(name string, size int64, shared bool) ([]string, error) {
+	data := []string{
+		"disk#0.type=disk",
+		"disk#0.name=" + name,
+		"disk#0.scsireserv=true",
+		"shared=" + fmt.Sprint(shared),
+		"size=" + sizeconv.ExactBSizeCompact(float64(size)),
+	}
+	return data, nil
+}
+
+func (t *T) GetTargets(ctx context.Context) (san.Targets, error) {
+	return san.Targets{
+		{
+			Name: t.array().Target(),
+			Type: san.ISCSI,
+		},
+	}, nil
+}
+
+func (t *T) DeleteDisk(ctx context.Context, name, wwid string) ([]pool.Disk, error) {
+	disk := pool.Disk{}
+	a := t.array()
+	drvDisk, err := a.DelDisk(ctx, arraylio.DelDiskOptions{
+		Name:  name,
+		Purge: true,
+	})
+	if err != nil {
+		return []pool.Disk{}, err
+	}
+	disk.Driver = drvDisk
+	disk.ID = a.DiskID(*drvDisk)
+	disk.Paths = a.DiskPaths(*drvDisk)
+	return []pool.Disk{disk}, nil
+}
+
+func (t *T) CreateDisk(ctx context.Context, name string, size int64, nodenames []string) ([]pool.Disk, error) {
+	disk := pool.Disk{}
+	paths, err := pool.GetPaths(ctx, t, nodenames, san.ISCSI)
+	if err != nil {
+		return []pool.Disk{}, err
+	}
+	if len(paths) == 0 {
+		return []pool.Disk{}, errors.New("no mapping in request. cowardly refuse to create a disk that can not be mapped")
+	}
+	a := t.array()
+	opt := arraylio.AddDiskOptions{
+		Name:      name,
+		Backstore: t.backstore(),
+		Size:      size,
+		Sparse:    t.sparse(),
+		Paths:     paths,
+	}
+	switch opt.Backstore {
+	case arraylio.BackstoreLV:
+		opt.VG = t.diskgroup()
+	default:
+		opt.Dev = filepath.Join(t.diskgroup(), name+".img")
+	}
+	drvDisk, err := a.AddDisk(ctx, opt)
+	if err != nil {
+		return []pool.Disk{}, err
+	}
+	disk.Driver = drvDisk
+	disk.ID = a.DiskID(*drvDisk)
+	disk.Paths = a.DiskPaths(*drvDisk)
+	return []pool.Disk{disk}, nil
+}
@@ -24,9 +24,11 @@ import (
 	"github.com/opensvc/om3/v3/core/vpath"
 	"github.com/opensvc/om3/v3/util/command"
 	"github.com/opensvc/om3/v3/util/converters"
+	"github.com/opensvc/om3/v3/util/envprovider"
 	"github.com/opensvc/om3/v3/util/executable"
 	"github.com/opensvc/om3/v3/util/funcopt"
 	"github.com/opensvc/om3/v3/util/pg"
+	"github.com/opensvc/om3/v3/util/proc"
 	"github.com/opensvc/om3/v3/util/retcodes"
 	"github.com/opensvc/om3/v3/util/ulimit"
 	"github.com/opensvc/om3/v3/util/usergroup"
@@ -483,3 +485,53 @@ func (t *T) NetNSPath(ctx context.Context) (string, error) {
 		return "", fmt.Errorf("resource %s pointed by the netns keyword does not expose a netns path", t.NetNS)
 	}
 }
+
+// Drift implements resource.Drifter. It returns the configured environment
+// variables whose value differs in the environment of the running processes
+// of the resource. The secret values are masked.
+func (t *T) Drift(ctx context.Context) (resource.Drifts, error) {
+	procs, err := proc.All()
+	if err != nil {
+		return nil, err
+	}
+	procs = procs.FilterByEnvList([]string{"OPENSVC_ID", "OPENSVC_SVC_ID"}, t.ObjectID.String())
+	procs = procs.FilterByEnv("OPENSVC_RID", t.RID())
+	if procs.Len() == 0 {
+		return nil, nil
+	}
+	env := make([]string, 0)
+	env = append(env, t.Env...)
+	if l, err := envprovider.From(t.ConfigsEnv, t.Path.Namespace, "cfg"); err != nil {
+		return nil, err
+	} else {
+		env = append(env, l...)
+	}
+	secretEnv, err := envprovider.From(t.SecretsEnv, t.Path.Namespace, "sec")
+	if err != nil {
+		return nil, err
+	}
+	p := procs.Procs()[0]
+	current := p.Env()
+	var l resource.Drifts
+	compare := func(kv string, masked bool) {
+		name, target, ok := strings.Cut(kv, "=")
+		if !ok {
+			return
+		}
+		if v, ok := current[name]; ok && v == target {
+			return
+		}
+		value := current[name]
+		if masked {
+			value, target = "***", "***"
+		}
+		l = append(l, resource.Drift{Attr: "environment " + name, Current: value, Target: target})
+	}
+	for _, kv := range env {
+		compare(kv, false)
+	}
+	for _, kv := range secretEnv {
+		compare(kv, true)
+	}
+	return l, nil
+}
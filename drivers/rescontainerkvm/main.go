@@ -240,11 +240,18 @@ func (t *T) undefine(ctx context.Context) error {
 	return cmd.Run()
 }
 
-func (t *T) migrate(ctx context.Context, to string) error {
+// destinationURI returns the libvirt connection uri of the destination
+// node of a live migration.
+func (t *T) destinationURI(to string) string {
 	toUri := fmt.Sprintf("qemu+ssh://%s/system", to)
 	if sshKeyFile := t.GetSSHKeyFile(); sshKeyFile != "" {
 		toUri += fmt.Sprintf("?keyfile=%s", sshKeyFile)
 	}
+	return toUri
+}
+
+func (t *T) migrate(ctx context.Context, to string) error {
+	toUri := t.destinationURI(to)
 	cmd := command.New(
 		command.WithContext(ctx),
 		command.WithName("virsh"),
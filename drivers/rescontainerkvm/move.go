@@ -0,0 +1,149 @@
+package rescontainerkvm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/antchfx/xmlquery"
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+// MoveCheck verifies the destination hypervisor can host the running
+// domain: the domain cpu model must be supported by the destination host
+// cpu, and the destination host must have enough free memory.
+func (t *T) MoveCheck(ctx context.Context, to string) error {
+	if v, err := t.isUp(ctx); err != nil {
+		return err
+	} else if !v {
+		t.Log().Infof("container %s is not running, skip move checks", t.Name)
+		return nil
+	}
+	b, err := t.migratableXML(ctx)
+	if err != nil {
+		return err
+	}
+	if err := t.moveCheckCPU(ctx, to, b); err != nil {
+		return err
+	}
+	if err := t.moveCheckMemory(ctx, to, b); err != nil {
+		return err
+	}
+	return nil
+}
+
+// migratableXML returns the running domain definition, stripped of the
+// host-specific information.
+func (t *T) migratableXML(ctx context.Context) ([]byte, error) {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("virsh"),
+		command.WithVarArgs("dumpxml", "--migratable", t.Name),
+		command.WithLogger(t.Log()),
+		command.WithBufferedStdout(),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Output()
+}
+
+func (t *T) moveCheckCPU(ctx context.Context, to string, b []byte) error {
+	f, err := os.CreateTemp("", "kvm-move-check-*.xml")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("virsh"),
+		command.WithVarArgs("-c", t.destinationURI(to), "hypervisor-cpu-compare", "--error", f.Name()),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("container %s cpu is not compatible with the %s host cpu: %w", t.Name, to, err)
+	}
+	return nil
+}
+
+func (t *T) moveCheckMemory(ctx context.Context, to string, b []byte) error {
+	need, err := domainMemory(b)
+	if err != nil {
+		return err
+	}
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("virsh"),
+		command.WithVarArgs("-c", t.destinationURI(to), "freecell", "--all"),
+		command.WithLogger(t.Log()),
+		command.WithBufferedStdout(),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	out, err := cmd.Output()
+	if err != nil {
+		return err
+	}
+	free, err := parseFreecellTotal(out)
+	if err != nil {
+		return err
+	}
+	if free < need {
+		return fmt.Errorf("container %s needs %s of memory, the %s host has %s free",
+			t.Name, sizeconv.BSizeCompact(float64(need)), to, sizeconv.BSizeCompact(float64(free)))
+	}
+	t.Log().Infof("container %s needs %s of memory, the %s host has %s free",
+		t.Name, sizeconv.BSizeCompact(float64(need)), to, sizeconv.BSizeCompact(float64(free)))
+	return nil
+}
+
+// domainMemory returns the current memory of the domain, in bytes.
+func domainMemory(b []byte) (int64, error) {
+	doc, err := xmlquery.Parse(bytes.NewReader(b))
+	if err != nil {
+		return 0, err
+	}
+	e := xmlquery.FindOne(doc, "//domain/currentMemory")
+	if e == nil {
+		e = xmlquery.FindOne(doc, "//domain/memory")
+	}
+	if e == nil {
+		return 0, fmt.Errorf("domain memory not found")
+	}
+	unit := e.SelectAttr("unit")
+	if unit == "" {
+		unit = "KiB"
+	}
+	return sizeconv.FromSize(strings.TrimSpace(e.InnerText()) + unit)
+}
+
+// parseFreecellTotal returns the total free memory, in bytes, from the
+// "virsh freecell --all" output:
+//
+//	    0:     1638400 KiB
+//	--------------------
+//	Total:     1638400 KiB
+func parseFreecellTotal(b []byte) (int64, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || fields[0] != "Total:" {
+			continue
+		}
+		return sizeconv.FromSize(fields[1] + fields[2])
+	}
+	return 0, fmt.Errorf("total free memory not found in freecell output")
+}
@@ -0,0 +1,21 @@
+package rescontainernspawn
+
+import (
+	"context"
+	"os/exec"
+
+	"github.com/opensvc/om3/v3/util/capabilities"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner(ctx context.Context) ([]string, error) {
+	for _, name := range []string{"systemd-nspawn", "systemd-run", "machinectl", "nsenter"} {
+		if _, err := exec.LookPath(name); err != nil {
+			return []string{}, nil
+		}
+	}
+	return []string{drvID.Cap()}, nil
+}
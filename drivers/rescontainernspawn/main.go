@@ -0,0 +1,666 @@
+package rescontainernspawn
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/actionrollback"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/core/provisioned"
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/core/vpath"
+	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/file"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/ping"
+	"github.com/opensvc/om3/v3/util/systemd"
+)
+
+const (
+	machinesDir = "/var/lib/machines"
+	defaultPATH = "PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+)
+
+var _ resource.Encaper = (*T)(nil)
+
+type (
+	T struct {
+		resource.T
+		resource.Restart
+		resource.SSH
+		resource.SCSIPersistentReservation
+		Path           naming.Path    `json:"path"`
+		Nodes          []string       `json:"nodes"`
+		SCSIReserv     bool           `json:"scsireserv"`
+		PromoteRW      bool           `json:"promote_rw"`
+		OsvcRootPath   string         `json:"osvc_root_path"`
+		GuestOS        string         `json:"guest_os"`
+		Name           string         `json:"name"`
+		Hostname       string         `json:"hostname"`
+		RootDir        string         `json:"rootfs"`
+		Image          string         `json:"image"`
+		Binds          []string       `json:"binds"`
+		PrivateNetwork bool           `json:"private_network"`
+		RunArgs        []string       `json:"run_args"`
+		RCmd           []string       `json:"rcmd"`
+		StartTimeout   *time.Duration `json:"start_timeout"`
+		StopTimeout    *time.Duration `json:"stop_timeout"`
+	}
+)
+
+func New() resource.Driver {
+	t := &T{}
+	return t
+}
+
+func (t *T) Start(ctx context.Context) error {
+	if v, err := t.isUp(ctx); err != nil {
+		return err
+	} else if v {
+		t.Log().Infof("container %s is already up", t.Name)
+		return nil
+	}
+	if err := t.start(ctx); err != nil {
+		return err
+	}
+	actionrollback.Register(ctx, func(ctx context.Context) error {
+		return t.Stop(ctx)
+	})
+	return t.waitUp(ctx)
+}
+
+func (t *T) Stop(ctx context.Context) error {
+	if v, err := t.isUp(ctx); err != nil {
+		return err
+	} else if !v {
+		t.Log().Infof("container %s is already down", t.Name)
+		return nil
+	}
+	return t.stopOrKill(ctx)
+}
+
+// NetNSPath implements the resource.NetNSPather optional interface.
+// Used by ip.netns and ip.route to configure network stuff in the container.
+func (t *T) NetNSPath(ctx context.Context) (string, error) {
+	if pid, err := t.getPID(ctx); err != nil {
+		return "", err
+	} else if pid == 0 {
+		return "", nil
+	} else {
+		return fmt.Sprintf("/proc/%d/ns/net", pid), nil
+	}
+}
+
+// PID implements the resource.PIDer optional interface.
+// Used by ip.netns to name the veth pair devices.
+func (t *T) PID(ctx context.Context) int {
+	pid, _ := t.getPID(ctx)
+	return pid
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	if v, err := t.isUp(ctx); err != nil {
+		t.StatusLog().Error("%s", err)
+		return status.Undef
+	} else if v {
+		return status.Up
+	}
+	return status.Down
+}
+
+// Label implements Label from resource.Driver interface,
+// it returns a formatted short description of the Resource
+func (t *T) Label(_ context.Context) string {
+	return t.Name
+}
+
+// LinkNames implements the interface necessary for the container.nspawn
+// resources to be targeted by ip.cni, ip.netns, ...
+func (t *T) LinkNames() []string {
+	return []string{t.RID()}
+}
+
+// ContainerHead implements the interface replacing b2.1 the zonepath resource attribute
+func (t *T) ContainerHead(ctx context.Context) (string, error) {
+	return t.rootDir(ctx)
+}
+
+func (t *T) Provisioned(ctx context.Context) (provisioned.T, error) {
+	return provisioned.NotApplicable, nil
+}
+
+// ProvisionAsLeader extracts the image in the root directory, if the
+// root directory is missing or empty.
+func (t *T) ProvisionAsLeader(ctx context.Context) error {
+	rootDir, err := t.rootDir(ctx)
+	if err != nil {
+		return err
+	}
+	if !t.isEmptyDir(rootDir) {
+		t.Log().Infof("container %s root directory %s is already populated", t.Name, rootDir)
+		return nil
+	}
+	if t.Image == "" {
+		return fmt.Errorf("the image keyword is mandatory for provision")
+	}
+	if err := os.MkdirAll(rootDir, 0755); err != nil {
+		return err
+	}
+	imageFile := t.Image
+	if strings.HasPrefix(t.Image, "http://") || strings.HasPrefix(t.Image, "https://") {
+		if imageFile, err = t.download(ctx, t.Image); err != nil {
+			return err
+		}
+		defer func() { _ = os.Remove(imageFile) }()
+	}
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("tar"),
+		command.WithVarArgs("--numeric-owner", "-xpf", imageFile, "-C", rootDir),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	return t.setHostname(ctx)
+}
+
+// download fetches the image url in a temporary file, and returns the
+// temporary file path.
+func (t *T) download(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	t.Log().Infof("download %s", url)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("download %s: %s", url, resp.Status)
+	}
+	f, err := os.CreateTemp(rawconfig.Paths.Tmp, "nspawn-image-")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		_ = os.Remove(f.Name())
+		return "", fmt.Errorf("download %s: %w", url, err)
+	}
+	return f.Name(), nil
+}
+
+// UnprovisionAsLeader removes the container local state and root
+// directory.
+func (t *T) UnprovisionAsLeader(ctx context.Context) error {
+	t.resetFailedUnit(ctx)
+	return t.removeRootDir(ctx)
+}
+
+// UnprovisionAsFollower removes the container local state only, as the
+// root directory, provisioned by the leader, may be on a shared filesystem
+// hosting the leader's container.
+func (t *T) UnprovisionAsFollower(ctx context.Context) error {
+	t.resetFailedUnit(ctx)
+	return nil
+}
+
+// resetFailedUnit unloads the transient service unit if it is in the
+// failed state.
+func (t *T) resetFailedUnit(ctx context.Context) {
+	_ = exec.CommandContext(ctx, "systemctl", "reset-failed", t.unitName()).Run()
+}
+
+// removeRootDir removes the root directory of a container provisioned from
+// an image.
+func (t *T) removeRootDir(ctx context.Context) error {
+	if t.Image == "" {
+		t.Log().Infof("no image keyword: leave the container %s root directory", t.Name)
+		return nil
+	}
+	rootDir, err := t.rootDir(ctx)
+	if err != nil {
+		return err
+	}
+	if !file.Exists(rootDir) {
+		t.Log().Infof("%s is already cleaned up", rootDir)
+		return nil
+	}
+	if file.IsProtected(rootDir) {
+		t.Log().Warnf("refuse to remove %s", rootDir)
+		return nil
+	}
+	t.Log().Infof("remove %s", rootDir)
+	return os.RemoveAll(rootDir)
+}
+
+func (t *T) isEmptyDir(p string) bool {
+	entries, err := os.ReadDir(p)
+	if err != nil {
+		return true
+	}
+	return len(entries) == 0
+}
+
+// Signal implements object.signaler
+func (t *T) Signal(ctx context.Context, sig syscall.Signal) error {
+	pid := t.PID(ctx)
+	if pid == 0 {
+		return nil
+	}
+	return syscall.Kill(pid, sig)
+}
+
+func (t *T) rcmd(ctx context.Context, envs []string) ([]string, error) {
+	if len(t.RCmd) > 0 {
+		args := append([]string{}, t.RCmd...)
+		if len(envs) > 0 {
+			args = append(args, "/usr/bin/env")
+			args = append(args, envs...)
+		}
+		return args, nil
+	}
+	pid, err := t.getPID(ctx)
+	if err != nil {
+		return nil, err
+	} else if pid == 0 {
+		return nil, fmt.Errorf("container %s is not running", t.Name)
+	}
+	args := []string{
+		"nsenter", "--target", strconv.Itoa(pid),
+		"--mount", "--uts", "--ipc", "--net", "--pid", "--root", "--wd",
+		"--", "env", "-i", defaultPATH,
+	}
+	args = append(args, envs...)
+	return args, nil
+}
+
+// SetEncapFileOwnership sets the ownership of the file to be the
+// same ownership than the container root dir, which may be not root
+// for unprivileged containers.
+func (t *T) SetEncapFileOwnership(ctx context.Context, p string) error {
+	rootDir, err := t.rootDir(ctx)
+	if err != nil {
+		return err
+	}
+	return file.CopyOwnership(rootDir, p)
+}
+
+func (t *T) Enter(ctx context.Context) error {
+	sh := "/bin/bash"
+	rcmd, err := t.rcmd(ctx, []string{"TERM=" + os.Getenv("TERM")})
+	if err != nil {
+		return err
+	}
+	args := append(rcmd, sh, "-c", "true")
+	cmd := exec.Command(args[0], args[1:]...)
+	_ = cmd.Run()
+
+	switch cmd.ProcessState.ExitCode() {
+	case 126, 127:
+		sh = "/bin/sh"
+	}
+	args = append(rcmd, sh)
+	cmd = exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func (t *T) GetHostname() string {
+	if t.Hostname != "" {
+		return t.Hostname
+	}
+	return t.Name
+}
+
+func (t *T) setHostname(ctx context.Context) error {
+	rootDir, err := t.rootDir(ctx)
+	if err != nil {
+		return err
+	}
+	p := filepath.Join(rootDir, "etc/hostname")
+	h := t.GetHostname()
+	if b, err := os.ReadFile(p); err == nil && strings.TrimSpace(string(b)) == h {
+		t.Log().Infof("container hostname already set")
+		return nil
+	}
+	if err := os.WriteFile(p, []byte(h+"\n"), 0644); err != nil {
+		return err
+	}
+	t.Log().Infof("container hostname set to %s", h)
+	return nil
+}
+
+func (t *T) rootDir(ctx context.Context) (string, error) {
+	if t.RootDir != "" {
+		return vpath.HostPath(ctx, t.RootDir, t.Path.Namespace)
+	}
+	return filepath.Join(machinesDir, t.Name), nil
+}
+
+// unitName returns the name of the transient service unit running the
+// systemd-nspawn process.
+func (t *T) unitName() string {
+	return "opensvc-nspawn-" + systemd.Escape(t.Name) + ".service"
+}
+
+// slice returns the name of the systemd slice of the resource pg, where
+// systemd-nspawn creates the machine scope, so the pg settings apply to
+// the container processes.
+func (t *T) slice() string {
+	pgID := t.GetPGID()
+	if pgID == "" {
+		return ""
+	}
+	l := strings.Split(pgID, "/")
+	return l[len(l)-1]
+}
+
+// bindArgs returns the systemd-nspawn bind mount arguments, with the
+// sources resolved to host paths.
+func (t *T) bindArgs(ctx context.Context) ([]string, error) {
+	args := make([]string, 0, len(t.Binds))
+	for _, s := range t.Binds {
+		l := strings.Split(s, ":")
+		if len(l) > 3 {
+			return nil, fmt.Errorf("invalid bind %s: must be <source>[:<destination>[:ro|rw]]", s)
+		}
+		src, err := vpath.HostPath(ctx, l[0], t.Path.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("bind %s: %w", s, err)
+		}
+		dst := l[0]
+		if len(l) > 1 && l[1] != "" {
+			dst = l[1]
+		}
+		opt := "--bind="
+		if len(l) == 3 {
+			switch l[2] {
+			case "ro":
+				opt = "--bind-ro="
+			case "rw", "":
+			default:
+				return nil, fmt.Errorf("invalid bind %s: mode must be ro or rw", s)
+			}
+		}
+		args = append(args, opt+src+":"+dst)
+	}
+	return args, nil
+}
+
+func (t *T) start(ctx context.Context) error {
+	rootDir, err := t.rootDir(ctx)
+	if err != nil {
+		return err
+	}
+	if t.isEmptyDir(rootDir) {
+		return fmt.Errorf("container %s root directory %s is missing or empty", t.Name, rootDir)
+	}
+	binds, err := t.bindArgs(ctx)
+	if err != nil {
+		return err
+	}
+
+	// a failed transient unit stays loaded and prevents a new unit with
+	// the same name.
+	t.resetFailedUnit(ctx)
+
+	args := []string{
+		"--unit=" + t.unitName(),
+		"--description=opensvc " + t.Path.String() + " " + t.RID(),
+		"--property=KillMode=mixed",
+		"--",
+		"systemd-nspawn",
+		"--boot",
+		"--quiet",
+		"--machine=" + t.Name,
+		"--directory=" + rootDir,
+		"--hostname=" + t.GetHostname(),
+	}
+	if slice := t.slice(); slice != "" {
+		args = append(args, "--slice="+slice)
+	}
+	if t.PrivateNetwork {
+		args = append(args, "--private-network")
+	}
+	args = append(args, binds...)
+	args = append(args, t.RunArgs...)
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("systemd-run"),
+		command.WithArgs(args),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// waitUp waits for the machine registration, until the start timeout.
+func (t *T) waitUp(ctx context.Context) error {
+	return t.wait(ctx, *t.StartTimeout, true)
+}
+
+func (t *T) wait(ctx context.Context, timeout time.Duration, up bool) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if v, err := t.isUp(ctx); err != nil {
+			return err
+		} else if v == up {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			if up {
+				return fmt.Errorf("container %s not up after %s", t.Name, timeout)
+			}
+			return fmt.Errorf("container %s not down after %s", t.Name, timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *T) stopOrKill(ctx context.Context) error {
+	if actioncontext.IsForce(ctx) {
+		return t.kill(ctx)
+	}
+	if err := t.stop(ctx); err == nil {
+		return nil
+	} else {
+		t.Log().Warnf("stop: %s", err)
+	}
+	return t.kill(ctx)
+}
+
+func (t *T) machinectl(ctx context.Context, args ...string) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("machinectl"),
+		command.WithArgs(args),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// stop asks the container init to power off, and waits for the machine
+// unregistration until the stop timeout.
+func (t *T) stop(ctx context.Context) error {
+	if err := t.machinectl(ctx, "poweroff", t.Name); err != nil {
+		return err
+	}
+	return t.wait(ctx, *t.StopTimeout, false)
+}
+
+func (t *T) kill(ctx context.Context) error {
+	if err := t.machinectl(ctx, "terminate", t.Name); err != nil {
+		return err
+	}
+	return t.wait(ctx, *t.StopTimeout, false)
+}
+
+// getPID returns the pid of the machine leader process, or 0 if the
+// machine is not registered.
+func (t *T) getPID(ctx context.Context) (int, error) {
+	if _, err := exec.LookPath("machinectl"); err != nil {
+		return 0, err
+	}
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("machinectl"),
+		command.WithVarArgs("show", t.Name, "--property=Leader", "--value"),
+		command.WithBufferedStdout(),
+		command.WithBufferedStderr(),
+		command.WithIgnoredExitCodes(0, 1),
+	)
+	b, err := cmd.Output()
+	if err != nil {
+		return 0, err
+	}
+	if cmd.ExitCode() == 1 {
+		// machine is not registered
+		return 0, nil
+	}
+	s := string(bytes.TrimSpace(b))
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(s)
+}
+
+func (t *T) isUp(ctx context.Context) (bool, error) {
+	pid, err := t.getPID(ctx)
+	return pid > 0, err
+}
+
+func (t *T) abortPing(hn string) bool {
+	timeout := 5 * time.Second
+	t.Log().Infof("abort? checking %s availability with ping (%s)", hn, timeout)
+	isAlive, err := ping.Ping(hn, timeout)
+	if err != nil {
+		t.Log().Errorf("abort? ping failed: %s", err)
+		return true
+	}
+	if isAlive {
+		t.Log().Errorf("abort! %s is alive", hn)
+		return true
+	} else {
+		t.Log().Tracef("abort? %s is not alive", hn)
+		return false
+	}
+}
+
+func (t *T) Abort(ctx context.Context) bool {
+	if v, err := t.isUp(ctx); err != nil {
+		t.Log().Warnf("abort? %s", err)
+		return false
+	} else if v {
+		// the local instance is already up.
+		// let the local start report the unnecessary start steps
+		return false
+	}
+	// with a private network, the container hostname is not reachable
+	// before the ip.netns resources configure its interfaces.
+	if !t.PrivateNetwork && t.abortPing(t.GetHostname()) {
+		return true
+	}
+	if n, err := t.upPeer(); err != nil {
+		return false
+	} else if n != "" {
+		t.Log().Infof("abort! %s is up on %s", t.Name, n)
+		return true
+	}
+	return false
+}
+
+func (t *T) upPeer() (string, error) {
+	hn := hostname.Hostname()
+	isPeerUp := func(n string) (bool, error) {
+		client, err := t.NewSSHClient(n)
+		if err != nil {
+			return false, err
+		}
+		defer client.Close()
+		session, err := client.NewSession()
+		if err != nil {
+			return false, err
+		}
+		defer session.Close()
+		var b bytes.Buffer
+		session.Stdout = &b
+
+		// The machine leader pid is only reported for a registered
+		// machine.
+		if err := session.Run(fmt.Sprintf("machinectl show %s --property=Leader --value", t.Name)); err != nil {
+			return false, nil
+		}
+		return len(bytes.TrimSpace(b.Bytes())) > 0, nil
+	}
+	for _, n := range t.Nodes {
+		if hn == n {
+			continue
+		}
+		if v, err := isPeerUp(n); err != nil {
+			t.Log().Tracef("ssh abort check on %s: %s", n, err)
+			continue
+		} else if v {
+			return n, nil
+		}
+	}
+	return "", nil
+}
+
+func (t *T) EncapCmd(ctx context.Context, args []string, envs []string, stdin io.Reader) (resource.Commander, error) {
+	baseArgs, err := t.rcmd(ctx, envs)
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.CommandContext(ctx, baseArgs[0], append(baseArgs[1:], args...)...)
+	cmd.Stdin = stdin
+	return cmd, nil
+}
+
+func (t *T) EncapCp(ctx context.Context, src, dst string) error {
+	rootDir, err := t.rootDir(ctx)
+	if err != nil {
+		return err
+	}
+	dst = filepath.Join(rootDir, dst)
+	return file.Copy2(src, dst)
+}
+
+func (t *T) GetOsvcRootPath() string {
+	if t.OsvcRootPath != "" {
+		return filepath.Join(t.OsvcRootPath, "bin", "om")
+	}
+	return filepath.Join(rawconfig.Paths.Bin, "om")
+}
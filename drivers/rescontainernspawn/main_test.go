@@ -0,0 +1,73 @@
+package rescontainernspawn
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+func newTestDriver(t *testing.T) *T {
+	t.Helper()
+	r := New().(*T)
+	r.SetLoggerForTest(plog.NewLogger(zerolog.Nop()))
+	r.Name = "c1"
+	r.Image = "/srv/images/c1.tar"
+	r.RootDir = filepath.Join(t.TempDir(), "c1")
+	require.NoError(t, os.MkdirAll(filepath.Join(r.RootDir, "etc"), 0755))
+	return r
+}
+
+func TestUnprovision(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("as follower keeps the root directory", func(t *testing.T) {
+		r := newTestDriver(t)
+		require.NoError(t, r.UnprovisionAsFollower(ctx))
+		require.DirExists(t, filepath.Join(r.RootDir, "etc"))
+	})
+
+	t.Run("as leader removes the root directory", func(t *testing.T) {
+		r := newTestDriver(t)
+		require.NoError(t, r.UnprovisionAsLeader(ctx))
+		require.NoDirExists(t, r.RootDir)
+	})
+
+	t.Run("as leader without image keeps the root directory", func(t *testing.T) {
+		r := newTestDriver(t)
+		r.Image = ""
+		require.NoError(t, r.UnprovisionAsLeader(ctx))
+		require.DirExists(t, r.RootDir)
+	})
+}
+
+func TestBindArgs(t *testing.T) {
+	r := newTestDriver(t)
+	r.Binds = []string{"/srv/data", "/srv/conf:/etc/app:ro", "/srv/log:/var/log:rw"}
+	args, err := r.bindArgs(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"--bind=/srv/data:/srv/data",
+		"--bind-ro=/srv/conf:/etc/app",
+		"--bind=/srv/log:/var/log",
+	}, args)
+
+	for _, s := range []string{"/a:/b:rx", "/a:/b:ro:x"} {
+		r.Binds = []string{s}
+		_, err := r.bindArgs(context.Background())
+		require.Error(t, err, s)
+	}
+}
+
+func TestRootDir(t *testing.T) {
+	r := newTestDriver(t)
+	r.RootDir = ""
+	rootDir, err := r.rootDir(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "/var/lib/machines/c1", rootDir)
+}
@@ -0,0 +1,97 @@
+package rescontainernspawn
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/keywords"
+	"github.com/opensvc/om3/v3/core/manifest"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/drivers/rescontainer"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	drvID = driver.NewID(driver.GroupContainer, "nspawn")
+
+	kws = []*keywords.Keyword{
+		{
+			Attr:        "RootDir",
+			DefaultText: keywords.NewText(fs, "text/kw/rootfs.default"),
+			Example:     "/srv/svc1/data/rootfs",
+			Option:      "rootfs",
+			Scopable:    true,
+			Text:        keywords.NewText(fs, "text/kw/rootfs"),
+		},
+		{
+			Attr:         "Image",
+			Example:      "https://repo.opensvc.com/images/debian-12.tar.xz",
+			Option:       "image",
+			Provisioning: true,
+			Scopable:     true,
+			Text:         keywords.NewText(fs, "text/kw/image"),
+		},
+		{
+			Attr:      "Binds",
+			Converter: "list",
+			Example:   "/srv/svc1/data:/data vol1/conf:/etc/app:ro",
+			Option:    "binds",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/binds"),
+		},
+		{
+			Attr:      "PrivateNetwork",
+			Converter: "bool",
+			Default:   "true",
+			Option:    "private_network",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/private_network"),
+		},
+		{
+			Attr:      "RunArgs",
+			Converter: "shlex",
+			Example:   "--capability=CAP_NET_ADMIN --property=TasksMax=512",
+			Option:    "run_args",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/run_args"),
+		},
+		{
+			Attr:      "RCmd",
+			Converter: "shlex",
+			Example:   "machinectl shell svc1 ",
+			Option:    "rcmd",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/rcmd"),
+		},
+		&rescontainer.KWName,
+		&rescontainer.KWHostname,
+		&rescontainer.KWStartTimeout,
+		&rescontainer.KWStopTimeout,
+		&rescontainer.KWPromoteRW,
+		&rescontainer.KWOsvcRootPath,
+		&rescontainer.KWGuestOS,
+	}
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+func (t *T) DriverID() driver.ID {
+	return drvID
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t *T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc)
+	m.Add(
+		manifest.ContextObjectPath,
+		manifest.ContextNodes,
+	)
+	m.AddKeywords(manifest.SCSIPersistentReservationKeywords...)
+	m.AddKeywords(kws...)
+	return m
+}
@@ -0,0 +1,78 @@
+package rescontainerocibase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type (
+	// imageCache is the last image inspect result, saved in the resource
+	// var dir so the status evaluations don't inspect the image each time.
+	imageCache struct {
+		Name      string    `json:"name"`
+		Present   bool      `json:"present"`
+		Digest    string    `json:"digest,omitempty"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+)
+
+// imageCacheTTL is the maximum age of the image inspect cache, so the
+// image changes made outside the resource actions are eventually reported.
+var imageCacheTTL = 10 * time.Minute
+
+func (t *BT) imageCacheFile() string {
+	return filepath.Join(t.VarDir(), "image.json")
+}
+
+// imageCacheGet returns the cached image inspect result, or nil if the cache
+// is missing, expired, or saved for another image.
+func (t *BT) imageCacheGet() *imageCache {
+	b, err := os.ReadFile(t.imageCacheFile())
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			t.Log().Debugf("read image cache: %s", err)
+		}
+		return nil
+	}
+	var v imageCache
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Log().Debugf("parse image cache: %s", err)
+		return nil
+	}
+	if v.Name != t.Image || time.Since(v.UpdatedAt) > imageCacheTTL {
+		return nil
+	}
+	return &v
+}
+
+// imageCacheRefresh inspects the image and saves the result in the cache.
+func (t *BT) imageCacheRefresh(ctx context.Context) *imageCache {
+	v := imageCache{
+		Name:      t.Image,
+		UpdatedAt: time.Now(),
+	}
+	if hasImage, imageID, err := t.executer.HasImage(ctx); err == nil && hasImage {
+		v.Present = true
+		v.Digest = imageID
+	}
+	if err := t.imageCacheSet(v); err != nil {
+		t.Log().Debugf("save image cache: %s", err)
+	}
+	return &v
+}
+
+func (t *BT) imageCacheSet(v imageCache) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	p := t.imageCacheFile()
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(p, b, 0o644)
+}
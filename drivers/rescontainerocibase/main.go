@@ -698,44 +698,72 @@ func (t *BT) Status(ctx context.Context) status.T {
 		t.Log().Tracef("status down on inspect nil")
 		return status.Down
 	}
+	for _, drift := range t.drifts(ctx, inspect) {
+		t.warnAttrDiff(drift.Attr, drift.Current, drift.Target)
+	}
+	if inspectHostConfig := inspect.HostConfig(); inspectHostConfig != nil {
+		t.statusInspectNS(ctx, "netns", inspectHostConfig.NetworkMode, t.NetNS)
+		t.statusInspectNS(ctx, "pidns", inspectHostConfig.PidMode, t.PIDNS)
+		t.statusInspectNS(ctx, "ipcns", inspectHostConfig.IpcMode, t.IPCNS)
+		t.statusInspectNS(ctx, "utsns", inspectHostConfig.UTSMode, t.UTSNS)
+	}
+
+	if !inspect.Running() {
+		return status.Down
+	}
+	return status.Up
+}
+
+// Drift implements resource.Drifter. It returns the differences between the
+// running container and the resource configuration.
+func (t *BT) Drift(ctx context.Context) (resource.Drifts, error) {
+	if !t.Detach || t.executer == nil {
+		return nil, nil
+	}
+	inspect, err := t.executer.Inspect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if inspect == nil || !inspect.Running() {
+		return nil, nil
+	}
+	return t.drifts(ctx, inspect), nil
+}
+
+func (t *BT) drifts(ctx context.Context, inspect Inspecter) resource.Drifts {
+	var l resource.Drifts
+	add := func(attr, current, target string) {
+		l = append(l, resource.Drift{Attr: attr, Current: current, Target: target})
+	}
 	if inspectConfig := inspect.Config(); inspectConfig != nil {
 		if t.Hostname != "" && inspectConfig.Hostname != t.Hostname {
-			t.warnAttrDiff("hostname", inspectConfig.Hostname, t.Hostname)
+			add("hostname", inspectConfig.Hostname, t.Hostname)
 		}
 		if inspectConfig.OpenStdin != t.Interactive {
-			t.warnAttrDiff("interactive", fmt.Sprint(inspectConfig.OpenStdin), fmt.Sprint(t.Interactive))
+			add("interactive", fmt.Sprint(inspectConfig.OpenStdin), fmt.Sprint(t.Interactive))
 		}
 		if len(t.Entrypoint) > 0 && !stringslice.Equal(inspectConfig.Entrypoint, t.Entrypoint) {
-			t.warnAttrDiff("entrypoint", shellquote.Join(inspectConfig.Entrypoint...), shellquote.Join(t.Entrypoint...))
+			add("entrypoint", shellquote.Join(inspectConfig.Entrypoint...), shellquote.Join(t.Entrypoint...))
 		}
 		if inspectConfig.Tty != t.TTY {
-			t.warnAttrDiff("tty", fmt.Sprint(inspectConfig.Tty), fmt.Sprint(t.TTY))
+			add("tty", fmt.Sprint(inspectConfig.Tty), fmt.Sprint(t.TTY))
 		}
 	}
 	if inspectHostConfig := inspect.HostConfig(); inspectHostConfig != nil {
 		if inspectHostConfig.Privileged != t.Privileged {
-			t.warnAttrDiff("privileged", fmt.Sprint(inspectHostConfig.Privileged), fmt.Sprint(t.Privileged))
+			add("privileged", fmt.Sprint(inspectHostConfig.Privileged), fmt.Sprint(t.Privileged))
 		}
 		if t.ReadOnly != "" && fmt.Sprint(inspectHostConfig.ReadonlyRootfs) != t.ReadOnly {
-			t.warnAttrDiff("read_only", fmt.Sprint(inspectHostConfig.ReadonlyRootfs), t.ReadOnly)
+			add("read_only", fmt.Sprint(inspectHostConfig.ReadonlyRootfs), t.ReadOnly)
 		}
-		t.statusInspectNS(ctx, "netns", inspectHostConfig.NetworkMode, t.NetNS)
-		t.statusInspectNS(ctx, "pidns", inspectHostConfig.PidMode, t.PIDNS)
-		t.statusInspectNS(ctx, "ipcns", inspectHostConfig.IpcMode, t.IPCNS)
-		t.statusInspectNS(ctx, "utsns", inspectHostConfig.UTSMode, t.UTSNS)
 	}
-
 	if _, imageID, err := t.executer.HasImage(ctx); err == nil {
 		containerImageID := inspect.ImageID()
 		if containerImageID != imageID {
-			t.warnAttrDiff("image "+t.Image, containerImageID, imageID)
+			add("image", containerImageID, imageID)
 		}
 	}
-
-	if !inspect.Running() {
-		return status.Down
-	}
-	return status.Up
+	return l
 }
 
 func (t *BT) Unprovision(_ context.Context) error {
@@ -873,9 +901,55 @@ func (t *BT) pull(ctx context.Context) error {
 	if err := t.executer.Pull(ctx); err != nil {
 		return fmt.Errorf("can't pull image %s: %s", t.Image, err)
 	}
+	t.imageCacheRefresh(ctx)
 	return nil
 }
 
+// PullImage fetches the container image into the local image store. It
+// implements the resource.ImagePuller interface used by the daemon to
+// pre-fetch images on the failover nodes.
+func (t *BT) PullImage(ctx context.Context) error {
+	if t.Image == "" {
+		return nil
+	}
+	if t.executer == nil {
+		return fmt.Errorf("pull image: undefined executer")
+	}
+	if !t.IsAlwaysImagePullPolicy() {
+		if hasImage, _, err := t.executer.HasImage(ctx); err == nil && hasImage {
+			t.Log().Tracef("image %s already present", t.Image)
+			return nil
+		}
+	}
+	t.Log().Infof("pull image %s", t.Image)
+	return t.pull(ctx)
+}
+
+// ImageStatus returns the presence and identifier of the container image in
+// the local image store, and the identifier of the image the running
+// container was created from. The image presence and identifier are read
+// from a cache refreshed on pull and after imageCacheTTL.
+func (t *BT) ImageStatus(ctx context.Context) *resource.ImageStatus {
+	if t.Image == "" || t.executer == nil {
+		return nil
+	}
+	data := resource.ImageStatus{
+		Name: t.Image,
+	}
+	if inspect, err := t.executer.Inspect(ctx); err == nil && inspect != nil && inspect.Running() {
+		data.RunningDigest = inspect.ImageID()
+	}
+	cache := t.imageCacheGet()
+	if cache == nil || (!cache.Present && data.RunningDigest != "") {
+		// the container may have been created from an image pulled
+		// outside the resource actions
+		cache = t.imageCacheRefresh(ctx)
+	}
+	data.Present = cache.Present
+	data.Digest = cache.Digest
+	return &data
+}
+
 func (t *BT) pullAndRun(ctx context.Context) error {
 	log := t.Log()
 	if t.executer == nil {
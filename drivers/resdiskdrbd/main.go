@@ -49,6 +49,13 @@ type (
 		Port     int         `json:"port"`
 		Network  string      `json:"network"`
 		Template string      `json:"template"`
+
+		SplitBrainRecovery string `json:"split_brain_recovery"`
+
+		// lastStatus is the drbd9 json status read by the last Status
+		// call, reused by Info during the same status evaluation.
+		lastStatus   drbd.Status
+		lastStatusAt time.Time
 	}
 	DRBDDriver interface {
 		Adjust(context.Context) error
@@ -57,6 +64,7 @@ type (
 		Connect(context.Context, string) error
 		ConnStates(context.Context) (drbd.CStateM, error)
 		ConnState(context.Context, string) (string, error)
+		ConnectDiscardMyData(context.Context) error
 		CreateMD(context.Context, int) error
 		DetachForce(context.Context) error
 		Disconnect(context.Context) error
@@ -68,6 +76,8 @@ type (
 		PrimaryForce(context.Context) error
 		Role(context.Context) (string, error)
 		Secondary(context.Context) error
+		SetSplitBrainRecovery(context.Context, string) error
+		Status(context.Context) (drbd.Status, error)
 		Up(context.Context) error
 		WipeMD(context.Context) error
 		WaitIsDefined(ctx context.Context, timeout time.Duration, target bool) error
@@ -124,6 +134,10 @@ var (
 	waitDiskStatesDelay   = time.Second * 1
 	waitDiskStatesTimeout = time.Second * 20
 
+	// statusReuseDelay is the maximum age of the drbd json status read
+	// by Status for Info to reuse it.
+	statusReuseDelay = time.Second * 10
+
 	MaxNodes = 32
 
 	//go:embed text/template/res9
@@ -157,10 +171,49 @@ func (t *T) Name() string {
 	}
 }
 
-func (t *T) Info(_ context.Context) (resource.InfoKeys, error) {
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
 	m := resource.InfoKeys{
 		{Key: "res", Value: t.Res},
 	}
+	if t.SplitBrainRecovery != "" {
+		m = append(m, resource.InfoKey{Key: "split_brain_recovery", Value: t.SplitBrainRecovery})
+	}
+	st, ok := t.reusableStatus()
+	if !ok {
+		dev := t.drbd(ctx)
+		if ok, err := dev.IsDefined(ctx); err != nil || !ok {
+			return m, nil
+		}
+		var err error
+		if st, err = t.drbdStatus(ctx, dev); err != nil {
+			// drbd8 has no json status
+			return m, nil
+		}
+	}
+	m = append(m, resource.InfoKey{Key: "role", Value: st.Role})
+	for _, device := range st.Devices {
+		m = append(m, resource.InfoKey{Key: fmt.Sprintf("vol%d.disk_state", device.Volume), Value: device.DiskState})
+	}
+	for _, conn := range st.Connections {
+		prefix := "peer." + conn.Name
+		m = append(m,
+			resource.InfoKey{Key: prefix + ".cstate", Value: conn.ConnectionState},
+			resource.InfoKey{Key: prefix + ".role", Value: conn.PeerRole},
+		)
+		for _, peerDevice := range conn.PeerDevices {
+			volPrefix := fmt.Sprintf("%s.vol%d", prefix, peerDevice.Volume)
+			m = append(m,
+				resource.InfoKey{Key: volPrefix + ".replication", Value: peerDevice.ReplicationState},
+				resource.InfoKey{Key: volPrefix + ".disk_state", Value: peerDevice.PeerDiskState},
+			)
+			if peerDevice.IsSyncing() {
+				m = append(m,
+					resource.InfoKey{Key: volPrefix + ".sync_percent", Value: fmt.Sprintf("%.1f", peerDevice.PercentInSync)},
+					resource.InfoKey{Key: volPrefix + ".sync_eta", Value: peerDevice.ETA().String()},
+				)
+			}
+		}
+	}
 	return m, nil
 }
 
@@ -349,30 +402,44 @@ func (t *T) Status(ctx context.Context) status.T {
 	if !isDefined {
 		return status.Down
 	}
-	role, err := dev.Role(ctx)
-	if err != nil {
-		t.StatusLog().Error("role: %s", err)
-		return status.Undef
-	}
-	t.StatusLog().Info(role)
-
-	states, err := dev.DiskStates(ctx)
-	if err != nil {
-		t.StatusLog().Error("dstates: %s", err)
-		return status.Undef
-	}
-	resourceStatus := status.Undef
-	for i, state := range states {
-		if i == 0 {
-			switch state {
+	var (
+		role           string
+		resourceStatus = status.Undef
+	)
+	if st, err := t.drbdStatus(ctx, dev); err == nil {
+		role = st.Role
+		t.StatusLog().Info(role)
+		for _, device := range st.Devices {
+			switch device.DiskState {
 			case "Diskless", "DUnknown", "Unconfigured":
 				resourceStatus = status.Down
 			}
-		} else {
-			switch state {
-			case "UpToDate":
-			default:
-				t.StatusLog().Warn("unexpected drbd resource %s/%d state: %s", t.Res, i, state)
+		}
+		t.statusPeers(st)
+	} else {
+		// drbd8 has no json status to report the role and states from
+		if role, err = dev.Role(ctx); err != nil {
+			t.StatusLog().Error("role: %s", err)
+			return status.Undef
+		}
+		t.StatusLog().Info(role)
+		states, err := dev.DiskStates(ctx)
+		if err != nil {
+			t.StatusLog().Error("dstates: %s", err)
+			return status.Undef
+		}
+		for i, state := range states {
+			if i == 0 {
+				switch state {
+				case "Diskless", "DUnknown", "Unconfigured":
+					resourceStatus = status.Down
+				}
+			} else {
+				switch state {
+				case "UpToDate":
+				default:
+					t.StatusLog().Warn("unexpected drbd resource %s/%d state: %s", t.Res, i, state)
+				}
 			}
 		}
 	}
@@ -391,6 +458,109 @@ func (t *T) Status(ctx context.Context) status.T {
 	}
 }
 
+// drbdStatus reads the drbd9 json status of the resource, and keeps it for
+// Info to reuse.
+func (t *T) drbdStatus(ctx context.Context, dev DRBDDriver) (drbd.Status, error) {
+	st, err := dev.Status(ctx)
+	if err != nil {
+		t.lastStatusAt = time.Time{}
+		return st, err
+	}
+	t.lastStatus = st
+	t.lastStatusAt = time.Now()
+	return st, nil
+}
+
+// reusableStatus returns the drbd9 json status read by the last Status
+// call, if not older than statusReuseDelay.
+func (t *T) reusableStatus() (drbd.Status, bool) {
+	if t.lastStatusAt.IsZero() || time.Since(t.lastStatusAt) > statusReuseDelay {
+		return drbd.Status{}, false
+	}
+	return t.lastStatus, true
+}
+
+// statusPeers logs the connections and replications needing attention:
+// the StandAlone connections, possibly caused by a split-brain, the
+// resyncs progress and the outdated or inconsistent peer disks.
+func (t *T) statusPeers(st drbd.Status) {
+	for _, conn := range st.Connections {
+		if conn.ConnectionState == drbd.ConnStateStandAlone {
+			t.StatusLog().Warn("peer %s cstate %s: possible split-brain, resolve with 'instance drbd resolve --keep <node>'", conn.Name, conn.ConnectionState)
+			continue
+		}
+		for _, peerDevice := range conn.PeerDevices {
+			switch {
+			case peerDevice.IsSyncing():
+				msg := fmt.Sprintf("peer %s vol%d %s %.1f%%", conn.Name, peerDevice.Volume, peerDevice.ReplicationState, peerDevice.PercentInSync)
+				if eta := peerDevice.ETA(); eta > 0 {
+					msg += fmt.Sprintf(" eta %s", eta)
+				}
+				t.StatusLog().Info("%s", msg)
+			case peerDevice.PeerDiskState == drbd.DiskStateOutdated, peerDevice.PeerDiskState == drbd.DiskStateInconsistent:
+				t.StatusLog().Warn("peer %s vol%d dstate %s", conn.Name, peerDevice.Volume, peerDevice.PeerDiskState)
+			}
+		}
+	}
+	for _, device := range st.Devices {
+		switch device.DiskState {
+		case drbd.DiskStateOutdated, drbd.DiskStateInconsistent:
+			if !isSyncTarget(st, device.Volume) {
+				t.StatusLog().Warn("vol%d dstate %s", device.Volume, device.DiskState)
+			}
+		}
+	}
+}
+
+func isSyncTarget(st drbd.Status, volume int) bool {
+	for _, conn := range st.Connections {
+		for _, peerDevice := range conn.PeerDevices {
+			if peerDevice.Volume != volume {
+				continue
+			}
+			switch peerDevice.ReplicationState {
+			case drbd.ReplStateSyncTarget, drbd.ReplStatePausedSyncTarget:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ResolveSplitBrain reconnects the resource after a split-brain, keeping the
+// data of the keep node. On the other nodes, the changes made since the
+// split-brain are discarded. It must be executed on all the nodes.
+func (t *T) ResolveSplitBrain(ctx context.Context, keep string) error {
+	if keep == "" {
+		return fmt.Errorf("the node whose data to keep is not set")
+	}
+	if !slices.Contains(t.Nodes, keep) {
+		return fmt.Errorf("%s is not a node of the resource", keep)
+	}
+	dev := t.drbd(ctx)
+	if ok, err := dev.IsDefined(ctx); err != nil {
+		return err
+	} else if !ok {
+		return fmt.Errorf("drbd resource %s is not up", t.Res)
+	}
+	if keep == hostname.Hostname() {
+		t.Log().Infof("drbd %s keep the local data and connect the StandAlone peers", t.Res)
+		return dev.StartConnections(ctx)
+	}
+	role, err := dev.Role(ctx)
+	if err != nil {
+		return err
+	}
+	if role == "Primary" {
+		return fmt.Errorf("drbd %s is primary: stop the local instance before discarding its data", t.Res)
+	}
+	t.Log().Warnf("drbd %s discard the local changes and resync from %s", t.Res, keep)
+	if err := dev.Disconnect(ctx); err != nil {
+		return err
+	}
+	return dev.ConnectDiscardMyData(ctx)
+}
+
 // Label implements Label from resource.Driver interface,
 // it returns a formatted short description of the Resource
 func (t *T) Label(_ context.Context) string {
@@ -1110,6 +1280,11 @@ func (t *T) prepareUp(ctx context.Context, dev DRBDDriver) error {
 	if err := t.waitForNonLocalDiskless(ctx, dev); err != nil {
 		return err
 	}
+	if t.SplitBrainRecovery != "" {
+		if err := dev.SetSplitBrainRecovery(ctx, t.SplitBrainRecovery); err != nil {
+			return fmt.Errorf("set split-brain recovery policy: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/opensvc/om3/v3/core/manifest"
 	"github.com/opensvc/om3/v3/core/naming"
 	"github.com/opensvc/om3/v3/drivers/resdisk"
+	"github.com/opensvc/om3/v3/util/drbd"
 )
 
 var (
@@ -67,6 +68,13 @@ var (
 			Provisioning: true,
 			Text:         keywords.NewText(fs, "text/kw/network"),
 		},
+		{
+			Attr:       "SplitBrainRecovery",
+			Candidates: drbd.SplitBrainRecoveries,
+			Example:    "discard-least-changes",
+			Option:     "split_brain_recovery",
+			Text:       keywords.NewText(fs, "text/kw/split_brain_recovery"),
+		},
 		{
 			Attr:         "Template",
 			Example:      "default",
@@ -0,0 +1,87 @@
+//go:build linux
+
+package resdisklv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/util/lvm2"
+)
+
+func (t *T) lvm2LV() *lvm2.LV {
+	return lvm2.NewLV(t.VGName, t.LVName, lvm2.WithLogger(t.Log()))
+}
+
+// Snapshot implements the resource.Snapshotter interface.
+func (t *T) Snapshot(ctx context.Context, options resource.SnapshotOptions) (resource.Snapshots, error) {
+	lv := t.lvm2LV()
+	if v, err := lv.IsActive(ctx); err != nil {
+		return nil, err
+	} else if !v {
+		t.Log().Infof("skip snapshot: %s is not active", lv.FQN())
+		return resource.Snapshots{}, nil
+	}
+	name, err := lv.CreateSnapshot(ctx, options.Tag, options.Size)
+	if err != nil {
+		return nil, err
+	}
+	if options.Keep > 0 {
+		if err := lv.PruneSnapshots(ctx, options.Tag, options.Keep); err != nil {
+			return nil, err
+		}
+	}
+	l, err := t.Snapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, snap := range l {
+		if snap.ID == t.VGName+"/"+name {
+			return resource.Snapshots{snap}, nil
+		}
+	}
+	return resource.Snapshots{}, nil
+}
+
+// Snapshots implements the resource.Snapshotter interface.
+func (t *T) Snapshots(ctx context.Context) (resource.Snapshots, error) {
+	l, err := t.lvm2LV().Snapshots(ctx)
+	if errors.Is(err, lvm2.ErrExist) {
+		return resource.Snapshots{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	snaps := make(resource.Snapshots, len(l))
+	for i, info := range l {
+		snaps[i] = resource.Snapshot{
+			ID:        info.VGName + "/" + info.LVName,
+			RID:       t.RID(),
+			Origin:    info.VGName + "/" + info.Origin,
+			Tag:       info.Tag,
+			CreatedAt: info.CreatedAt,
+			Size:      info.Size,
+			Thin:      info.Thin,
+		}
+	}
+	return snaps, nil
+}
+
+// RestoreSnapshot implements the resource.Snapshotter interface. The
+// logical volume must not be in use.
+func (t *T) RestoreSnapshot(ctx context.Context, id string) error {
+	vgName, name, _ := strings.Cut(id, "/")
+	if vgName != t.VGName {
+		return fmt.Errorf("snapshot %s is not a snapshot of %s", id, t.fqn())
+	}
+	lv := t.lvm2LV()
+	if attrs, err := lv.Attrs(ctx); err != nil {
+		return err
+	} else if attrs.Attr(lvm2.LVAttrIndexDeviceOpen) == lvm2.LVAttrDeviceOpen {
+		return fmt.Errorf("%s is in use: stop the instance before restoring snapshot %s", lv.FQN(), id)
+	}
+	t.Log().Infof("restore %s from snapshot %s", lv.FQN(), id)
+	return lv.MergeSnapshot(ctx, name)
+}
@@ -0,0 +1,138 @@
+//go:build linux
+
+package resdiskvg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/util/lvm2"
+)
+
+// originLVs returns the origin logical volumes of the volume group,
+// skipping the snapshots, thin pools and internal volumes. If activeOnly
+// is true, the inactive logical volumes are skipped too.
+func (t *T) originLVs(ctx context.Context, activeOnly bool) ([]*lvm2.LV, error) {
+	infos, err := lvm2.NewVG(t.VGName, lvm2.WithLogger(t.Log())).LVInfos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	l := make([]*lvm2.LV, 0)
+	for _, info := range infos {
+		attrs := lvm2.LVAttrs(info.LVAttr)
+		if info.Origin != "" {
+			continue
+		}
+		switch attrs.Attr(lvm2.LVAttrIndexType) {
+		case '-', lvm2.LVAttrTypeThinVolume:
+		default:
+			continue
+		}
+		if activeOnly && attrs.Attr(lvm2.LVAttrIndexState) != lvm2.LVAttrStateActive {
+			continue
+		}
+		l = append(l, lvm2.NewLV(t.VGName, info.LVName, lvm2.WithLogger(t.Log())))
+	}
+	return l, nil
+}
+
+func (t *T) lvSnapshots(ctx context.Context, lv *lvm2.LV) (resource.Snapshots, error) {
+	l, err := lv.Snapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snaps := make(resource.Snapshots, len(l))
+	for i, info := range l {
+		snaps[i] = resource.Snapshot{
+			ID:        info.VGName + "/" + info.LVName,
+			RID:       t.RID(),
+			Origin:    info.VGName + "/" + info.Origin,
+			Tag:       info.Tag,
+			CreatedAt: info.CreatedAt,
+			Size:      info.Size,
+			Thin:      info.Thin,
+		}
+	}
+	return snaps, nil
+}
+
+// Snapshot implements the resource.Snapshotter interface, snapshotting
+// every active logical volume of the volume group.
+func (t *T) Snapshot(ctx context.Context, options resource.SnapshotOptions) (resource.Snapshots, error) {
+	lvs, err := t.originLVs(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	snaps := make(resource.Snapshots, 0)
+	for _, lv := range lvs {
+		name, err := lv.CreateSnapshot(ctx, options.Tag, options.Size)
+		if err != nil {
+			return snaps, err
+		}
+		if options.Keep > 0 {
+			if err := lv.PruneSnapshots(ctx, options.Tag, options.Keep); err != nil {
+				return snaps, err
+			}
+		}
+		l, err := t.lvSnapshots(ctx, lv)
+		if err != nil {
+			return snaps, err
+		}
+		for _, snap := range l {
+			if snap.ID == t.VGName+"/"+name {
+				snaps = append(snaps, snap)
+			}
+		}
+	}
+	return snaps, nil
+}
+
+// Snapshots implements the resource.Snapshotter interface.
+func (t *T) Snapshots(ctx context.Context) (resource.Snapshots, error) {
+	lvs, err := t.originLVs(ctx, false)
+	if errors.Is(err, lvm2.ErrExist) {
+		return resource.Snapshots{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	snaps := make(resource.Snapshots, 0)
+	for _, lv := range lvs {
+		l, err := t.lvSnapshots(ctx, lv)
+		if err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, l...)
+	}
+	return snaps, nil
+}
+
+// RestoreSnapshot implements the resource.Snapshotter interface. The
+// snapshot origin logical volume must not be in use.
+func (t *T) RestoreSnapshot(ctx context.Context, id string) error {
+	vgName, name, _ := strings.Cut(id, "/")
+	if vgName != t.VGName {
+		return fmt.Errorf("snapshot %s is not in volume group %s", id, t.VGName)
+	}
+	snaps, err := t.Snapshots(ctx)
+	if err != nil {
+		return err
+	}
+	for _, snap := range snaps {
+		if snap.ID != id {
+			continue
+		}
+		_, lvName, _ := strings.Cut(snap.Origin, "/")
+		lv := lvm2.NewLV(t.VGName, lvName, lvm2.WithLogger(t.Log()))
+		if attrs, err := lv.Attrs(ctx); err != nil {
+			return err
+		} else if attrs.Attr(lvm2.LVAttrIndexDeviceOpen) == lvm2.LVAttrDeviceOpen {
+			return fmt.Errorf("%s is in use: stop the instance before restoring snapshot %s", lv.FQN(), id)
+		}
+		t.Log().Infof("restore %s from snapshot %s", lv.FQN(), id)
+		return lv.MergeSnapshot(ctx, name)
+	}
+	return fmt.Errorf("snapshot %s not found", id)
+}
@@ -0,0 +1,253 @@
+package resfsbtrfs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/drivers/resfshost"
+	"github.com/opensvc/om3/v3/util/btrfs"
+	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/device"
+	"github.com/opensvc/om3/v3/util/file"
+	"github.com/opensvc/om3/v3/util/findmnt"
+)
+
+type (
+	// T is the fs.btrfs resource driver. It extends the generic host
+	// filesystem driver with the subvolume mount and provisioning, and the
+	// subvolume quota group.
+	T struct {
+		resfshost.T
+		Size      *int64 `json:"size"`
+		Subvolume string `json:"subvol"`
+	}
+)
+
+const (
+	// topLevelSubvolumeID is the id of the btrfs filesystem root subvolume,
+	// hosting all other subvolumes.
+	topLevelSubvolumeID = "5"
+)
+
+func New() resource.Driver {
+	t := &T{
+		T: resfshost.T{Type: "btrfs"},
+	}
+	return t
+}
+
+// Configure adds the subvol mount option, so the generic host filesystem
+// mount, status and drift functions apply to the subvolume.
+func (t *T) Configure() error {
+	if err := t.T.Configure(); err != nil {
+		return err
+	}
+	if opt := t.subvolMountOption(); opt != "" && !strings.Contains(t.MountOptions, "subvol=") {
+		if t.MountOptions == "" {
+			t.MountOptions = opt
+		} else {
+			t.MountOptions = opt + "," + t.MountOptions
+		}
+	}
+	return nil
+}
+
+// subvolMountOption returns the subvol mount option, formatted like the
+// kernel reports it, with a leading '/'.
+func (t *T) subvolMountOption() string {
+	if t.Subvolume == "" {
+		return ""
+	}
+	return "subvol=/" + strings.TrimPrefix(t.Subvolume, "/")
+}
+
+// Label implements Label from resource.Driver interface,
+// it returns a formatted short description of the Resource
+func (t *T) Label(_ context.Context) string {
+	s := t.Device
+	if t.Subvolume != "" {
+		s += ":" + t.Subvolume
+	}
+	if m := t.mountPoint(); m != "" {
+		s += "@" + m
+	}
+	return s
+}
+
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	m := resource.InfoKeys{
+		{Key: "dev", Value: t.Device},
+		{Key: "subvol", Value: t.Subvolume},
+		{Key: "mnt", Value: t.mountPoint()},
+		{Key: "mnt_opt", Value: t.MountOptions},
+	}
+	return m, nil
+}
+
+func (t *T) mountPoint() string {
+	return filepath.Clean(t.MountPoint)
+}
+
+func (t *T) fs() *btrfs.Filesystem {
+	return &btrfs.Filesystem{
+		Device: t.Device,
+		Log:    t.Log(),
+	}
+}
+
+func (t *T) mountWithOptions(ctx context.Context, mnt, opts string) error {
+	args := []string{"-t", "btrfs"}
+	if opts != "" {
+		args = append(args, "-o", opts)
+	}
+	args = append(args, t.Device, mnt)
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("mount"),
+		command.WithArgs(args),
+		command.WithLogger(t.Log()),
+		command.WithTimeout(time.Minute),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+func (t *T) umount(ctx context.Context, mnt string) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("umount"),
+		command.WithVarArgs(mnt),
+		command.WithLogger(t.Log()),
+		command.WithTimeout(time.Minute),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// withTopLevel mounts the filesystem top-level subvolume in a private
+// mountpoint during the execution of f, so subvolumes can be created or
+// deleted whatever the mounted subvolume.
+func (t *T) withTopLevel(ctx context.Context, f func(top string) error) error {
+	top := filepath.Join(t.VarDir(), "top")
+	if err := os.MkdirAll(top, 0700); err != nil {
+		return err
+	}
+	if v, err := findmnt.HasMntWithTypes(ctx, []string{"btrfs"}, top); err != nil {
+		return err
+	} else if !v {
+		if err := t.mountWithOptions(ctx, top, "subvolid="+topLevelSubvolumeID); err != nil {
+			return err
+		}
+		defer func() {
+			if err := t.umount(ctx, top); err != nil {
+				t.Log().Warnf("umount %s: %s", top, err)
+			}
+		}()
+	}
+	return f(top)
+}
+
+func (t *T) subvolume(top string) *btrfs.Subvolume {
+	return &btrfs.Subvolume{
+		Path: filepath.Join(top, t.Subvolume),
+		Log:  t.Log(),
+	}
+}
+
+func (t *T) ProvisionAsLeader(ctx context.Context) error {
+	fs := t.fs()
+	if v, err := fs.IsFormated(ctx); err != nil {
+		return err
+	} else if v {
+		t.Log().Infof("%s is already formatted", t.Device)
+	} else if err := fs.MKFS(ctx, t.MKFSOptions); err != nil {
+		return err
+	}
+	if t.Subvolume == "" {
+		return nil
+	}
+	return t.withTopLevel(ctx, func(top string) error {
+		subvol := t.subvolume(top)
+		if v, err := subvol.Exists(); err != nil {
+			return err
+		} else if v {
+			t.Log().Infof("subvolume %s already exists", t.Subvolume)
+		} else if err := subvol.Create(); err != nil {
+			return err
+		}
+		if t.Size != nil {
+			return subvol.SetLimit(*t.Size)
+		}
+		return nil
+	})
+}
+
+// UnprovisionAsLeader deletes the subvolume, if set, and the mountpoint
+// directory. Like the generic host filesystem driver, it does not wipe the
+// filesystem.
+func (t *T) UnprovisionAsLeader(ctx context.Context) error {
+	if t.Subvolume == "" {
+		return nil
+	}
+	err := t.withTopLevel(ctx, func(top string) error {
+		subvol := t.subvolume(top)
+		if v, err := subvol.Exists(); err != nil {
+			return err
+		} else if !v {
+			t.Log().Infof("subvolume %s is already deleted", t.Subvolume)
+			return nil
+		}
+		return subvol.Delete()
+	})
+	if err != nil {
+		return err
+	}
+	return t.removeMountPoint()
+}
+
+func (t *T) removeMountPoint() error {
+	mnt := t.mountPoint()
+	if mnt == "" {
+		return nil
+	}
+	if file.IsProtected(mnt) {
+		return fmt.Errorf("dir %s is protected: refuse to remove", mnt)
+	}
+	if !file.Exists(mnt) {
+		t.Log().Infof("dir %s is already removed", mnt)
+		return nil
+	}
+	// the mountpoint of an unmounted subvolume is empty
+	return os.Remove(mnt)
+}
+
+func (t *T) ClaimedDevices(ctx context.Context) device.L {
+	return t.SubDevices(ctx)
+}
+
+func (t *T) ReservableDevices(ctx context.Context) device.L {
+	return t.SubDevices(ctx)
+}
+
+func (t *T) SubDevices(ctx context.Context) device.L {
+	l := make(device.L, 0)
+	paths, err := t.fs().Devices(ctx)
+	if err != nil {
+		return l
+	}
+	for _, p := range paths {
+		l = append(l, device.New(p, device.WithLogger(t.Log())))
+	}
+	return l
+}
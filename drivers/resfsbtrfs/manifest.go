@@ -0,0 +1,59 @@
+package resfsbtrfs
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/v3/core/datarecv"
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/keywords"
+	"github.com/opensvc/om3/v3/core/manifest"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/drivers/resfshost"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	drvID = driver.NewID(driver.GroupFS, "btrfs")
+
+	kws = []*keywords.Keyword{
+		{
+			Attr:     "Subvolume",
+			Example:  "{namespace}/{name}",
+			Option:   "subvol",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/subvol"),
+		},
+		{
+			Attr:         "Size",
+			Converter:    "size",
+			Option:       "size",
+			Provisioning: true,
+			Scopable:     true,
+			Text:         keywords.NewText(fs, "text/kw/size"),
+		},
+	}
+)
+
+func init() {
+	// replaces the generic fs.btrfs driver registered by resfshost, whose
+	// init runs first as this package imports it.
+	driver.Register(drvID, New)
+}
+
+func (t *T) DriverID() driver.ID {
+	return drvID
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t *T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc, naming.KindVol)
+	m.Add(manifest.ContextObjectPath)
+	m.AddKeywords(resfshost.KeywordsBase...)
+	m.AddKeywords(kws...)
+	m.AddKeywords(manifest.SCSIPersistentReservationKeywords...)
+	m.AddKeywords(datarecv.Keywords("DataRecv.")...)
+	return m
+}
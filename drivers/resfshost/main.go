@@ -149,6 +149,27 @@ func (t *T) Status(ctx context.Context) status.T {
 	return status.Up
 }
 
+// Drift implements resource.Drifter. It returns the configured mount
+// options missing from the active mount.
+func (t *T) Drift(ctx context.Context) (resource.Drifts, error) {
+	if t.MountPoint == "" || t.mountOptions() == "" {
+		return nil, nil
+	}
+	mounts, err := findmnt.List(ctx, "", t.mountPoint())
+	if err != nil {
+		return nil, err
+	}
+	if len(mounts) == 0 {
+		return nil, nil
+	}
+	// the last entry is the mount visible on the mount point
+	m := mounts[len(mounts)-1]
+	if missing := findmnt.MissingOptions(t.mountOptions(), m.Options); len(missing) > 0 {
+		return resource.Drifts{{Attr: "mnt_opt", Current: m.Options, Target: t.mountOptions()}}, nil
+	}
+	return nil, nil
+}
+
 // Label implements Label from resource.Driver interface,
 // it returns a formatted short description of the Resource
 func (t *T) Label(ctx context.Context) string {
@@ -213,6 +213,46 @@ func (t *T) statusOfAddr(ctx context.Context, dev string) status.T {
 	return status.Up
 }
 
+// Drift implements resource.Drifter. It returns the differences between the
+// interface and netmask holding the ip address and the configured ones.
+func (t *T) Drift(ctx context.Context) (resource.Drifts, error) {
+	if t.Name == "" || t.Dev == "" {
+		return nil, nil
+	}
+	ip := t.ipaddr()
+	if ip == nil {
+		return nil, nil
+	}
+	intfs, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	dev, _ := resip.SplitDevLabel(t.Dev)
+	for _, intf := range intfs {
+		addrs, err := intf.Addrs()
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			ipnet, ok := addr.(*net.IPNet)
+			if !ok || !ipnet.IP.Equal(ip) {
+				continue
+			}
+			var l resource.Drifts
+			if intf.Name != dev {
+				l = append(l, resource.Drift{Attr: "dev", Current: intf.Name, Target: dev})
+			}
+			if t.Netmask != "" {
+				if ones, _ := ipnet.Mask.Size(); ones != t.ipmaskOnes() {
+					l = append(l, resource.Drift{Attr: "netmask", Current: fmt.Sprint(ones), Target: fmt.Sprint(t.ipmaskOnes())})
+				}
+			}
+			return l, nil
+		}
+	}
+	return nil, nil
+}
+
 func (t *T) Provision(ctx context.Context) error {
 	return nil
 }
@@ -0,0 +1,22 @@
+//go:build linux
+
+package ressyncbtrfs
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/util/btrfs"
+	"github.com/opensvc/om3/v3/util/capabilities"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner(ctx context.Context) ([]string, error) {
+	l := make([]string, 0)
+	if btrfs.IsCapable() {
+		l = append(l, drvID.Cap())
+	}
+	return l, nil
+}
@@ -0,0 +1,455 @@
+//go:build linux
+
+package ressyncbtrfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/nodesinfo"
+	"github.com/opensvc/om3/v3/core/provisioned"
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/core/topology"
+	"github.com/opensvc/om3/v3/drivers/ressync"
+	"github.com/opensvc/om3/v3/util/btrfs"
+	"github.com/opensvc/om3/v3/util/hostname"
+)
+
+// T is the driver structure.
+type (
+	T struct {
+		ressync.T
+		resource.SSH
+		Src      string
+		Dst      string
+		Target   []string
+		Nodes    []string
+		DRPNodes []string
+		ObjectID uuid.UUID
+		Timeout  *time.Duration
+		Topology topology.T
+
+		srcSnapSent   string
+		srcSnapTosend string
+		dstSnapSent   string
+		dstSnapTosend string
+	}
+
+	modeT uint
+)
+
+const (
+	modeFull modeT = iota
+	modeIncr
+
+	lockName = "sync"
+
+	// snapDir is the name of the directory hosting the sync snapshots,
+	// next to the src and dst subvolumes.
+	snapDir = ".snap"
+)
+
+func New() resource.Driver {
+	return &T{}
+}
+
+func (t *T) Configure() error {
+	rid := strings.Replace(t.RID(), "#", ".", 1)
+	t.srcSnapSent = snapPath(t.Src, t.Src, rid, "sent")
+	t.srcSnapTosend = snapPath(t.Src, t.Src, rid, "tosend")
+	t.dstSnapSent = snapPath(t.Dst, t.Src, rid, "sent")
+	t.dstSnapTosend = snapPath(t.Dst, t.Src, rid, "tosend")
+	return nil
+}
+
+// snapPath returns the path of a sync snapshot of the src subvolume, stored
+// next to the subvolume p.
+//
+// The snapshot base name is derived from the src subvolume base name on the
+// source and destination nodes, as btrfs receive names the received
+// subvolume after the sent snapshot.
+func snapPath(p, src, rid, suffix string) string {
+	return filepath.Join(filepath.Dir(p), snapDir, filepath.Base(src)+"@"+rid+"."+suffix)
+}
+
+func (t *T) Running() (resource.RunningInfoList, error) {
+	return t.RunningFromLock(lockName)
+}
+
+func (t *T) Full(ctx context.Context) error {
+	disable := actioncontext.IsLockDisabled(ctx)
+	timeout := actioncontext.LockTimeout(ctx)
+	target := actioncontext.Target(ctx)
+	cancel, err := t.Lock(disable, timeout, lockName)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	return t.lockedSync(ctx, modeFull, target)
+}
+
+func (t *T) Update(ctx context.Context) error {
+	disable := actioncontext.IsLockDisabled(ctx)
+	timeout := actioncontext.LockTimeout(ctx)
+	target := actioncontext.Target(ctx)
+	cancel, err := t.Lock(disable, timeout, lockName)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	return t.lockedSync(ctx, modeIncr, target)
+}
+
+func (t *T) lockedSync(ctx context.Context, mode modeT, target []string) (err error) {
+	if len(target) == 0 {
+		target = t.Target
+	}
+
+	isCron := actioncontext.IsCron(ctx)
+
+	if t.isFlexAndNotPrimary() {
+		return fmt.Errorf("this flex instance is not primary. only %s can sync", t.Nodes[0])
+	}
+
+	if v, rids := t.IsInstanceSufficientlyStarted(ctx); !v {
+		return fmt.Errorf("the instance is not sufficiently started (%s). refuse to sync to protect the data of the started remote instance", strings.Join(rids, ","))
+	}
+
+	hasSnapSent, err := t.subvol(t.srcSnapSent, "").Exists()
+	if err != nil {
+		return err
+	}
+	hasSnapTosend, err := t.subvol(t.srcSnapTosend, "").Exists()
+	if err != nil {
+		return err
+	}
+
+	if mode != modeFull && !hasSnapSent {
+		t.Log().Infof("%s does not exist: can't send delta, send full", t.srcSnapSent)
+		mode = modeFull
+	}
+	if mode == modeFull {
+		if err := t.subvol(t.srcSnapSent, "").Delete(); err != nil {
+			return err
+		}
+		if err := t.subvol(t.srcSnapTosend, "").Delete(); err != nil {
+			return err
+		}
+		if err := t.subvol(t.Src, "").Snapshot(t.srcSnapTosend, true); err != nil {
+			return err
+		}
+	} else if !hasSnapTosend {
+		if err := t.subvol(t.Src, "").Snapshot(t.srcSnapTosend, true); err != nil {
+			return err
+		}
+	}
+
+	nodenames := t.GetTargetPeernames(target, t.Nodes, t.DRPNodes)
+	for _, nodename := range nodenames {
+		if err := t.isSendAllowedToPeerEnv(nodename); err != nil {
+			if isCron {
+				t.Log().Tracef("%s", err)
+			} else {
+				t.Log().Infof("%s", err)
+			}
+			continue
+		}
+		if err := t.peerSync(ctx, mode, nodename); err != nil {
+			return err
+		}
+		if err := t.rotatePeerSnaps(nodename); err != nil {
+			return err
+		}
+		if err := t.refreshPeerDst(nodename); err != nil {
+			return err
+		}
+		if err := t.WritePeerLastSync(ctx, nodename, nodenames); err != nil {
+			return err
+		}
+	}
+	return t.rotateSnaps(t.srcSnapTosend, t.srcSnapSent, "")
+}
+
+func (t *T) peerSync(ctx context.Context, mode modeT, nodename string) error {
+	if mode == modeFull {
+		return t.send(ctx, nodename, "")
+	}
+	if v, err := t.subvol(t.dstSnapSent, t.peer(nodename)).Exists(); err != nil {
+		return err
+	} else if v {
+		return t.send(ctx, nodename, t.srcSnapSent)
+	}
+	return t.send(ctx, nodename, "")
+}
+
+// send pipes the btrfs send stream of the tosend snapshot, incremental from
+// parent if not empty, to a btrfs receive on the peer node.
+func (t *T) send(ctx context.Context, nodename, parent string) error {
+	peer := t.peer(nodename)
+	dstSnap := t.subvol(t.dstSnapTosend, peer)
+	if err := dstSnap.Delete(); err != nil {
+		return err
+	}
+	if err := dstSnap.MkdirParent(); err != nil {
+		return err
+	}
+	if parent == "" {
+		// a full send can not be received over existing snapshots of the
+		// same source.
+		if err := t.subvol(t.dstSnapSent, peer).Delete(); err != nil {
+			return err
+		}
+	}
+
+	errWriter := t.Log().Writer(zerolog.ErrorLevel)
+	nfoWriter := t.Log().Writer(zerolog.InfoLevel)
+
+	args := t.subvol(t.srcSnapTosend, "").SendCmd(parent)
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stderr = errWriter
+	stdoutPipe, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("error creating stdout pipe for btrfs send: %w", err)
+	}
+	defer stdoutPipe.Close()
+
+	rargs := btrfs.ReceiveCmd(filepath.Dir(t.dstSnapTosend))
+	rcmd := exec.CommandContext(ctx, rargs[0], rargs[1:]...)
+	rcmdStr := rcmd.String()
+	cmdStr := cmd.String()
+
+	var (
+		stdinPipe io.WriteCloser
+		wait      func() error
+	)
+	if peer == "" {
+		t.Log().Infof("%s | %s", cmdStr, rcmdStr)
+		rcmd.Stdout = nfoWriter
+		rcmd.Stderr = errWriter
+		if stdinPipe, err = rcmd.StdinPipe(); err != nil {
+			return fmt.Errorf("error creating stdin pipe for btrfs receive: %w", err)
+		}
+		if err := rcmd.Start(); err != nil {
+			return err
+		}
+		wait = rcmd.Wait
+	} else {
+		client, err := t.NewSSHClient(nodename)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		session, err := client.NewSession()
+		if err != nil {
+			return err
+		}
+		defer session.Close()
+		session.Stdout = nfoWriter
+		session.Stderr = errWriter
+		if stdinPipe, err = session.StdinPipe(); err != nil {
+			return err
+		}
+		t.Log().Infof("%s | ssh %s '%s'", cmdStr, nodename, rcmdStr)
+		if err := session.Start(rcmdStr); err != nil {
+			return err
+		}
+		wait = session.Wait
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	stats := ressync.NewStats(nodename)
+	if _, err := t.CopyWithStats(ctx, stdinPipe, stdoutPipe, stats); err != nil {
+		return err
+	}
+	_ = stdinPipe.Close()
+
+	if err := cmd.Wait(); err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			t.Log().
+				Attr("exitcode", ee.ExitCode()).
+				Errorf("exec '%s' on localhost exited with code %d", cmdStr, ee.ExitCode())
+		}
+		return err
+	}
+	if err := wait(); err != nil {
+		var (
+			ee  *exec.ExitError
+			see *ssh.ExitError
+		)
+		switch {
+		case errors.As(err, &ee):
+			t.Log().
+				Attr("exitcode", ee.ExitCode()).
+				Errorf("exec '%s' on %s exited with code %d", rcmdStr, nodename, ee.ExitCode())
+		case errors.As(err, &see):
+			t.Log().
+				Attr("exitcode", see.ExitStatus()).
+				Attr("host", nodename).
+				Errorf("rexec '%s' on host %s exited with code %d", rcmdStr, nodename, see.ExitStatus())
+		}
+		return err
+	}
+	return nil
+}
+
+// rotatePeerSnaps replaces the peer sent snapshot with the just received
+// tosend snapshot, to serve as the parent of the next incremental send.
+func (t *T) rotatePeerSnaps(nodename string) error {
+	return t.rotateSnaps(t.dstSnapTosend, t.dstSnapSent, t.peer(nodename))
+}
+
+func (t *T) rotateSnaps(src, dst, peer string) error {
+	if err := t.subvol(dst, peer).Delete(); err != nil {
+		return err
+	}
+	return t.subvol(src, peer).Rename(dst)
+}
+
+// refreshPeerDst replaces the peer dst subvolume with a writable snapshot
+// of the received data. The received snapshots are read-only and must be
+// kept intact to receive the next incremental send.
+func (t *T) refreshPeerDst(nodename string) error {
+	peer := t.peer(nodename)
+	if err := t.subvol(t.Dst, peer).Delete(); err != nil {
+		return err
+	}
+	return t.subvol(t.dstSnapSent, peer).Snapshot(t.Dst, false)
+}
+
+// peer returns the node to pass to the btrfs commands: empty for the local
+// node, to avoid a ssh connection.
+func (t *T) peer(nodename string) string {
+	if nodename == hostname.Hostname() {
+		return ""
+	}
+	return nodename
+}
+
+func (t *T) subvol(p, nodename string) *btrfs.Subvolume {
+	return &btrfs.Subvolume{
+		Path:       p,
+		Log:        t.Log(),
+		Node:       nodename,
+		SSHKeyFile: t.GetSSHKeyFile(),
+	}
+}
+
+func (t *T) Kill(ctx context.Context) error {
+	return nil
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	var isSourceNode bool
+	if v, _ := t.IsInstanceSufficientlyStarted(ctx); !v {
+		isSourceNode = false
+	} else if t.isFlexAndNotPrimary() {
+		isSourceNode = false
+	} else {
+		isSourceNode = true
+	}
+	nodenames := t.getTargetNodenames(isSourceNode)
+	return t.StatusLastSync(nodenames)
+}
+
+// Label implements Label from resource.Driver interface,
+// it returns a formatted short description of the Resource
+func (t *T) Label(_ context.Context) string {
+	switch {
+	case t.Src != "" && len(t.Target) > 0:
+		return t.Src + " to " + strings.Join(t.Target, " ")
+	case t.Src != "":
+		return t.Src + " to void"
+	case len(t.Target) > 0:
+		return "nothing to " + strings.Join(t.Target, " ")
+	default:
+		return ""
+	}
+}
+
+func (t *T) ScheduleOptions() resource.ScheduleOptions {
+	return resource.ScheduleOptions{
+		Action: "sync_update",
+		Option: "schedule",
+		Base:   "",
+	}
+}
+
+func (t *T) Provisioned(ctx context.Context) (provisioned.T, error) {
+	return provisioned.NotApplicable, nil
+}
+
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	target := sort.StringSlice(t.Target)
+	sort.Sort(target)
+	m := resource.InfoKeys{
+		{Key: "src", Value: t.Src},
+		{Key: "dst", Value: t.Dst},
+		{Key: "target", Value: strings.Join(target, " ")},
+	}
+	if t.Timeout != nil {
+		m = append(m, resource.InfoKey{Key: "timeout", Value: fmt.Sprintf("%s", t.Timeout)})
+	}
+	return m, nil
+}
+
+func (t *T) isFlexAndNotPrimary() bool {
+	if t.Topology != topology.Flex {
+		return false
+	}
+	if hostname.Hostname() == t.Nodes[0] {
+		return false
+	}
+	return true
+}
+
+func (t *T) isSendAllowedToPeerEnv(nodename string) error {
+	var localEnv, peerEnv string
+	nodesInfo, err := nodesinfo.Load()
+	if err != nil {
+		return fmt.Errorf("get nodes info: %w", err)
+	}
+	getEnv := func(n string, s *string) error {
+		if m, ok := nodesInfo[n]; !ok {
+			return fmt.Errorf("node %s not found in nodes_info.json", n)
+		} else {
+			*s = m.Env
+		}
+		return nil
+	}
+	if err := getEnv(hostname.Hostname(), &localEnv); err != nil {
+		return err
+	}
+	if err := getEnv(nodename, &peerEnv); err != nil {
+		return err
+	}
+	if localEnv != "PRD" && peerEnv == "PRD" {
+		return fmt.Errorf("refuse to sync from a non-PRD node to a PRD node")
+	}
+	return nil
+}
+
+func (t *T) getTargetNodenames(isSourceNode bool) []string {
+	if isSourceNode {
+		// if the instance is active, check last sync timestamp for each peer
+		return t.GetTargetPeernames(t.Target, t.Nodes, t.DRPNodes)
+	} else {
+		// if the instance is passive, check last sync timestamp for the local node (received from the source node)
+		return []string{hostname.Hostname()}
+	}
+}
@@ -0,0 +1,43 @@
+//go:build linux
+
+package ressyncbtrfs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigureSnapPaths(t *testing.T) {
+	cases := map[string]struct {
+		src, dst                    string
+		srcSent, dstSent, dstTosend string
+	}{
+		"same base names": {
+			src:       "/srv/pool1/data",
+			dst:       "/srv/pool2/data",
+			srcSent:   "/srv/pool1/.snap/data@sync.1.sent",
+			dstSent:   "/srv/pool2/.snap/data@sync.1.sent",
+			dstTosend: "/srv/pool2/.snap/data@sync.1.tosend",
+		},
+		"different base names": {
+			src:       "/srv/pool1/data",
+			dst:       "/srv/pool2/replica",
+			srcSent:   "/srv/pool1/.snap/data@sync.1.sent",
+			dstSent:   "/srv/pool2/.snap/data@sync.1.sent",
+			dstTosend: "/srv/pool2/.snap/data@sync.1.tosend",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := New().(*T)
+			require.NoError(t, r.SetRID("sync#1"))
+			r.Src = tc.src
+			r.Dst = tc.dst
+			require.NoError(t, r.Configure())
+			require.Equal(t, tc.srcSent, r.srcSnapSent)
+			require.Equal(t, tc.dstSent, r.dstSnapSent)
+			require.Equal(t, tc.dstTosend, r.dstSnapTosend)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+//go:build linux
+
+package ressyncbtrfs
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/keywords"
+	"github.com/opensvc/om3/v3/core/manifest"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/drivers/ressync"
+)
+
+var (
+	drvID = driver.NewID(driver.GroupSync, "btrfs")
+
+	//go:embed text
+	fs embed.FS
+
+	kws = []*keywords.Keyword{
+		{
+			Attr:      "Timeout",
+			Converter: "duration",
+			Example:   "5m",
+			Option:    "timeout",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/timeout"),
+		},
+		{
+			Attr:     "Src",
+			Example:  "/srv/{fqdn}",
+			Option:   "src",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/src"),
+		},
+		{
+			Attr:     "Dst",
+			Example:  "/srv/{fqdn}",
+			Option:   "dst",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/dst"),
+		},
+		{
+			Attr:       "Target",
+			Candidates: []string{"nodes", "drpnodes", "local"},
+			Converter:  "list",
+			Option:     "target",
+			Scopable:   true,
+			Text:       keywords.NewText(fs, "text/kw/target"),
+		},
+	}
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+func (t *T) DriverID() driver.ID {
+	return drvID
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t *T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc, naming.KindVol)
+	m.Add(
+		manifest.ContextObjectPath,
+		manifest.ContextNodes,
+		manifest.ContextDRPNodes,
+		manifest.ContextTopology,
+		manifest.ContextObjectID,
+	)
+	m.AddKeywords(ressync.BaseKeywords...)
+	m.AddKeywords(kws...)
+	return m
+}
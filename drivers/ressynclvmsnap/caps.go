@@ -0,0 +1,23 @@
+//go:build linux
+
+package ressynclvmsnap
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/v3/util/capabilities"
+	"github.com/opensvc/om3/v3/util/lvm2"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner(ctx context.Context) ([]string, error) {
+	baseCap := drvID.Cap()
+	l := make([]string, 0)
+	if lvm2.IsCapable() {
+		l = append(l, baseCap)
+	}
+	return l, nil
+}
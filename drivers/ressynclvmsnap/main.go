@@ -0,0 +1,186 @@
+//go:build linux
+
+package ressynclvmsnap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/v3/core/actioncontext"
+	"github.com/opensvc/om3/v3/core/provisioned"
+	"github.com/opensvc/om3/v3/core/resource"
+	"github.com/opensvc/om3/v3/core/status"
+	"github.com/opensvc/om3/v3/drivers/ressync"
+	"github.com/opensvc/om3/v3/util/lvm2"
+)
+
+// T is the driver structure.
+type (
+	T struct {
+		ressync.T
+		LV   []string
+		Keep int
+		Name string
+		Size string
+	}
+)
+
+const (
+	lockName = "sync"
+)
+
+func New() resource.Driver {
+	return &T{}
+}
+
+func (t *T) SortKey() string {
+	// The "+" ascii char is ordered before any rfc952 char, so using it
+	// as a prefix in the sort key makes sure it is ordered before any
+	// driver using t.ResourceID.Name as its sort key (which is the
+	// default).
+	return "+" + t.ResourceID.Name
+}
+
+func (t *T) Running() (resource.RunningInfoList, error) {
+	return t.RunningFromLock(lockName)
+}
+
+func (t *T) Update(ctx context.Context) error {
+	disable := actioncontext.IsLockDisabled(ctx)
+	timeout := actioncontext.LockTimeout(ctx)
+	unlock, err := t.Lock(disable, timeout, lockName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return t.lockedUpdate(ctx)
+}
+
+func (t *T) lockedUpdate(ctx context.Context) error {
+	if v, rids := t.IsInstanceSufficientlyStarted(ctx); !v {
+		t.Log().Tracef("the instance is not sufficiently started (%s). refuse to create snapshots", strings.Join(rids, ","))
+		return nil
+	}
+	for _, s := range t.LV {
+		lv, err := t.lv(s)
+		if err != nil {
+			return err
+		}
+		if _, err := lv.CreateSnapshot(ctx, t.Name, t.Size); err != nil {
+			return err
+		}
+		if err := lv.PruneSnapshots(ctx, t.Name, t.keep()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keep returns the number of snapshots to retain per logical volume. A
+// keep keyword value lower than 1 is treated as 1, so the snapshot just
+// created is never pruned.
+func (t *T) keep() int {
+	if t.Keep < 1 {
+		return 1
+	}
+	return t.Keep
+}
+
+func (t *T) lv(s string) (*lvm2.LV, error) {
+	vgName, lvName, ok := strings.Cut(s, "/")
+	if !ok || vgName == "" || lvName == "" {
+		return nil, fmt.Errorf("invalid lv %s: expected <vg>/<lv>", s)
+	}
+	return lvm2.NewLV(vgName, lvName, lvm2.WithLogger(t.Log())), nil
+}
+
+func (t *T) status(ctx context.Context, s string) status.T {
+	lv, err := t.lv(s)
+	if err != nil {
+		t.StatusLog().Error("%s", err)
+		return status.Undef
+	}
+	snaps, err := lv.Snapshots(ctx)
+	if err != nil {
+		if errors.Is(err, lvm2.ErrExist) {
+			return status.NotApplicable
+		}
+		t.StatusLog().Error("%s", err)
+		return status.Undef
+	}
+	snapCount := 0
+	issueCount := 0
+	for _, snap := range snaps {
+		if snap.Tag != t.Name {
+			continue
+		}
+		snapCount++
+		if snapCount == 1 {
+			maxDelay := t.GetMaxDelay(snap.CreatedAt)
+			if maxDelay == 0 {
+				continue
+			}
+			if age := time.Since(snap.CreatedAt); age > maxDelay {
+				t.StatusLog().Warn("%s last snap is too old, created at %s (>%s ago)", s, snap.CreatedAt, maxDelay)
+				issueCount++
+			}
+		}
+	}
+	if snapCount == 0 {
+		t.StatusLog().Warn("%s has no snap", s)
+		issueCount++
+	} else if n := snapCount - t.keep(); n > 0 {
+		t.StatusLog().Warn("%s has %d too many snaps", s, n)
+		issueCount++
+	}
+	if issueCount > 0 {
+		return status.Warn
+	}
+	return status.Up
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	var aggSt status.T
+	for _, s := range t.LV {
+		st := t.status(ctx, s)
+		aggSt.Add(st)
+	}
+	return aggSt
+}
+
+// Label implements Label from resource.Driver interface,
+// it returns a formatted short description of the Resource
+func (t *T) Label(_ context.Context) string {
+	if t.Name != "" {
+		return fmt.Sprintf("%s of %s", t.Name, strings.Join(t.LV, " "))
+	} else {
+		return fmt.Sprintf("of %s", strings.Join(t.LV, " "))
+	}
+}
+
+func (t *T) ScheduleOptions() resource.ScheduleOptions {
+	return resource.ScheduleOptions{
+		Action: "sync_update",
+		Option: "schedule",
+		Base:   "",
+	}
+}
+
+func (t *T) Provisioned(ctx context.Context) (provisioned.T, error) {
+	return provisioned.NotApplicable, nil
+}
+
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	m := resource.InfoKeys{
+		{Key: "lv", Value: strings.Join(t.LV, " ")},
+		{Key: "name", Value: t.Name},
+		{Key: "keep", Value: fmt.Sprintf("%d", t.keep())},
+		{Key: "size", Value: t.Size},
+		{Key: "max_delay", Value: fmt.Sprintf("%s", t.MaxDelay)},
+		{Key: "schedule", Value: t.Schedule},
+	}
+	return m, nil
+}
@@ -0,0 +1,73 @@
+//go:build linux
+
+package ressynclvmsnap
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/v3/core/driver"
+	"github.com/opensvc/om3/v3/core/keywords"
+	"github.com/opensvc/om3/v3/core/manifest"
+	"github.com/opensvc/om3/v3/core/naming"
+	"github.com/opensvc/om3/v3/drivers/ressync"
+)
+
+var (
+	drvID = driver.NewID(driver.GroupSync, "lvmsnap")
+
+	//go:embed text
+	fs embed.FS
+
+	Keywords = []*keywords.Keyword{
+		{
+			Attr:     "Name",
+			Example:  "weekly",
+			Option:   "name",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/name"),
+		},
+		{
+			Attr:      "LV",
+			Converter: "list",
+			Example:   "vg1/data vg1/log",
+			Option:    "lv",
+			Required:  true,
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/lv"),
+		},
+		{
+			Attr:      "Keep",
+			Converter: "int",
+			Default:   "3",
+			Example:   "3",
+			Option:    "keep",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/keep"),
+		},
+		{
+			Attr:     "Size",
+			Default:  "10%ORIGIN",
+			Example:  "1g",
+			Option:   "size",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/size"),
+		},
+	}
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+func (t *T) DriverID() driver.ID {
+	return drvID
+}
+
+// Manifest ...
+func (t *T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc, naming.KindVol)
+	m.AddKeywords(ressync.BaseKeywords...)
+	m.AddKeywords(Keywords...)
+	return m
+}
@@ -78,6 +78,8 @@ type (
 		Stop(context.Context) error
 		RemoveContainer(context.Context) error
 		ContainerInspectRefresh(context.Context) (rescontainerocibase.Inspecter, error)
+		ImageStatus(context.Context) *resource.ImageStatus
+		PullImage(context.Context) error
 		Signal(context.Context, syscall.Signal) error
 	}
 )
@@ -106,6 +108,25 @@ func (t *T) Stop(ctx context.Context) (err error) {
 	return nil
 }
 
+// PullImage fetches the task container image into the local image store.
+func (t *T) PullImage(ctx context.Context) error {
+	container := t.containerDetachedGetter.GetContainerDetached()
+	if container == nil {
+		return fmt.Errorf("unable to get task container")
+	}
+	return container.PullImage(ctx)
+}
+
+// ImageStatus returns the presence and identifier of the task container image
+// in the local image store.
+func (t *T) ImageStatus(ctx context.Context) *resource.ImageStatus {
+	container := t.containerDetachedGetter.GetContainerDetached()
+	if container == nil {
+		return nil
+	}
+	return container.ImageStatus(ctx)
+}
+
 func (t *T) lockedRun(ctx context.Context) (err error) {
 	container := t.containerDetachedGetter.GetContainerDetached()
 
@@ -58,6 +58,7 @@ require (
 	github.com/opensvc/testhelper v1.0.0
 	github.com/pbar1/pkill-go v1.0.0
 	github.com/pkg/errors v0.9.1
+	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2
 	github.com/prometheus/client_golang v1.22.0
 	github.com/prometheus/procfs v0.16.1
 	github.com/retailnext/cannula v0.0.0-20160516234737-f1c21e7f5695
@@ -128,7 +129,6 @@ require (
 	github.com/oasdiff/yaml3 v0.0.9 // indirect
 	github.com/opensvc/locker v1.0.3 // indirect
 	github.com/perimeterx/marshmallow v1.1.5 // indirect
-	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
 	github.com/prometheus/client_model v0.6.2 // indirect
 	github.com/prometheus/common v0.63.0 // indirect
 	github.com/rivo/uniseg v0.4.7 // indirect
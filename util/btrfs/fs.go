@@ -0,0 +1,141 @@
+package btrfs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	// Filesystem is a btrfs filesystem identified by one of its devices,
+	// or its LABEL= or UUID= specification.
+	Filesystem struct {
+		Device string
+		Log    *plog.Logger
+	}
+
+	// Usage is the space usage of a btrfs filesystem, in bytes.
+	Usage struct {
+		Size int64
+		Used int64
+		Free int64
+	}
+)
+
+// IsFormated returns true if the device hosts a btrfs filesystem.
+func (t *Filesystem) IsFormated(ctx context.Context) (bool, error) {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName(btrfsCmd),
+		command.WithVarArgs("filesystem", "show", t.Device),
+		command.WithLogger(t.Log),
+		command.WithBufferedStdout(),
+		command.WithBufferedStderr(),
+		command.WithCommandLogLevel(zerolog.TraceLevel),
+		command.WithIgnoredExitCodes(0, 1),
+	)
+	if err := cmd.Run(); err != nil {
+		return false, err
+	}
+	return cmd.ExitCode() == 0 && len(cmd.Stdout()) > 0, nil
+}
+
+// MKFS formats the device with a btrfs filesystem.
+func (t *Filesystem) MKFS(ctx context.Context, args []string) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName(mkfsCmd),
+		command.WithArgs(append(args, t.Device)),
+		command.WithLogger(t.Log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// Usage returns the space usage of the filesystem. The filesystem does not
+// need to be mounted.
+func (t *Filesystem) Usage(ctx context.Context) (Usage, error) {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName(btrfsCmd),
+		command.WithVarArgs("filesystem", "show", "--raw", t.Device),
+		command.WithLogger(t.Log),
+		command.WithBufferedStdout(),
+		command.WithCommandLogLevel(zerolog.TraceLevel),
+	)
+	if err := cmd.Run(); err != nil {
+		return Usage{}, err
+	}
+	return parseShow(cmd.Stdout())
+}
+
+// Devices returns the paths of the devices hosting the filesystem.
+func (t *Filesystem) Devices(ctx context.Context) ([]string, error) {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName(btrfsCmd),
+		command.WithVarArgs("filesystem", "show", "--raw", t.Device),
+		command.WithLogger(t.Log),
+		command.WithBufferedStdout(),
+		command.WithCommandLogLevel(zerolog.TraceLevel),
+	)
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	l := make([]string, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(cmd.Stdout()))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 8 && fields[0] == "devid" && fields[6] == "path" {
+			l = append(l, fields[7])
+		}
+	}
+	return l, nil
+}
+
+// parseShow parses the output of "btrfs filesystem show --raw":
+//
+//	Label: 'data'  uuid: 3b3a...
+//		Total devices 2 FS bytes used 1138688
+//		devid    1 size 10737418240 used 2155872256 path /dev/sdb
+//		devid    2 size 10737418240 used 2155872256 path /dev/sdc
+func parseShow(b []byte) (Usage, error) {
+	var (
+		usage Usage
+		found bool
+	)
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		switch {
+		case len(fields) >= 7 && fields[0] == "Total" && fields[3] == "FS":
+			i, err := strconv.ParseInt(fields[len(fields)-1], 10, 64)
+			if err != nil {
+				return usage, fmt.Errorf("parse fs bytes used: %w", err)
+			}
+			usage.Used = i
+			found = true
+		case len(fields) >= 4 && fields[0] == "devid" && fields[2] == "size":
+			i, err := strconv.ParseInt(fields[3], 10, 64)
+			if err != nil {
+				return usage, fmt.Errorf("parse device size: %w", err)
+			}
+			usage.Size += i
+		}
+	}
+	if !found {
+		return usage, fmt.Errorf("no btrfs filesystem found")
+	}
+	usage.Free = usage.Size - usage.Used
+	return usage, nil
+}
@@ -0,0 +1,24 @@
+package btrfs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseShow(t *testing.T) {
+	b := []byte(`Label: 'data'  uuid: 3b3a2f4e-7f2b-4e49-8a0c-7c1e6e0b8d21
+	Total devices 2 FS bytes used 1138688
+	devid    1 size 10737418240 used 2155872256 path /dev/sdb
+	devid    2 size 10737418240 used 2155872256 path /dev/sdc
+
+`)
+	usage, err := parseShow(b)
+	require.NoError(t, err)
+	require.Equal(t, int64(21474836480), usage.Size)
+	require.Equal(t, int64(1138688), usage.Used)
+	require.Equal(t, int64(21474836480-1138688), usage.Free)
+
+	_, err = parseShow([]byte("ERROR: not a valid btrfs filesystem: /dev/sdd\n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,101 @@
+// Package btrfs wraps the btrfs command to manage filesystems, subvolumes,
+// quota groups and snapshots, locally or on a peer node through ssh.
+package btrfs
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/opensvc/om3/v3/util/funcopt"
+	"github.com/opensvc/om3/v3/util/plog"
+	"github.com/opensvc/om3/v3/util/sshnode"
+)
+
+const (
+	btrfsCmd = "/usr/bin/btrfs"
+	mkfsCmd  = "/usr/sbin/mkfs.btrfs"
+)
+
+var (
+	// ErrNotFound is returned when a subvolume does not exist.
+	ErrNotFound = errors.New("subvolume not found")
+)
+
+func IsCapable() bool {
+	if _, err := exec.LookPath(btrfsCmd); err != nil {
+		return false
+	}
+	return true
+}
+
+// runner executes btrfs commands on the local node, or on Node through
+// ssh if set.
+type runner struct {
+	Log        *plog.Logger
+	Node       string
+	SSHKeyFile string
+}
+
+func (t runner) newSSHClient() (*ssh.Client, error) {
+	opts := make([]funcopt.O, 0)
+	if t.SSHKeyFile != "" {
+		opts = append(opts, sshnode.WithPrivateKeyFiles(t.SSHKeyFile))
+	}
+	return sshnode.NewClient(t.Node, opts...)
+}
+
+// output runs the command and returns its combined output. The command is
+// logged at info level if verbose is true, at trace level otherwise.
+func (t runner) output(verbose bool, name string, args ...string) ([]byte, error) {
+	cmd := exec.Command(name, args...)
+	cmdStr := cmd.String()
+	if t.Node == "" {
+		b, err := cmd.CombinedOutput()
+		t.logResult(verbose, cmdStr, b, err, cmd.ProcessState.ExitCode())
+		return b, err
+	}
+	client, err := t.newSSHClient()
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+	b, err := session.CombinedOutput(cmdStr)
+	ec := 0
+	var ee *ssh.ExitError
+	if errors.As(err, &ee) {
+		ec = ee.Waitmsg.ExitStatus()
+	}
+	t.logResult(verbose, "ssh "+t.Node+" "+cmdStr, b, err, ec)
+	return b, err
+}
+
+func (t runner) logResult(verbose bool, cmdStr string, b []byte, err error, ec int) {
+	if t.Log == nil {
+		return
+	}
+	switch {
+	case err != nil:
+		t.Log.
+			Attr("outputs", string(b)).
+			Tracef("%s: exited with code %d", cmdStr, ec)
+	case verbose:
+		t.Log.Infof("%s", cmdStr)
+	default:
+		t.Log.Tracef("%s", cmdStr)
+	}
+}
+
+func isNotFound(b []byte) bool {
+	s := string(b)
+	return strings.Contains(s, "No such file or directory") ||
+		strings.Contains(s, "not a subvolume") ||
+		strings.Contains(s, "Not a Btrfs subvolume")
+}
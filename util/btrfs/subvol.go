@@ -0,0 +1,118 @@
+package btrfs
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/opensvc/om3/v3/util/plog"
+)
+
+type (
+	// Subvolume is a btrfs subvolume identified by its path in a mounted
+	// btrfs filesystem.
+	Subvolume struct {
+		Path       string
+		Log        *plog.Logger
+		Node       string
+		SSHKeyFile string
+	}
+)
+
+func (t *Subvolume) runner() runner {
+	return runner{Log: t.Log, Node: t.Node, SSHKeyFile: t.SSHKeyFile}
+}
+
+// Exists returns true if Path is a btrfs subvolume.
+func (t *Subvolume) Exists() (bool, error) {
+	b, err := t.runner().output(false, btrfsCmd, "subvolume", "show", t.Path)
+	if err == nil {
+		return true, nil
+	} else if isNotFound(b) {
+		return false, nil
+	}
+	return false, fmt.Errorf("%s: subvolume show: %w: %s", t.Path, err, b)
+}
+
+// MkdirParent creates the missing parent directories of the subvolume.
+func (t *Subvolume) MkdirParent() error {
+	if b, err := t.runner().output(false, "mkdir", "-p", filepath.Dir(t.Path)); err != nil {
+		return fmt.Errorf("%s: create parent directory: %w: %s", t.Path, err, b)
+	}
+	return nil
+}
+
+// Create creates the subvolume, and its missing parent directories.
+func (t *Subvolume) Create() error {
+	if err := t.MkdirParent(); err != nil {
+		return err
+	}
+	if b, err := t.runner().output(true, btrfsCmd, "subvolume", "create", t.Path); err != nil {
+		return fmt.Errorf("%s: subvolume create: %w: %s", t.Path, err, b)
+	}
+	return nil
+}
+
+// Delete deletes the subvolume. Deleting a subvolume that does not exist is
+// not an error.
+func (t *Subvolume) Delete() error {
+	b, err := t.runner().output(true, btrfsCmd, "subvolume", "delete", t.Path)
+	if err == nil || isNotFound(b) {
+		return nil
+	}
+	return fmt.Errorf("%s: subvolume delete: %w: %s", t.Path, err, b)
+}
+
+// Snapshot creates a snapshot of the subvolume at dst. The snapshot is
+// read-only if readOnly is true, as required by btrfs send.
+func (t *Subvolume) Snapshot(dst string, readOnly bool) error {
+	snap := Subvolume{Path: dst, Log: t.Log, Node: t.Node, SSHKeyFile: t.SSHKeyFile}
+	if err := snap.MkdirParent(); err != nil {
+		return err
+	}
+	args := []string{"subvolume", "snapshot"}
+	if readOnly {
+		args = append(args, "-r")
+	}
+	args = append(args, t.Path, dst)
+	if b, err := t.runner().output(true, btrfsCmd, args...); err != nil {
+		return fmt.Errorf("%s: subvolume snapshot to %s: %w: %s", t.Path, dst, err, b)
+	}
+	return nil
+}
+
+// Rename moves the subvolume to dst, in the same filesystem.
+func (t *Subvolume) Rename(dst string) error {
+	if b, err := t.runner().output(true, "mv", "-T", t.Path, dst); err != nil {
+		return fmt.Errorf("%s: rename to %s: %w: %s", t.Path, dst, err, b)
+	}
+	return nil
+}
+
+// SetLimit enables the quota accounting of the filesystem hosting the
+// subvolume, and sets the size limit of the subvolume quota group.
+func (t *Subvolume) SetLimit(size int64) error {
+	if b, err := t.runner().output(true, btrfsCmd, "quota", "enable", t.Path); err != nil {
+		return fmt.Errorf("%s: quota enable: %w: %s", t.Path, err, b)
+	}
+	if b, err := t.runner().output(true, btrfsCmd, "qgroup", "limit", strconv.FormatInt(size, 10), t.Path); err != nil {
+		return fmt.Errorf("%s: qgroup limit: %w: %s", t.Path, err, b)
+	}
+	return nil
+}
+
+// SendCmd returns the command sending the subvolume, incrementally from
+// parent if not empty.
+func (t *Subvolume) SendCmd(parent string) []string {
+	args := []string{btrfsCmd, "send"}
+	if parent != "" {
+		args = append(args, "-p", parent)
+	}
+	return append(args, t.Path)
+}
+
+// ReceiveCmd returns the command receiving a subvolume sent by SendCmd in
+// the dir directory.
+func ReceiveCmd(dir string) []string {
+	return []string{btrfsCmd, "receive", dir}
+}
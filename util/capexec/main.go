@@ -27,6 +27,12 @@ type (
 		PGVMemLimit     *string
 		PGMemSwappiness *string
 		PGBlockIOWeight *string
+		PGIOMax         *string
+		PGPidsMax       *string
+		PGMemHigh       *string
+		PGMemMin        *string
+		PGCPUWeight     *string
+		PGCPUMaxBurst   *string
 		LimitAS         *string
 		LimitCPU        *string
 		LimitCore       *string
@@ -75,6 +81,24 @@ func (t T) Argv() []string {
 	if t.PGBlockIOWeight != nil {
 		argv = append(argv, "--pg-blkio-weight", *t.PGBlockIOWeight)
 	}
+	if t.PGIOMax != nil {
+		argv = append(argv, "--pg-io-max", *t.PGIOMax)
+	}
+	if t.PGPidsMax != nil {
+		argv = append(argv, "--pg-pids-max", *t.PGPidsMax)
+	}
+	if t.PGMemHigh != nil {
+		argv = append(argv, "--pg-mem-high", *t.PGMemHigh)
+	}
+	if t.PGMemMin != nil {
+		argv = append(argv, "--pg-mem-min", *t.PGMemMin)
+	}
+	if t.PGCPUWeight != nil {
+		argv = append(argv, "--pg-cpu-weight", *t.PGCPUWeight)
+	}
+	if t.PGCPUMaxBurst != nil {
+		argv = append(argv, "--pg-cpu-max-burst", *t.PGCPUMaxBurst)
+	}
 	if t.LimitAS != nil {
 		argv = append(argv, "--limit-as", *t.LimitAS)
 	}
@@ -209,6 +233,24 @@ func (t T) toPG() pg.Config {
 	if t.PGBlockIOWeight != nil {
 		pg.BlockIOWeight = *t.PGBlockIOWeight
 	}
+	if t.PGIOMax != nil {
+		pg.IOMax = *t.PGIOMax
+	}
+	if t.PGPidsMax != nil {
+		pg.PidsMax = *t.PGPidsMax
+	}
+	if t.PGMemHigh != nil {
+		pg.MemHigh = *t.PGMemHigh
+	}
+	if t.PGMemMin != nil {
+		pg.MemMin = *t.PGMemMin
+	}
+	if t.PGCPUWeight != nil {
+		pg.CPUWeight = *t.PGCPUWeight
+	}
+	if t.PGCPUMaxBurst != nil {
+		pg.CPUMaxBurst = *t.PGCPUMaxBurst
+	}
 	return pg
 }
 
@@ -290,6 +332,24 @@ func (t *T) LoadPG(g pg.Config) {
 	if g.BlockIOWeight != "" {
 		t.PGBlockIOWeight = &g.BlockIOWeight
 	}
+	if g.IOMax != "" {
+		t.PGIOMax = &g.IOMax
+	}
+	if g.PidsMax != "" {
+		t.PGPidsMax = &g.PidsMax
+	}
+	if g.MemHigh != "" {
+		t.PGMemHigh = &g.MemHigh
+	}
+	if g.MemMin != "" {
+		t.PGMemMin = &g.MemMin
+	}
+	if g.CPUWeight != "" {
+		t.PGCPUWeight = &g.CPUWeight
+	}
+	if g.CPUMaxBurst != "" {
+		t.PGCPUMaxBurst = &g.CPUMaxBurst
+	}
 }
 
 func (t *T) FlagSet(flags *pflag.FlagSet) {
@@ -303,6 +363,12 @@ func (t *T) FlagSet(flags *pflag.FlagSet) {
 	t.PGVMemLimit = flags.String("pg-vmem-limit", "", "the cpu hardcap limit (in usecs). allowed cpu time in a given period")
 	t.PGMemSwappiness = flags.String("pg-mem-swappiness", "", "the cpu hardcap limit (in usecs). allowed cpu time in a given period")
 	t.PGBlockIOWeight = flags.String("pg-blkio-weight", "", "the cpu hardcap limit (in usecs). allowed cpu time in a given period")
+	t.PGIOMax = flags.String("pg-io-max", "", "the per-device io limits of the process group (ex: /dev/sda rbps=10m wiops=100)")
+	t.PGPidsMax = flags.String("pg-pids-max", "", "the maximum number of tasks in the process group")
+	t.PGMemHigh = flags.String("pg-mem-high", "", "the memory usage throttle limit of the process group")
+	t.PGMemMin = flags.String("pg-mem-min", "", "the memory usage hard protection of the process group")
+	t.PGCPUWeight = flags.String("pg-cpu-weight", "", "the cpu weight of the process group (1-10000)")
+	t.PGCPUMaxBurst = flags.String("pg-cpu-max-burst", "", "the cpu time the process group can burst over its quota (ex: 20ms)")
 	t.LimitAS = flags.String("limit-as", "", "the maximum area (in bytes) of address space which may be taken by the process")
 	t.LimitCPU = flags.String("limit-cpu", "", "the maximum amount of processor time (in seconds) that a process can use")
 	t.LimitCore = flags.String("limit-core", "", "the maximum size (in bytes) of a core file that the current process can create")
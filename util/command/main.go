@@ -89,6 +89,7 @@ func New(opts ...funcopt.O) *T {
 		t.ctx, t.cancel = context.WithTimeout(t.ctx, t.timeout)
 	}
 	t.cmd = exec.CommandContext(t.ctx, t.name, t.args...)
+	setTerminate(t.ctx, t.cmd)
 	return t
 }
 
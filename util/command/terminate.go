@@ -0,0 +1,47 @@
+package command
+
+import (
+	"context"
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+type (
+	terminateKey struct{}
+
+	terminatePolicy struct {
+		grace       time.Duration
+		onTerminate func(pid int)
+	}
+)
+
+// ContextWithTerminate returns a copy of ctx requesting the commands created
+// with this context to be sent a SIGTERM when the context is done, then a
+// SIGKILL if they are still running after the grace delay.
+//
+// The onTerminate function, if not nil, is called with the command pid before
+// the SIGTERM is sent, so the caller can capture diagnostics of the process.
+func ContextWithTerminate(ctx context.Context, grace time.Duration, onTerminate func(pid int)) context.Context {
+	return context.WithValue(ctx, terminateKey{}, terminatePolicy{
+		grace:       grace,
+		onTerminate: onTerminate,
+	})
+}
+
+// setTerminate configures the exec.Cmd cancellation according to the
+// terminate policy found in ctx, if any. Without policy, the exec.Cmd
+// default is to kill the process when the context is done.
+func setTerminate(ctx context.Context, cmd *exec.Cmd) {
+	p, ok := ctx.Value(terminateKey{}).(terminatePolicy)
+	if !ok {
+		return
+	}
+	cmd.Cancel = func() error {
+		if p.onTerminate != nil {
+			p.onTerminate(cmd.Process.Pid)
+		}
+		return cmd.Process.Signal(syscall.SIGTERM)
+	}
+	cmd.WaitDelay = p.grace
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextWithTerminate(t *testing.T) {
+	var terminated int
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	ctx = ContextWithTerminate(ctx, 200*time.Millisecond, func(pid int) {
+		terminated = pid
+	})
+
+	// the command ignores SIGTERM, so it is expected to be killed after the
+	// grace delay.
+	cmd := New(
+		WithContext(ctx),
+		WithName("/bin/sh"),
+		WithVarArgs("-c", `trap "" TERM; exec sleep 10`),
+	)
+	begin := time.Now()
+	require.NoError(t, cmd.Start())
+	pid := cmd.Cmd().Process.Pid
+	err := cmd.Wait()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, pid, terminated)
+	require.Less(t, time.Since(begin), 5*time.Second)
+	require.GreaterOrEqual(t, time.Since(begin), 300*time.Millisecond)
+}
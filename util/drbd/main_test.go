@@ -4,6 +4,7 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -51,3 +52,33 @@ func TestParseDump(t *testing.T) {
 		require.Equal(t, "/dev/demo-focal/focal1", volume.Disk)
 	})
 }
+
+func TestParseStatus(t *testing.T) {
+	b, err := os.ReadFile(path.Join("testdata", "drbdsetup.status-json"))
+	require.Nil(t, err)
+	status, err := ParseStatus(b)
+	require.Nil(t, err)
+	require.Equal(t, "demo-focal1", status.Name)
+	require.Equal(t, "Primary", status.Role)
+	require.Len(t, status.Devices, 1)
+	require.Equal(t, DiskStateUpToDate, status.Devices[0].DiskState)
+	require.Len(t, status.Connections, 2)
+	require.Equal(t, "pulsar", status.Connections[1].Name)
+	require.Equal(t, ConnStateStandAlone, status.Connections[1].ConnectionState)
+
+	peerDevice := status.Connections[0].PeerDevices[0]
+	require.True(t, peerDevice.IsSyncing())
+	require.Equal(t, 40.0, peerDevice.PercentInSync)
+	// 614400 sectors in 3s is 100 MiB/s, so 6 GiB to resync in about 61s
+	require.Equal(t, 61*time.Second, peerDevice.ETA())
+	require.False(t, status.Connections[1].PeerDevices[0].IsSyncing())
+	require.Equal(t, time.Duration(0), status.Connections[1].PeerDevices[0].ETA())
+}
+
+func TestSplitBrainNetOptions(t *testing.T) {
+	opts, err := SplitBrainNetOptions(SplitBrainRecoveryDiscardYounger)
+	require.Nil(t, err)
+	require.Equal(t, []string{"--after-sb-0pri=discard-younger-primary", "--after-sb-1pri=discard-secondary", "--after-sb-2pri=disconnect"}, opts)
+	_, err = SplitBrainNetOptions("foo")
+	require.Error(t, err)
+}
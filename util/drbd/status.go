@@ -0,0 +1,208 @@
+package drbd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/util/command"
+)
+
+type (
+	// Status is a drbd resource status, as reported by
+	// "drbdsetup status --json".
+	Status struct {
+		Name        string             `json:"name"`
+		NodeID      int                `json:"node-id"`
+		Role        string             `json:"role"`
+		Devices     []DeviceStatus     `json:"devices"`
+		Connections []ConnectionStatus `json:"connections"`
+	}
+
+	// DeviceStatus is the status of a local volume of a drbd resource.
+	DeviceStatus struct {
+		Volume    int    `json:"volume"`
+		Minor     int    `json:"minor"`
+		DiskState string `json:"disk-state"`
+	}
+
+	// ConnectionStatus is the status of the connection to a peer.
+	ConnectionStatus struct {
+		PeerNodeID      int                `json:"peer-node-id"`
+		Name            string             `json:"name"`
+		ConnectionState string             `json:"connection-state"`
+		PeerRole        string             `json:"peer-role"`
+		PeerDevices     []PeerDeviceStatus `json:"peer_devices"`
+	}
+
+	// PeerDeviceStatus is the replication status of a volume with a peer.
+	PeerDeviceStatus struct {
+		Volume           int    `json:"volume"`
+		ReplicationState string `json:"replication-state"`
+		PeerDiskState    string `json:"peer-disk-state"`
+
+		// OutOfSync is the amount of data to resync, in KiB.
+		OutOfSync int64 `json:"out-of-sync"`
+
+		PercentInSync float64 `json:"percent-in-sync"`
+
+		// RsDb1Sectors is the number of sectors resynced during the last
+		// RsDt1Ms milliseconds, used to estimate the resync rate.
+		RsDb1Sectors int64 `json:"rs-db1-sectors"`
+		RsDt1Ms      int64 `json:"rs-dt1-ms"`
+	}
+)
+
+const (
+	ReplStateSyncSource       = "SyncSource"
+	ReplStateSyncTarget       = "SyncTarget"
+	ReplStatePausedSyncSource = "PausedSyncS"
+	ReplStatePausedSyncTarget = "PausedSyncT"
+
+	DiskStateUpToDate     = "UpToDate"
+	DiskStateOutdated     = "Outdated"
+	DiskStateInconsistent = "Inconsistent"
+
+	// SplitBrainRecoveryManual leaves the split-brain victims disconnected,
+	// waiting for a "drbd resolve" action.
+	SplitBrainRecoveryManual = "manual"
+
+	// SplitBrainRecoveryDiscardYounger discards the changes of the node
+	// which became primary last.
+	SplitBrainRecoveryDiscardYounger = "discard-younger"
+
+	// SplitBrainRecoveryDiscardLeastChanges discards the changes of the
+	// node with the fewest changed blocks.
+	SplitBrainRecoveryDiscardLeastChanges = "discard-least-changes"
+)
+
+var (
+	// SplitBrainRecoveries is the list of supported split-brain recovery
+	// policies.
+	SplitBrainRecoveries = []string{
+		SplitBrainRecoveryManual,
+		SplitBrainRecoveryDiscardYounger,
+		SplitBrainRecoveryDiscardLeastChanges,
+	}
+)
+
+// ParseStatus parses the "drbdsetup status --json" output of a single
+// resource.
+func ParseStatus(b []byte) (Status, error) {
+	var l []Status
+	if err := json.Unmarshal(b, &l); err != nil {
+		return Status{}, err
+	} else if len(l) == 0 {
+		return Status{}, fmt.Errorf("no drbd resource found")
+	}
+	return l[0], nil
+}
+
+// Status returns the resource status, with the per-peer connection and
+// replication states. It requires drbd9.
+func (t *T) Status(ctx context.Context) (Status, error) {
+	cmd := command.New(
+		command.WithName(drbdsetup),
+		command.WithVarArgs("status", "--json", t.res),
+		command.WithBufferedStdout(),
+		command.WithContext(ctx),
+		command.WithLogger(t.log),
+		command.WithCommandLogLevel(zerolog.TraceLevel),
+	)
+	b, err := cmd.Output()
+	if err != nil {
+		return Status{}, err
+	}
+	return ParseStatus(b)
+}
+
+// IsSyncing returns true if the volume is resyncing from or to the peer.
+func (t PeerDeviceStatus) IsSyncing() bool {
+	switch t.ReplicationState {
+	case ReplStateSyncSource, ReplStateSyncTarget, ReplStatePausedSyncSource, ReplStatePausedSyncTarget:
+		return true
+	default:
+		return false
+	}
+}
+
+// ETA returns the estimated duration of the resync, from the last resync
+// rate sample. It returns zero if the rate is unknown.
+func (t PeerDeviceStatus) ETA() time.Duration {
+	if t.RsDb1Sectors <= 0 || t.RsDt1Ms <= 0 {
+		return 0
+	}
+	// sectors are 512 bytes, out-of-sync is in KiB
+	kibPerMs := float64(t.RsDb1Sectors) / 2 / float64(t.RsDt1Ms)
+	return (time.Duration(float64(t.OutOfSync)/kibPerMs) * time.Millisecond).Round(time.Second)
+}
+
+// SplitBrainNetOptions returns the after-sb-* net options implementing the
+// split-brain recovery policy.
+//
+// The automatic policies apply when no node, or a single node, was primary
+// during the split-brain. The split-brains with two primaries always need a
+// manual resolution.
+func SplitBrainNetOptions(policy string) ([]string, error) {
+	var sb0pri string
+	sb1pri := "discard-secondary"
+	switch policy {
+	case SplitBrainRecoveryManual:
+		sb0pri = "disconnect"
+		sb1pri = "disconnect"
+	case SplitBrainRecoveryDiscardYounger:
+		sb0pri = "discard-younger-primary"
+	case SplitBrainRecoveryDiscardLeastChanges:
+		sb0pri = "discard-least-changes"
+	default:
+		return nil, fmt.Errorf("invalid split-brain recovery policy %s: expected one of %v", policy, SplitBrainRecoveries)
+	}
+	return []string{
+		"--after-sb-0pri=" + sb0pri,
+		"--after-sb-1pri=" + sb1pri,
+		"--after-sb-2pri=disconnect",
+	}, nil
+}
+
+// SetSplitBrainRecovery sets the after-sb-* net options of the resource
+// connections to implement the split-brain recovery policy.
+func (t *T) SetSplitBrainRecovery(ctx context.Context, policy string) error {
+	opts, err := SplitBrainNetOptions(policy)
+	if err != nil {
+		return err
+	}
+	args := append([]string{"net-options"}, opts...)
+	args = append(args, t.res)
+	cmd := command.New(
+		command.WithName(drbdadm),
+		command.WithArgs(args),
+		command.WithLogger(t.log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+		command.WithContext(ctx),
+	)
+	return retry(cmd)
+}
+
+// ConnectDiscardMyData reconnects the resource to its peers, discarding the
+// local changes made during a split-brain. The local node must be secondary.
+func (t *T) ConnectDiscardMyData(ctx context.Context) error {
+	f := func(ctx context.Context) error {
+		args := []string{"connect", "--discard-my-data", t.res}
+		cmd := command.New(
+			command.WithName(drbdadm),
+			command.WithArgs(args),
+			command.WithLogger(t.log),
+			command.WithCommandLogLevel(zerolog.InfoLevel),
+			command.WithStdoutLogLevel(zerolog.InfoLevel),
+			command.WithStderrLogLevel(zerolog.ErrorLevel),
+			command.WithContext(ctx),
+		)
+		return retry(cmd)
+	}
+	return t.withLock(ctx, f, "drbdadm connect --discard-my-data", time.Second)
+}
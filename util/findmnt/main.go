@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/opensvc/om3/v3/util/capabilities"
+	"github.com/opensvc/om3/v3/util/sizeconv"
 )
 
 type (
@@ -34,6 +35,15 @@ const (
 
 var (
 	jsonFlag = "-J"
+
+	// ignoredOptions are the mount options not reported for an active
+	// mount, because they are only used by the mount command or are kernel
+	// defaults.
+	ignoredOptions = []string{
+		"_netdev", "async", "auto", "bind", "comment", "defaults", "dev",
+		"exec", "group", "loop", "noauto", "nofail", "nouser", "owner",
+		"rbind", "suid", "user", "users",
+	}
 )
 
 // Has returns true when {dev} is mounted on {mnt} using the findmnt command
@@ -160,6 +170,53 @@ func List(ctx context.Context, dev string, mnt string) (mounts []MountInfo, err
 	return
 }
 
+// MissingOptions returns the mount options of {want} not found in the
+// {have} options reported for an active mount. The options only used by
+// the mount command, or implied by the kernel defaults, are ignored.
+// The option values are compared as sizes when possible, as the kernel
+// reports the tmpfs size=1g option as size=1048576k.
+func MissingOptions(want, have string) []string {
+	var l []string
+	haveOptions := strings.Split(have, ",")
+	for _, option := range strings.Split(want, ",") {
+		name, _, _ := strings.Cut(option, "=")
+		switch {
+		case option == "":
+			continue
+		case slices.Contains(ignoredOptions, name):
+			continue
+		case strings.HasPrefix(name, "x-"):
+			continue
+		case slices.ContainsFunc(haveOptions, func(s string) bool { return isSameOption(option, s) }):
+			continue
+		}
+		l = append(l, option)
+	}
+	return l
+}
+
+// isSameOption returns true if the mount options a and b have the same
+// name and the same value, or values converting to the same size.
+func isSameOption(a, b string) bool {
+	if a == b {
+		return true
+	}
+	aName, aValue, aHasValue := strings.Cut(a, "=")
+	bName, bValue, bHasValue := strings.Cut(b, "=")
+	if aName != bName || !aHasValue || !bHasValue {
+		return false
+	}
+	aSize, err := sizeconv.FromSize(aValue)
+	if err != nil {
+		return false
+	}
+	bSize, err := sizeconv.FromSize(bValue)
+	if err != nil {
+		return false
+	}
+	return aSize == bSize
+}
+
 // findMntArgs returns findmnt exec args for dev and mnt.
 // When dev is on nfs, -T mnt is skipped to prevent command hang
 // When dev is dir, -S dev is skipped
@@ -74,3 +74,17 @@ TARGET="/mnt/nas/Documents partagés" SOURCE="nas.local:/volume1/documents" FSTY
 		})
 	}
 }
+
+func TestMissingOptions(t *testing.T) {
+	have := "rw,nosuid,nodev,relatime,errors=remount-ro"
+	require.Empty(t, MissingOptions("", have))
+	require.Empty(t, MissingOptions("defaults,_netdev,nofail,x-systemd.automount", have))
+	require.Empty(t, MissingOptions("nosuid,nodev,errors=remount-ro", have))
+	require.Equal(t, []string{"noexec", "errors=panic"}, MissingOptions("nosuid,noexec,errors=panic", have))
+
+	tmpfs := "rw,nosuid,nodev,size=1048576k,mode=755,inode64"
+	require.Empty(t, MissingOptions("size=1g,mode=0755", tmpfs))
+	require.Empty(t, MissingOptions("size=1048576k", tmpfs))
+	require.Equal(t, []string{"size=2g"}, MissingOptions("size=2g", tmpfs))
+	require.Equal(t, []string{"size=50%"}, MissingOptions("size=50%", tmpfs))
+}
@@ -0,0 +1,235 @@
+//go:build linux
+
+package lvm2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/v3/util/command"
+	"github.com/opensvc/om3/v3/util/sizeconv"
+)
+
+type (
+	// SnapshotInfo describes a snapshot logical volume of an origin
+	// logical volume.
+	SnapshotInfo struct {
+		LVName    string
+		VGName    string
+		Origin    string
+		Tag       string
+		Size      string
+		Thin      bool
+		Open      bool
+		CreatedAt time.Time
+	}
+
+	snapshotShowData struct {
+		Report []struct {
+			LV []struct {
+				LVName string `json:"lv_name"`
+				VGName string `json:"vg_name"`
+				LVAttr string `json:"lv_attr"`
+				LVSize string `json:"lv_size"`
+				Origin string `json:"origin"`
+			} `json:"lv"`
+		} `json:"report"`
+	}
+)
+
+const (
+	// LVAttrTypeThinVolume is the lv_attr type field value of a thin volume.
+	LVAttrTypeThinVolume LVAttr = 'V'
+
+	// LVAttrDeviceOpen is the lv_attr open field value of an in-use volume.
+	LVAttrDeviceOpen LVAttr = 'o'
+
+	// DefaultSnapshotSize is the size allocated to a classic (non-thin)
+	// snapshot when none is specified.
+	DefaultSnapshotSize = "10%ORIGIN"
+
+	snapshotSep = ".snap."
+
+	// snapshotTimeFormat has a microsecond resolution, so the snapshots
+	// created in the same second don't collide.
+	snapshotTimeFormat = "20060102.150405.000000"
+
+	// snapshotSecondTimeFormat is the time format of the snapshots named
+	// before the microsecond resolution was introduced.
+	snapshotSecondTimeFormat = "20060102.150405"
+)
+
+// SnapshotName returns the name of a snapshot of the origin lv, tagged by
+// tag and created at tm: <lv>.<tag>.snap.<20060102.150405.000000>
+func SnapshotName(lv, tag string, tm time.Time) string {
+	return fmt.Sprintf("%s.%s%s%s", lv, tag, snapshotSep, tm.Format(snapshotTimeFormat))
+}
+
+// ParseSnapshotName returns the tag and creation time embedded in a snapshot
+// name of the origin lv. The ok return value is false if name is not a
+// snapshot name formatted by SnapshotName.
+func ParseSnapshotName(lv, name string) (tag string, tm time.Time, ok bool) {
+	prefix := lv + "."
+	if !strings.HasPrefix(name, prefix) {
+		return
+	}
+	s := name[len(prefix):]
+	i := strings.LastIndex(s, snapshotSep)
+	if i < 0 {
+		return
+	}
+	for _, layout := range []string{snapshotTimeFormat, snapshotSecondTimeFormat} {
+		if t, err := time.ParseInLocation(layout, s[i+len(snapshotSep):], time.Local); err == nil {
+			return s[:i], t, true
+		}
+	}
+	return
+}
+
+// IsThin returns true if the logical volume is a thin volume, whose
+// snapshots don't need a preallocated size.
+func (t *LV) IsThin(ctx context.Context) (bool, error) {
+	if attrs, err := t.Attrs(ctx); err != nil {
+		return false, err
+	} else {
+		return attrs.Attr(LVAttrIndexType) == LVAttrTypeThinVolume, nil
+	}
+}
+
+// CreateSnapshot creates a snapshot of the logical volume named after tag
+// and the current time, and returns its name. The size is only used for
+// classic snapshots, and defaults to DefaultSnapshotSize.
+func (t *LV) CreateSnapshot(ctx context.Context, tag, size string) (string, error) {
+	thin, err := t.IsThin(ctx)
+	if err != nil {
+		return "", err
+	}
+	name := SnapshotName(t.LVName, tag, time.Now())
+	args := []string{"-s", "-n", name}
+	if !thin {
+		if size == "" {
+			size = DefaultSnapshotSize
+		}
+		if strings.Contains(size, "%") {
+			args = append(args, "-l", size)
+		} else if i, err := sizeconv.FromSize(size); err == nil {
+			args = append(args, "-L", fmt.Sprintf("%dB", i))
+		} else {
+			args = append(args, "-L", size)
+		}
+	}
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("lvcreate"),
+		command.WithArgs(append(args, t.FQN())),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	if err := cmd.Run(); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// Snapshots returns the snapshots of the logical volume formatted by
+// SnapshotName, ordered from the most recent to the oldest.
+func (t *LV) Snapshots(ctx context.Context) ([]SnapshotInfo, error) {
+	data := snapshotShowData{}
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("lvs"),
+		command.WithVarArgs("-o", "lv_name,vg_name,lv_attr,lv_size,origin", "--reportformat", "json", t.VGName),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.TraceLevel),
+		command.WithStdoutLogLevel(zerolog.TraceLevel),
+		command.WithStderrLogLevel(zerolog.TraceLevel),
+		command.WithBufferedStdout(),
+	)
+	if err := cmd.Run(); err != nil {
+		if cmd.ExitCode() == 5 {
+			return nil, fmt.Errorf("%w: %s", ErrExist, t.VGName)
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(cmd.Stdout(), &data); err != nil {
+		return nil, err
+	}
+	l := make([]SnapshotInfo, 0)
+	for _, report := range data.Report {
+		for _, info := range report.LV {
+			if info.Origin != t.LVName {
+				continue
+			}
+			tag, tm, ok := ParseSnapshotName(t.LVName, info.LVName)
+			if !ok {
+				continue
+			}
+			attrs := LVAttrs(info.LVAttr)
+			l = append(l, SnapshotInfo{
+				LVName:    info.LVName,
+				VGName:    info.VGName,
+				Origin:    info.Origin,
+				Tag:       tag,
+				Size:      info.LVSize,
+				Thin:      attrs.Attr(LVAttrIndexType) == LVAttrTypeThinVolume,
+				Open:      attrs.Attr(LVAttrIndexDeviceOpen) == LVAttrDeviceOpen,
+				CreatedAt: tm,
+			})
+		}
+	}
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].CreatedAt.After(l[j].CreatedAt)
+	})
+	return l, nil
+}
+
+// PruneSnapshots removes the snapshots tagged by tag beyond the keep most
+// recent ones. A keep value lower than 1 is treated as 1, so the most
+// recent snapshot is never removed.
+func (t *LV) PruneSnapshots(ctx context.Context, tag string, keep int) error {
+	if keep < 1 {
+		keep = 1
+	}
+	l, err := t.Snapshots(ctx)
+	if err != nil {
+		return err
+	}
+	kept := 0
+	for _, snap := range l {
+		if snap.Tag != tag {
+			continue
+		}
+		if kept < keep {
+			kept++
+			continue
+		}
+		if err := NewLV(snap.VGName, snap.LVName, WithLogger(t.Log())).Remove(ctx, []string{"-f"}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MergeSnapshot merges the snapshot named name back into the logical volume,
+// restoring the volume content to the snapshot state. The snapshot is
+// consumed by the merge.
+func (t *LV) MergeSnapshot(ctx context.Context, name string) error {
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("lvconvert"),
+		command.WithVarArgs("--merge", t.VGName+"/"+name),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
@@ -0,0 +1,37 @@
+//go:build linux
+
+package lvm2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSnapshotName(t *testing.T) {
+	tm := time.Date(2024, 3, 9, 10, 9, 52, 123456000, time.Local)
+	name := SnapshotName("data", "weekly", tm)
+	require.Equal(t, "data.weekly.snap.20240309.100952.123456", name)
+
+	tag, parsed, ok := ParseSnapshotName("data", name)
+	require.True(t, ok)
+	require.Equal(t, "weekly", tag)
+	require.True(t, tm.Equal(parsed))
+
+	require.NotEqual(t, name, SnapshotName("data", "weekly", tm.Add(time.Millisecond)), "same second")
+
+	tag, parsed, ok = ParseSnapshotName("data", "data.weekly.snap.20240309.100952")
+	require.True(t, ok, "second resolution name")
+	require.Equal(t, "weekly", tag)
+	require.True(t, tm.Truncate(time.Second).Equal(parsed))
+
+	_, _, ok = ParseSnapshotName("log", name)
+	require.False(t, ok, "other origin")
+
+	_, _, ok = ParseSnapshotName("data", "data.weekly.snap.notadate")
+	require.False(t, ok, "bad date")
+
+	_, _, ok = ParseSnapshotName("data", "data_bak")
+	require.False(t, ok, "not a snapshot name")
+}
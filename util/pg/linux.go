@@ -5,11 +5,16 @@ package pg
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/containerd/cgroups"
 	cgroupsv2 "github.com/containerd/cgroups/v2"
 	"github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
 
 	"github.com/opensvc/om3/v3/util/converters"
 	"github.com/opensvc/om3/v3/util/sizeconv"
@@ -81,15 +86,44 @@ func (c Config) ApplyProc(pid int) (created bool, errs error) {
 			r.BlockIO.Weight = &weight
 		}
 	}
+	if c.PidsMax == "max" {
+		// a negative limit is written as "max"
+		r.Pids = &specs.LinuxPids{Limit: -1}
+	} else if c.PidsMax != "" {
+		if n, err := strconv.ParseInt(c.PidsMax, 10, 64); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("pg_pids_max: %w", err))
+		} else {
+			r.Pids = &specs.LinuxPids{Limit: n}
+		}
+	}
+	if c.IOMax != "" {
+		if err := parseIOMax(c.IOMax, r.BlockIO); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("pg_io_max: %w", err))
+		}
+	}
+
+	res := cgroupsv2.ToResources(&r)
+	v2Files, err := c.v2Resources(res)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
 
-	control, err := cgroupsv2.NewManager(UnifiedPath(), c.ID, cgroupsv2.ToResources(&r))
+	control, err := cgroupsv2.NewManager(UnifiedPath(), c.ID, res)
 	if err == nil {
+		for _, v := range v2Files {
+			if err := os.WriteFile(filepath.Join(UnifiedPath(), c.ID, v[0]), []byte(v[1]), 0644); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("pg %s: %w", c.ID, err))
+			}
+		}
 		if pid == 0 {
 			// pass
 		} else if err := control.AddProc(uint64(pid)); err != nil {
 			errs = errors.Join(errs, fmt.Errorf("add pid to pg %s: %w", c.ID, err))
 		}
 	} else {
+		if c.log != nil && c.hasV2Only() {
+			c.log.Warnf("pg %s: pg_mem_high, pg_mem_min, pg_cpu_weight and pg_cpu_max_burst are ignored on a cgroup v1 hierarchy", c.ID)
+		}
 		control, err := cgroups.New(cgroups.V1, cgroups.StaticPath(c.ID), &r)
 		if err != nil {
 			errs = errors.Join(errs, fmt.Errorf("new pg %s: %w", c.ID, err))
@@ -104,6 +138,136 @@ func (c Config) ApplyProc(pid int) (created bool, errs error) {
 	return
 }
 
+func (c Config) hasV2Only() bool {
+	return c.MemHigh != "" || c.MemMin != "" || c.CPUWeight != "" || c.CPUMaxBurst != ""
+}
+
+// v2Resources adds to res the cgroup v2 only settings, and returns the
+// file name and value pairs of the settings not supported by res, to
+// write in the cgroup directory once created.
+func (c Config) v2Resources(res *cgroupsv2.Resources) (files [][2]string, errs error) {
+	if c.CPUWeight != "" {
+		if n, err := strconv.ParseUint(c.CPUWeight, 10, 64); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("pg_cpu_weight: %w", err))
+		} else if n < 1 || n > 10000 {
+			errs = errors.Join(errs, fmt.Errorf("pg_cpu_weight: %d is not between 1 and 10000", n))
+		} else {
+			if res.CPU == nil {
+				res.CPU = &cgroupsv2.CPU{}
+			}
+			res.CPU.Weight = &n
+		}
+	}
+	if c.MemHigh == "max" {
+		files = append(files, [2]string{"memory.high", "max"})
+	} else if c.MemHigh != "" {
+		if n, err := sizeconv.FromSize(c.MemHigh); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("pg_mem_high: %w", err))
+		} else {
+			if res.Memory == nil {
+				res.Memory = &cgroupsv2.Memory{}
+			}
+			res.Memory.High = &n
+		}
+	}
+	if c.MemMin == "max" {
+		files = append(files, [2]string{"memory.min", "max"})
+	} else if c.MemMin != "" {
+		if n, err := sizeconv.FromSize(c.MemMin); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("pg_mem_min: %w", err))
+		} else {
+			files = append(files, [2]string{"memory.min", strconv.FormatInt(n, 10)})
+		}
+	}
+	if c.CPUMaxBurst != "" {
+		if d, err := time.ParseDuration(c.CPUMaxBurst); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("pg_cpu_max_burst: %w", err))
+		} else {
+			files = append(files, [2]string{"cpu.max.burst", strconv.FormatInt(d.Microseconds(), 10)})
+		}
+	}
+	return
+}
+
+// parseIOMax parses a pg_io_max value like
+//
+//	/dev/sda rbps=10m wiops=100 8:16 wbps=5m
+//
+// into the throttle device lists of r. A word without '=' is a device,
+// either a block device path or a <major>:<minor> number, and the
+// following <type>=<rate> words set its limits. The rbps and wbps rates
+// accept size suffixes.
+func parseIOMax(s string, r *specs.LinuxBlockIO) error {
+	var (
+		major, minor int64
+		device       string
+	)
+	for _, word := range strings.Fields(s) {
+		k, v, ok := strings.Cut(word, "=")
+		if !ok {
+			var err error
+			if major, minor, err = parseDevice(word); err != nil {
+				return err
+			}
+			device = word
+			continue
+		}
+		if device == "" {
+			return fmt.Errorf("%s: no device specified before the limit", word)
+		}
+		var (
+			rate uint64
+			err  error
+		)
+		switch k {
+		case "rbps", "wbps":
+			var n int64
+			n, err = sizeconv.FromSize(v)
+			rate = uint64(n)
+		case "riops", "wiops":
+			rate, err = strconv.ParseUint(v, 10, 64)
+		default:
+			return fmt.Errorf("%s: unsupported limit type %s (rbps, wbps, riops, wiops)", word, k)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", word, err)
+		}
+		d := specs.LinuxThrottleDevice{Rate: rate}
+		d.Major, d.Minor = major, minor
+		switch k {
+		case "rbps":
+			r.ThrottleReadBpsDevice = append(r.ThrottleReadBpsDevice, d)
+		case "wbps":
+			r.ThrottleWriteBpsDevice = append(r.ThrottleWriteBpsDevice, d)
+		case "riops":
+			r.ThrottleReadIOPSDevice = append(r.ThrottleReadIOPSDevice, d)
+		case "wiops":
+			r.ThrottleWriteIOPSDevice = append(r.ThrottleWriteIOPSDevice, d)
+		}
+	}
+	return nil
+}
+
+func parseDevice(s string) (major, minor int64, err error) {
+	if a, b, ok := strings.Cut(s, ":"); ok {
+		if major, err = strconv.ParseInt(a, 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("%s: invalid major: %w", s, err)
+		}
+		if minor, err = strconv.ParseInt(b, 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("%s: invalid minor: %w", s, err)
+		}
+		return
+	}
+	var st unix.Stat_t
+	if err = unix.Stat(s, &st); err != nil {
+		return 0, 0, fmt.Errorf("%s: %w", s, err)
+	}
+	if st.Mode&unix.S_IFMT != unix.S_IFBLK {
+		return 0, 0, fmt.Errorf("%s: not a block device", s)
+	}
+	return int64(unix.Major(uint64(st.Rdev))), int64(unix.Minor(uint64(st.Rdev))), nil
+}
+
 func (c Config) Delete() (bool, error) {
 	var changed bool
 	if ch, err := c.deleteV1(); err != nil {
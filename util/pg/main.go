@@ -27,8 +27,18 @@ type (
 		VMemLimit     string
 		MemSwappiness string
 		BlockIOWeight string
-		applied       bool
-		log           *plog.Logger
+
+		// The following settings are only supported by the cgroup v2
+		// hierarchy, except IOMax and PidsMax.
+		IOMax       string
+		PidsMax     string
+		MemHigh     string
+		MemMin      string
+		CPUWeight   string
+		CPUMaxBurst string
+
+		applied bool
+		log     *plog.Logger
 	}
 	Mgr struct {
 		mu      sync.Mutex
@@ -189,6 +199,24 @@ func (c Config) String() string {
 	if c.BlockIOWeight != "" {
 		l = append(l, "blkioweight="+c.BlockIOWeight)
 	}
+	if c.IOMax != "" {
+		l = append(l, "io_max="+strconv.Quote(c.IOMax))
+	}
+	if c.PidsMax != "" {
+		l = append(l, "pids_max="+c.PidsMax)
+	}
+	if c.MemHigh != "" {
+		l = append(l, "mem_high="+c.MemHigh)
+	}
+	if c.MemMin != "" {
+		l = append(l, "mem_min="+c.MemMin)
+	}
+	if c.CPUWeight != "" {
+		l = append(l, "cpu_weight="+c.CPUWeight)
+	}
+	if c.CPUMaxBurst != "" {
+		l = append(l, "cpu_max_burst="+c.CPUMaxBurst)
+	}
 	if len(l) == 0 {
 		return buff
 	}
@@ -0,0 +1,201 @@
+package pg
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type (
+	// PressureLine is a line of a cgroup v2 pressure stall information
+	// file. The averages are the percentages of wall time some (or all)
+	// tasks were stalled on the resource over the last 10, 60 and 300
+	// seconds. Total is the cumulated stall time in microseconds.
+	PressureLine struct {
+		Avg10  float64 `json:"avg10"`
+		Avg60  float64 `json:"avg60"`
+		Avg300 float64 `json:"avg300"`
+		Total  uint64  `json:"total,omitempty"`
+	}
+
+	// PressureStat is the content of a <resource>.pressure file. The full
+	// line is not reported for the cpu resource by older kernels.
+	PressureStat struct {
+		Some PressureLine  `json:"some"`
+		Full *PressureLine `json:"full,omitempty"`
+	}
+
+	// Pressure is the pressure stall information of a cgroup.
+	Pressure struct {
+		CPU    *PressureStat `json:"cpu,omitempty"`
+		Memory *PressureStat `json:"memory,omitempty"`
+		IO     *PressureStat `json:"io,omitempty"`
+	}
+)
+
+// ParsePressureStat parses the content of a <resource>.pressure file:
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func ParsePressureStat(s string) (PressureStat, error) {
+	var stat PressureStat
+	var hasSome bool
+	for _, line := range strings.Split(s, "\n") {
+		words := strings.Fields(line)
+		if len(words) == 0 {
+			continue
+		}
+		var p PressureLine
+		for _, word := range words[1:] {
+			k, v, ok := strings.Cut(word, "=")
+			if !ok {
+				return stat, fmt.Errorf("invalid pressure field %s", word)
+			}
+			var err error
+			switch k {
+			case "avg10":
+				p.Avg10, err = strconv.ParseFloat(v, 64)
+			case "avg60":
+				p.Avg60, err = strconv.ParseFloat(v, 64)
+			case "avg300":
+				p.Avg300, err = strconv.ParseFloat(v, 64)
+			case "total":
+				p.Total, err = strconv.ParseUint(v, 10, 64)
+			}
+			if err != nil {
+				return stat, fmt.Errorf("invalid pressure field %s: %w", word, err)
+			}
+		}
+		switch words[0] {
+		case "some":
+			stat.Some = p
+			hasSome = true
+		case "full":
+			stat.Full = &p
+		default:
+			return stat, fmt.Errorf("invalid pressure line %s", line)
+		}
+	}
+	if !hasSome {
+		return stat, fmt.Errorf("no 'some' pressure line")
+	}
+	return stat, nil
+}
+
+// ReadPressure returns the pressure stall information of the cgroup
+// directory dir. It returns nil if dir has no pressure file, which is
+// the case for a cgroup v1 hierarchy, a kernel without PSI support or a
+// cgroup not created.
+func ReadPressure(dir string) (*Pressure, error) {
+	var (
+		p    Pressure
+		errs error
+		n    int
+	)
+	for _, e := range []struct {
+		name string
+		stat **PressureStat
+	}{
+		{"cpu.pressure", &p.CPU},
+		{"memory.pressure", &p.Memory},
+		{"io.pressure", &p.IO},
+	} {
+		b, err := os.ReadFile(filepath.Join(dir, e.name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		stat, err := ParsePressureStat(string(b))
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", e.name, err))
+			continue
+		}
+		*e.stat = &stat
+		n++
+	}
+	if n == 0 {
+		return nil, errs
+	}
+	return &p, errs
+}
+
+// Pressure returns the pressure stall information of the pg cgroup in
+// the unified hierarchy.
+func (c Config) Pressure() (*Pressure, error) {
+	return ReadPressure(filepath.Join(UnifiedPath(), c.ID))
+}
+
+// Rounded returns a copy of the pressure with the averages rounded to the
+// whole percent and without the cumulated stall times, so the instance
+// status embedding it only changes on significant pressure variations.
+// It returns nil if all the rounded averages are zero.
+func (t *Pressure) Rounded() *Pressure {
+	if t == nil {
+		return nil
+	}
+	zero := true
+	round := func(s *PressureStat) *PressureStat {
+		if s == nil {
+			return nil
+		}
+		n := PressureStat{Some: s.Some.rounded()}
+		zero = zero && n.Some.isZero()
+		if s.Full != nil {
+			full := s.Full.rounded()
+			zero = zero && full.isZero()
+			n.Full = &full
+		}
+		return &n
+	}
+	n := Pressure{
+		CPU:    round(t.CPU),
+		Memory: round(t.Memory),
+		IO:     round(t.IO),
+	}
+	if zero {
+		return nil
+	}
+	return &n
+}
+
+func (t PressureLine) rounded() PressureLine {
+	return PressureLine{
+		Avg10:  math.Round(t.Avg10),
+		Avg60:  math.Round(t.Avg60),
+		Avg300: math.Round(t.Avg300),
+	}
+}
+
+func (t PressureLine) isZero() bool {
+	return t.Avg10 == 0 && t.Avg60 == 0 && t.Avg300 == 0
+}
+
+func (t *Pressure) DeepCopy() *Pressure {
+	if t == nil {
+		return nil
+	}
+	n := Pressure{
+		CPU:    t.CPU.DeepCopy(),
+		Memory: t.Memory.DeepCopy(),
+		IO:     t.IO.DeepCopy(),
+	}
+	return &n
+}
+
+func (t *PressureStat) DeepCopy() *PressureStat {
+	if t == nil {
+		return nil
+	}
+	n := *t
+	if t.Full != nil {
+		full := *t.Full
+		n.Full = &full
+	}
+	return &n
+}
@@ -0,0 +1,67 @@
+package pg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePressureStat(t *testing.T) {
+	stat, err := ParsePressureStat("some avg10=1.50 avg60=0.25 avg300=0.00 total=12345\nfull avg10=0.10 avg60=0.00 avg300=0.00 total=42\n")
+	require.NoError(t, err)
+	require.Equal(t, PressureLine{Avg10: 1.5, Avg60: 0.25, Total: 12345}, stat.Some)
+	require.NotNil(t, stat.Full)
+	require.Equal(t, uint64(42), stat.Full.Total)
+
+	stat, err = ParsePressureStat("some avg10=0.00 avg60=0.00 avg300=0.00 total=0\n")
+	require.NoError(t, err)
+	require.Nil(t, stat.Full)
+
+	_, err = ParsePressureStat("full avg10=0.00 avg60=0.00 avg300=0.00 total=0\n")
+	require.Error(t, err)
+
+	_, err = ParsePressureStat("some avg10=x\n")
+	require.Error(t, err)
+}
+
+func TestReadPressure(t *testing.T) {
+	dir := t.TempDir()
+	p, err := ReadPressure(dir)
+	require.NoError(t, err)
+	require.Nil(t, p, "no pressure file")
+
+	err = os.WriteFile(filepath.Join(dir, "io.pressure"), []byte("some avg10=2.00 avg60=1.00 avg300=0.50 total=100\nfull avg10=1.00 avg60=0.50 avg300=0.25 total=50\n"), 0644)
+	require.NoError(t, err)
+	p, err = ReadPressure(dir)
+	require.NoError(t, err)
+	require.NotNil(t, p)
+	require.Nil(t, p.CPU)
+	require.Nil(t, p.Memory)
+	require.Equal(t, 2.0, p.IO.Some.Avg10)
+	require.Equal(t, 0.25, p.IO.Full.Avg300)
+}
+
+func TestPressureRounded(t *testing.T) {
+	var p *Pressure
+	require.Nil(t, p.Rounded())
+
+	p = &Pressure{
+		CPU: &PressureStat{Some: PressureLine{Avg10: 0.49, Avg60: 0.2, Avg300: 0.1, Total: 1000}},
+		IO: &PressureStat{
+			Some: PressureLine{Avg10: 2.51, Avg60: 1.49, Avg300: 0.5, Total: 100},
+			Full: &PressureLine{Avg10: 1.2, Avg60: 0.4, Avg300: 0.1, Total: 50},
+		},
+	}
+	r := p.Rounded()
+	require.NotNil(t, r)
+	require.Nil(t, r.Memory)
+	require.Equal(t, PressureLine{}, r.CPU.Some)
+	require.Equal(t, PressureLine{Avg10: 3, Avg60: 1, Avg300: 1}, r.IO.Some)
+	require.Equal(t, PressureLine{Avg10: 1}, *r.IO.Full)
+	require.Equal(t, uint64(100), p.IO.Some.Total, "the source pressure is not modified")
+
+	p = &Pressure{CPU: &PressureStat{Some: PressureLine{Avg10: 0.3, Total: 1000}}}
+	require.Nil(t, p.Rounded(), "no significant pressure")
+}
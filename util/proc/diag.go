@@ -0,0 +1,167 @@
+package proc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Diag is a diagnostic snapshot of a process and its descendants,
+	// captured from /proc to investigate a hung command.
+	Diag struct {
+		PID      int
+		PPID     int
+		State    string
+		Cmdline  []string
+		WChan    string
+		Stack    []string
+		Files    []string
+		Children []Diag
+		Err      error
+	}
+)
+
+// Diagnose returns the diagnostic snapshot of the process pid and its
+// descendants.
+func Diagnose(pid int) Diag {
+	return diagnose(pid, childrenMap())
+}
+
+func diagnose(pid int, children map[int][]int) Diag {
+	t := New(pid)
+	d := Diag{PID: pid}
+	if state, ppid, err := t.stat(); err != nil {
+		d.Err = err
+		return d
+	} else {
+		d.State = state
+		d.PPID = ppid
+	}
+	d.Cmdline, _ = parseFile(t.Head() + "/cmdline")
+	if b, err := os.ReadFile(t.Head() + "/wchan"); err == nil {
+		d.WChan = string(b)
+	}
+	if b, err := os.ReadFile(t.Head() + "/stack"); err == nil {
+		d.Stack = strings.Split(strings.TrimSpace(string(b)), "\n")
+	}
+	d.Files = t.files()
+	for _, child := range children[pid] {
+		d.Children = append(d.Children, diagnose(child, children))
+	}
+	return d
+}
+
+// PPID returns the parent process id.
+func (t T) PPID() (int, error) {
+	_, ppid, err := t.stat()
+	return ppid, err
+}
+
+// stat returns the state and parent process id from /proc/<pid>/stat.
+func (t T) stat() (string, int, error) {
+	b, err := os.ReadFile(t.Head() + "/stat")
+	if err != nil {
+		return "", 0, err
+	}
+	return parseStat(string(b))
+}
+
+// parseStat parses a /proc/<pid>/stat content. The command name field is
+// enclosed in parentheses and can contain spaces and parentheses, so the
+// fields are split after the last closing parenthesis.
+func parseStat(s string) (string, int, error) {
+	i := strings.LastIndex(s, ")")
+	if i < 0 {
+		return "", 0, fmt.Errorf("unexpected stat format: %s", s)
+	}
+	fields := strings.Fields(s[i+1:])
+	if len(fields) < 2 {
+		return "", 0, fmt.Errorf("unexpected stat format: %s", s)
+	}
+	ppid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return "", 0, fmt.Errorf("unexpected stat ppid: %w", err)
+	}
+	return fields[0], ppid, nil
+}
+
+// files returns the sorted "fd -> target" list of the process open files.
+func (t T) files() []string {
+	head := t.Head() + "/fd"
+	entries, err := os.ReadDir(head)
+	if err != nil {
+		return nil
+	}
+	l := make([]string, 0, len(entries))
+	for _, e := range entries {
+		target, err := os.Readlink(filepath.Join(head, e.Name()))
+		if err != nil {
+			continue
+		}
+		l = append(l, e.Name()+" -> "+target)
+	}
+	sort.Slice(l, func(i, j int) bool {
+		a, _ := strconv.Atoi(strings.Fields(l[i])[0])
+		b, _ := strconv.Atoi(strings.Fields(l[j])[0])
+		return a < b
+	})
+	return l
+}
+
+// childrenMap returns the children process ids indexed by parent process id.
+func childrenMap() map[int][]int {
+	m := make(map[int][]int)
+	l, err := All()
+	if err != nil {
+		return m
+	}
+	for _, p := range l.Procs() {
+		ppid, err := p.PPID()
+		if err != nil {
+			continue
+		}
+		m[ppid] = append(m[ppid], p.PID())
+	}
+	for _, pids := range m {
+		sort.Ints(pids)
+	}
+	return m
+}
+
+// String returns the indented text rendering of the process tree
+// diagnostics.
+func (t Diag) String() string {
+	var sb strings.Builder
+	t.render(&sb, "")
+	return sb.String()
+}
+
+func (t Diag) render(sb *strings.Builder, indent string) {
+	if t.Err != nil {
+		fmt.Fprintf(sb, "%spid %d: %s\n", indent, t.PID, t.Err)
+		return
+	}
+	fmt.Fprintf(sb, "%spid %d ppid %d state %s: %s\n", indent, t.PID, t.PPID, t.State, strings.Join(t.Cmdline, " "))
+	if t.WChan != "" && t.WChan != "0" {
+		fmt.Fprintf(sb, "%s  wchan: %s\n", indent, t.WChan)
+	}
+	if len(t.Stack) > 0 {
+		fmt.Fprintf(sb, "%s  stack:\n", indent)
+		for _, s := range t.Stack {
+			fmt.Fprintf(sb, "%s    %s\n", indent, s)
+		}
+	}
+	if len(t.Files) > 0 {
+		fmt.Fprintf(sb, "%s  files:\n", indent)
+		for _, s := range t.Files {
+			fmt.Fprintf(sb, "%s    %s\n", indent, s)
+		}
+	}
+	for _, child := range t.Children {
+		child.render(sb, indent+"  ")
+	}
+}
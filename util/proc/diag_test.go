@@ -0,0 +1,46 @@
+package proc
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStat(t *testing.T) {
+	state, ppid, err := parseStat("1234 (my (odd) cmd) S 42 1234 1234 0 -1")
+	require.NoError(t, err)
+	require.Equal(t, "S", state)
+	require.Equal(t, 42, ppid)
+
+	_, _, err = parseStat("garbage")
+	require.Error(t, err)
+}
+
+func TestDiagnose(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("requires /proc")
+	}
+	cmd := exec.Command("sleep", "10")
+	require.NoError(t, cmd.Start())
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	d := Diagnose(os.Getpid())
+	require.NoError(t, d.Err)
+	require.Equal(t, os.Getpid(), d.PID)
+	require.NotEmpty(t, d.Files)
+
+	var found bool
+	for _, child := range d.Children {
+		if child.PID == cmd.Process.Pid {
+			found = true
+			require.Equal(t, os.Getpid(), child.PPID)
+		}
+	}
+	require.True(t, found, "child process not found in:\n%s", d)
+}
@@ -199,6 +199,12 @@ type (
 		bufferedPublicationC chan cmdPub
 		// bufferedPublicationEnabled is true if publication buffering is enabled
 		bufferedPublicationEnabled bool
+
+		// seq is the sequence number of the last publication
+		seq uint64
+
+		// recorder is the optional journal of the publications
+		recorder Recorder
 	}
 
 	stringer interface {
@@ -209,6 +215,14 @@ type (
 		Labels Labels `json:"labels"`
 	}
 
+	// Recorder is the interface of a publication journal. Record is called
+	// by the bus routine for each publication with the publication sequence
+	// number, in publication order and before the subscribers are notified,
+	// so it must not block.
+	Recorder interface {
+		Record(seq uint64, data any)
+	}
+
 	Messager interface {
 		AddLabels(...Label)
 		GetLabels() Labels
@@ -573,6 +587,16 @@ func (b *Bus) SetPanicOnFullQueue(graceTime time.Duration) {
 	b.panicOnFullQueueGraceTime = graceTime
 }
 
+// SetRecorder sets the journal recording the bus publications.
+//
+// It panics if called on started bus.
+func (b *Bus) SetRecorder(r Recorder) {
+	if b.started {
+		panic("can't set recorder on started bus")
+	}
+	b.recorder = r
+}
+
 func (b *Bus) onSubCmd(c cmdSub) {
 	id := uuid.New()
 	sub := &Subscription{
@@ -632,6 +656,10 @@ func (b *Bus) doBufferedPublication(c cmdPub) {
 }
 
 func (b *Bus) doPublication(c cmdPub) {
+	b.seq++
+	if b.recorder != nil {
+		b.recorder.Record(b.seq, c.data)
+	}
 	for _, toFilterKey := range c.pubKeys {
 		// search subscribers that listen to on one of cmdPub.keys
 		if subIDMap, ok := b.subMap[toFilterKey]; ok {
@@ -742,9 +770,10 @@ func (b *Bus) onSubDelFilter(c cmdSubDelFilter) {
 			filters = append(filters, f)
 		}
 	}
+	// the keys of the deleted filters must be removed from the map
+	b.subMap.Del(c.id, sub.keys()...)
 	sub.filters = filters
 	b.subs[c.id] = sub
-	b.subMap.Del(c.id, ":")
 	b.subMap.Add(c.id, sub.keys()...)
 	c.resp <- nil
 }
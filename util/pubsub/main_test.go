@@ -387,3 +387,29 @@ func TestSubscriptionKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestDelFilter(t *testing.T) {
+	bus := newRun(t.Name())
+	defer bus.Stop()
+
+	sub := bus.Sub(t.Name())
+	sub.AddFilter(&msgS{}, Label{"a", "1"})
+	sub.AddFilter(&msgS{}, Label{"a", "2"})
+	sub.Start()
+	defer func() { _ = sub.Stop() }()
+
+	sub.DelFilter(&msgS{}, Label{"a", "1"})
+	bus.Pub(&msgS{v: "deleted filter"}, Label{"a", "1"})
+	bus.Pub(&msgS{v: "kept filter"}, Label{"a", "2"})
+	select {
+	case i := <-sub.C:
+		require.Equal(t, "kept filter", i.(*msgS).v)
+	case <-time.After(time.Second):
+		require.Fail(t, "no message received")
+	}
+	select {
+	case i := <-sub.C:
+		require.Fail(t, "unexpected message", "%v", i)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
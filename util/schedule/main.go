@@ -563,6 +563,20 @@ func (t *Expr) Test(tm time.Time) (time.Duration, error) {
 	return t.TestWithLast(tm, time.Time{})
 }
 
+// Includes returns true if <tm> is in a timerange allowed by the
+// expression, and not in an excluded timerange.
+func (t *Expr) Includes(tm time.Time) (bool, error) {
+	_, err := t.Test(tm)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, ErrNotAllowed), errors.Is(err, ErrExcluded):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 func newExprDataset() Schedules {
 	return make(Schedules, 0)
 }
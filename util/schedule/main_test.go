@@ -147,6 +147,30 @@ func TestAllowed(t *testing.T) {
 	}
 }
 
+func TestIncludes(t *testing.T) {
+	tests := []struct {
+		Expression string
+		Time       string
+		Expected   bool
+	}{
+		{"", "2015-02-27 Z10:00:00", false},
+		{"02:00-06:00 sat", "2015-02-28 Z03:00:00", true},
+		{"02:00-06:00 sat", "2015-02-28 Z07:00:00", false},
+		{"02:00-06:00 sat", "2015-02-27 Z03:00:00", false},
+		{"22:00-02:00", "2015-02-27 Z01:00:00", true},
+		{`["!* fri", "02:00-06:00"]`, "2015-02-27 Z03:00:00", false},
+	}
+	for _, data := range tests {
+		name := fmt.Sprintf("%+v", data)
+		t.Run(name, func(t *testing.T) {
+			tm, _ := time.Parse(timeLayout, data.Time)
+			v, err := New(data.Expression).Includes(tm)
+			require.NoError(t, err)
+			require.Equal(t, data.Expected, v)
+		})
+	}
+}
+
 func TestParsedInterval(t *testing.T) {
 	tests := []struct {
 		Expression string
@@ -41,6 +41,7 @@ type (
 		configReader    io.Reader
 		collectorClient *collector.Client
 		force           bool
+		snapshotMax     int
 
 		// variable
 		changed      map[string]interface{}
@@ -65,10 +66,11 @@ var (
 func New() *T {
 	srLog = log.With().Str("c", "sysreport").Logger()
 	t := &T{
-		etcDir:   filepath.Join(rawconfig.Paths.Etc, "sysreport.conf.d"),
-		varDir:   filepath.Join(rawconfig.Paths.Var),
-		excludes: []string{},
-		commands: []string{},
+		etcDir:      filepath.Join(rawconfig.Paths.Etc, "sysreport.conf.d"),
+		varDir:      filepath.Join(rawconfig.Paths.Var),
+		snapshotMax: DefaultSnapshotMax,
+		excludes:    []string{},
+		commands:    []string{},
 		includes: []string{
 			filepath.Join(rawconfig.Paths.Etc, "*.conf"),
 			filepath.Join(rawconfig.Paths.Etc, "namespaces", "*", "*", "*.conf"),
@@ -695,6 +697,13 @@ func (t *T) Do() error {
 	if err := t.updateStatsStat(); err != nil {
 		return err
 	}
+	if err := t.saveSnapshot(); err != nil {
+		return err
+	}
+	if t.collectorClient == nil {
+		srLog.Info().Msg("No collector configured, keep the local snapshot only")
+		return nil
+	}
 	if err := t.send(); err != nil {
 		return err
 	}
@@ -0,0 +1,226 @@
+package sysreport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/anmitsu/go-shlex"
+
+	"github.com/opensvc/om3/v3/core/rawconfig"
+	"github.com/opensvc/om3/v3/util/hostname"
+	"github.com/opensvc/om3/v3/util/packages"
+)
+
+type (
+	// Snapshot is the fingerprint of a sysreport collection, kept locally
+	// to compare the collections of different nodes, or of different dates,
+	// without a collector.
+	Snapshot struct {
+		Node      string    `json:"node"`
+		CreatedAt time.Time `json:"created_at"`
+
+		// Files is the sha256 sum of the collected files, indexed by path.
+		Files map[string]string `json:"files"`
+
+		// Commands is the sha256 sum of the collected command outputs,
+		// indexed by command.
+		Commands map[string]string `json:"commands"`
+
+		// Packages is the version of the installed packages, indexed by
+		// <name>.<arch>.
+		Packages map[string]string `json:"packages"`
+	}
+
+	// Difference describes a file, command output or package diverging
+	// between two snapshots. An empty A or B means the item is absent from
+	// the corresponding snapshot.
+	Difference struct {
+		Kind string `json:"kind"`
+		Name string `json:"name"`
+		A    string `json:"a"`
+		B    string `json:"b"`
+	}
+)
+
+const (
+	DiffKindFile    = "file"
+	DiffKindCommand = "command"
+	DiffKindPackage = "package"
+)
+
+var (
+	// DefaultSnapshotMax is the default number of snapshots kept.
+	DefaultSnapshotMax = 10
+)
+
+// SnapshotsDir returns the directory where the sysreport snapshots are
+// stored.
+func SnapshotsDir() string {
+	return filepath.Join(rawconfig.Paths.Var, "sysreport", "snapshots")
+}
+
+// SetSnapshotMax sets the number of snapshots to keep. The oldest are
+// removed after each collection. A zero value disables the snapshots.
+func (t *T) SetSnapshotMax(n int) {
+	t.snapshotMax = n
+}
+
+// Snapshots returns the local snapshots, oldest first.
+func Snapshots() ([]Snapshot, error) {
+	l := make([]Snapshot, 0)
+	files, err := snapshotFiles()
+	if err != nil {
+		return l, err
+	}
+	for _, filename := range files {
+		b, err := os.ReadFile(filename)
+		if err != nil {
+			return l, err
+		}
+		var snapshot Snapshot
+		if err := json.Unmarshal(b, &snapshot); err != nil {
+			return l, fmt.Errorf("%s: %w", filename, err)
+		}
+		l = append(l, snapshot)
+	}
+	return l, nil
+}
+
+// snapshotFiles returns the snapshot file paths, oldest first.
+func snapshotFiles() ([]string, error) {
+	entries, err := os.ReadDir(SnapshotsDir())
+	if errors.Is(err, os.ErrNotExist) {
+		return []string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	l := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		l = append(l, filepath.Join(SnapshotsDir(), entry.Name()))
+	}
+	// the file names are zero-padded unix nano timestamps
+	sort.Strings(l)
+	return l, nil
+}
+
+func (t *T) makeSnapshot() (Snapshot, error) {
+	snapshot := Snapshot{
+		Node:      hostname.Hostname(),
+		CreatedAt: time.Now(),
+		Files:     make(map[string]string),
+		Commands:  make(map[string]string),
+		Packages:  make(map[string]string),
+	}
+	for _, path := range sortedKeys(t.expanded) {
+		// checksum the collected copy, where the cluster secret is
+		// obfuscated.
+		b, err := os.ReadFile(filepath.Join(t.collectFileDir(), path))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return snapshot, err
+		}
+		snapshot.Files[path] = checksum(b)
+	}
+	for _, command := range t.commands {
+		argv, err := shlex.Split(command, true)
+		if err != nil {
+			continue
+		}
+		b, err := os.ReadFile(stupidCommandToPath(t.collectCmdDir(), argv))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return snapshot, err
+		}
+		snapshot.Commands[command] = checksum(b)
+	}
+	pkgs, err := packages.List()
+	if err != nil {
+		srLog.Warn().Err(err).Msg("list packages for the snapshot")
+	}
+	for _, pkg := range pkgs {
+		name := pkg.Name
+		if pkg.Arch != "" {
+			name += "." + pkg.Arch
+		}
+		snapshot.Packages[name] = pkg.Version
+	}
+	return snapshot, nil
+}
+
+// saveSnapshot stores the snapshot of the current collection, and removes
+// the oldest snapshots exceeding the max count.
+func (t *T) saveSnapshot() error {
+	if t.snapshotMax <= 0 {
+		return nil
+	}
+	snapshot, err := t.makeSnapshot()
+	if err != nil {
+		return fmt.Errorf("make snapshot: %w", err)
+	}
+	if err := t.initDir(SnapshotsDir()); err != nil {
+		return err
+	}
+	b, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	filename := filepath.Join(SnapshotsDir(), fmt.Sprintf("%020d.json", snapshot.CreatedAt.UnixNano()))
+	if err := os.WriteFile(filename, b, 0600); err != nil {
+		return fmt.Errorf("write snapshot: %w", err)
+	}
+	srLog.Info().Str("path", filename).Msg("Snapshot saved")
+	files, err := snapshotFiles()
+	if err != nil {
+		return err
+	}
+	for len(files) > t.snapshotMax {
+		srLog.Debug().Str("path", files[0]).Msg("Remove old snapshot")
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// Compare returns the files, command outputs and packages differing
+// between the a and b snapshots, sorted by kind and name.
+func Compare(a, b Snapshot) []Difference {
+	l := make([]Difference, 0)
+	compare := func(kind string, ma, mb map[string]string) {
+		names := make(map[string]any)
+		for name := range ma {
+			names[name] = nil
+		}
+		for name := range mb {
+			names[name] = nil
+		}
+		for _, name := range sortedKeys(names) {
+			if va, vb := ma[name], mb[name]; va != vb {
+				l = append(l, Difference{Kind: kind, Name: name, A: va, B: vb})
+			}
+		}
+	}
+	compare(DiffKindFile, a.Files, b.Files)
+	compare(DiffKindCommand, a.Commands, b.Commands)
+	compare(DiffKindPackage, a.Packages, b.Packages)
+	return l
+}
+
+func checksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
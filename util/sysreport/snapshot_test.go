@@ -0,0 +1,27 @@
+package sysreport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	a := Snapshot{
+		Files:    map[string]string{"/etc/hosts": "1", "/etc/fstab": "2"},
+		Commands: map[string]string{"uname -a": "3"},
+		Packages: map[string]string{"bash.amd64": "5.1", "curl.amd64": "7.0"},
+	}
+	b := Snapshot{
+		Files:    map[string]string{"/etc/hosts": "1", "/etc/fstab": "4", "/etc/motd": "5"},
+		Commands: map[string]string{"uname -a": "3"},
+		Packages: map[string]string{"bash.amd64": "5.2"},
+	}
+	require.Equal(t, []Difference{
+		{Kind: DiffKindFile, Name: "/etc/fstab", A: "2", B: "4"},
+		{Kind: DiffKindFile, Name: "/etc/motd", A: "", B: "5"},
+		{Kind: DiffKindPackage, Name: "bash.amd64", A: "5.1", B: "5.2"},
+		{Kind: DiffKindPackage, Name: "curl.amd64", A: "7.0", B: ""},
+	}, Compare(a, b))
+	require.Empty(t, Compare(a, a))
+}